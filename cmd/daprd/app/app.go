@@ -30,6 +30,7 @@ import (
 	conversationLoader "github.com/dapr/dapr/pkg/components/conversation"
 	cryptoLoader "github.com/dapr/dapr/pkg/components/crypto"
 	lockLoader "github.com/dapr/dapr/pkg/components/lock"
+	grpcMiddlewareLoader "github.com/dapr/dapr/pkg/components/middleware/grpc"
 	httpMiddlewareLoader "github.com/dapr/dapr/pkg/components/middleware/http"
 	nrLoader "github.com/dapr/dapr/pkg/components/nameresolution"
 	pubsubLoader "github.com/dapr/dapr/pkg/components/pubsub"
@@ -99,6 +100,7 @@ func Run() {
 	bindingsLoader.DefaultRegistry.Logger = logContrib
 	conversationLoader.DefaultRegistry.Logger = logContrib
 	httpMiddlewareLoader.DefaultRegistry.Logger = log // Note this uses log on purpose
+	grpcMiddlewareLoader.DefaultRegistry.Logger = log
 
 	reg := registry.NewOptions().
 		WithSecretStores(secretstoresLoader.DefaultRegistry).
@@ -110,6 +112,7 @@ func Run() {
 		WithBindings(bindingsLoader.DefaultRegistry).
 		WithCryptoProviders(cryptoLoader.DefaultRegistry).
 		WithHTTPMiddlewares(httpMiddlewareLoader.DefaultRegistry).
+		WithGRPCMiddlewares(grpcMiddlewareLoader.DefaultRegistry).
 		WithConversations(conversationLoader.DefaultRegistry)
 
 	ctx := signals.Context()
@@ -119,6 +122,7 @@ func Run() {
 		ControlPlaneTrustDomain: opts.ControlPlaneTrustDomain,
 		ControlPlaneNamespace:   opts.ControlPlaneNamespace,
 		TrustAnchors:            opts.TrustAnchors,
+		WorkloadAPIAddress:      opts.WorkloadAPIAddress,
 		AppID:                   opts.AppID,
 		MTLSEnabled:             opts.EnableMTLS,
 		Mode:                    modes.DaprMode(opts.Mode),
@@ -140,6 +144,7 @@ func Run() {
 			rt, rerr := runtime.FromConfig(ctx, &runtime.Config{
 				AppID:                         opts.AppID,
 				ActorsService:                 opts.ActorsService,
+				PlacementWeight:               opts.PlacementWeight,
 				RemindersService:              opts.RemindersService,
 				SchedulerAddress:              opts.SchedulerAddress,
 				SchedulerStreams:              opts.SchedulerJobStreams,
@@ -0,0 +1,28 @@
+//go:build allcomponents || stablecomponents
+
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	grpcMiddlewareLoader "github.com/dapr/dapr/pkg/components/middleware/grpc"
+	"github.com/dapr/dapr/pkg/middleware/grpc/ratelimit"
+	"github.com/dapr/kit/logger"
+)
+
+func init() {
+	grpcMiddlewareLoader.DefaultRegistry.RegisterComponent(func(logger.Logger) grpcMiddlewareLoader.FactoryMethod {
+		return ratelimit.New
+	}, "ratelimit")
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/dapr/dapr/pkg/cors"
 	"github.com/dapr/dapr/pkg/metrics"
 	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/placement/hashing"
 	"github.com/dapr/dapr/pkg/runtime"
 	"github.com/dapr/dapr/pkg/security/consts"
 	"github.com/dapr/kit/logger"
@@ -42,6 +43,7 @@ type Options struct {
 	ControlPlaneNamespace         string
 	SentryAddress                 string
 	TrustAnchors                  []byte
+	WorkloadAPIAddress            string
 	AllowedOrigins                string
 	EnableProfiling               bool
 	AppMaxConcurrency             int
@@ -68,6 +70,7 @@ type Options struct {
 	RemindersService              string
 	SchedulerAddress              []string
 	SchedulerJobStreams           uint
+	PlacementWeight               uint32
 	DaprAPIListenAddresses        string
 	AppHealthProbeInterval        int
 	AppHealthProbeTimeout         int
@@ -136,6 +139,7 @@ func New(origArgs []string) (*Options, error) {
 	fs.StringVar(&opts.AppID, "app-id", "", "A unique ID for Dapr. Used for Service Discovery and state")
 	fs.StringVar(&opts.ControlPlaneAddress, "control-plane-address", "", "Address for a Dapr control plane")
 	fs.StringVar(&opts.SentryAddress, "sentry-address", "", "Address for the Sentry CA service")
+	fs.StringVar(&opts.WorkloadAPIAddress, "spiffe-workload-api-address", "", "Address of a SPIFFE Workload API, such as unix:///run/spire/sockets/agent.sock, to fetch the workload identity and trust bundle from instead of Sentry")
 	fs.StringVar(&opts.ControlPlaneTrustDomain, "control-plane-trust-domain", "localhost", "Trust domain of the Dapr control plane")
 	fs.StringVar(&opts.ControlPlaneNamespace, "control-plane-namespace", "default", "Namespace of the Dapr control plane")
 	fs.StringSliceVar(&opts.SentryRequestJwtAudiences, "sentry-request-jwt-audiences", nil, "JWT audience list for certificate signing requests. If not specified, the trust domain will be used")
@@ -170,6 +174,7 @@ func New(origArgs []string) (*Options, error) {
 	// --placement-host-address is a legacy (but not deprecated) flag that is translated to the actors-service flag
 	var placementServiceHostAddr string
 	fs.StringVar(&placementServiceHostAddr, "placement-host-address", "", "Addresses for Dapr Actor Placement servers (overrides actors-service)")
+	fs.Uint32Var(&opts.PlacementWeight, "placement-weight", 1, "Capacity of this host relative to the other hosts of its actor types, from 1 to "+strconv.Itoa(hashing.MaxWeight)+"; actors are placed on hosts in proportion to their weight")
 	fs.StringSliceVar(&opts.SchedulerAddress, "scheduler-host-address", nil, "Addresses of the Scheduler service instance(s), as comma separated host:port pairs")
 	fs.UintVar(&opts.SchedulerJobStreams, "scheduler-job-streams", 3, "The number of active job streams to connect to the Scheduler service")
 
@@ -202,6 +207,10 @@ func New(origArgs []string) (*Options, error) {
 		opts.ActorsService = "placement:" + placementServiceHostAddr
 	}
 
+	if opts.PlacementWeight < 1 || opts.PlacementWeight > hashing.MaxWeight {
+		return nil, fmt.Errorf("invalid value for 'placement-weight' option: must be between 1 and %d", hashing.MaxWeight)
+	}
+
 	// Max body size
 	// max-body-size has priority over dapr-http-max-request-size
 	if fs.Changed("max-body-size") {
@@ -240,7 +249,11 @@ func New(origArgs []string) (*Options, error) {
 		}
 	}
 
-	opts.TrustAnchors = []byte(os.Getenv(consts.TrustAnchorsEnvVar))
+	// The trust bundle is served by the SPIFFE Workload API when used, so
+	// trust anchors given to the sidecar are ignored.
+	if len(opts.WorkloadAPIAddress) == 0 {
+		opts.TrustAnchors = []byte(os.Getenv(consts.TrustAnchorsEnvVar))
+	}
 
 	if !fs.Changed("control-plane-namespace") {
 		ns, ok := os.LookupEnv(consts.ControlPlaneNamespaceEnvVar)
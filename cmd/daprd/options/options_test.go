@@ -304,6 +304,36 @@ func TestReadBufferSize(t *testing.T) {
 	})
 }
 
+func TestPlacementWeight(t *testing.T) {
+	t.Run("No placement-weight", func(t *testing.T) {
+		opts, err := New([]string{})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(1), opts.PlacementWeight)
+	})
+
+	t.Run("placement-weight is set", func(t *testing.T) {
+		opts, err := New([]string{
+			"--placement-weight", "4",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(4), opts.PlacementWeight)
+	})
+
+	t.Run("placement-weight is out of range", func(t *testing.T) {
+		_, err := New([]string{
+			"--placement-weight", "0",
+		})
+		require.Error(t, err)
+
+		_, err = New([]string{
+			"--placement-weight", "11",
+		})
+		require.Error(t, err)
+	})
+}
+
 func TestControlPlaneEnvVar(t *testing.T) {
 	t.Run("should default CLI flags if not defined", func(t *testing.T) {
 		opts, err := New([]string{})
@@ -24,6 +24,7 @@ import (
 	"github.com/dapr/dapr/pkg/metrics"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/placement"
+	"github.com/dapr/dapr/pkg/placement/hashing"
 	"github.com/dapr/dapr/pkg/placement/monitoring"
 	"github.com/dapr/dapr/pkg/placement/raft"
 	"github.com/dapr/dapr/pkg/security"
@@ -103,6 +104,9 @@ func Run() {
 	}
 	placementOpts.SetMinAPILevel(opts.MinAPILevel)
 	placementOpts.SetMaxAPILevel(opts.MaxAPILevel)
+	if opts.MaxAPILevel >= 0 && opts.MaxAPILevel < hashing.WeightedAPILevel {
+		log.Infof("Max API level %d is below %d: the placement weights of actor hosts are ignored and actors are spread evenly", opts.MaxAPILevel, hashing.WeightedAPILevel)
+	}
 
 	placementService, err := placement.New(placementOpts)
 	if err != nil {
@@ -136,6 +136,7 @@ func Run() {
 	cfg.JWT.JWKSPath = jwksPath
 	cfg.JWT.SigningAlgorithm = opts.JWT.SigningAlgorithm
 	cfg.TrustDomain = opts.TrustDomain
+	cfg.TrustAnchorPropagationPeriod = opts.TrustAnchorPropagationPeriod
 	cfg.Port = opts.Port
 	cfg.ListenAddress = opts.ListenAddress
 	cfg.Mode = modes.DaprMode(opts.Mode)
@@ -150,6 +151,22 @@ func Run() {
 
 	cfg.JWT.TTL = opts.JWT.TTL
 
+	cfg.Audit = config.ConfigAudit{
+		FilePath:     opts.Audit.FilePath,
+		OTLPEndpoint: opts.Audit.OTLPEndpoint,
+		OTLPInsecure: opts.Audit.OTLPInsecure,
+	}
+
+	if opts.ExternalSigner.Address != "" {
+		cfg.ExternalSigner = &config.ConfigExternalSigner{
+			Address:     opts.ExternalSigner.Address,
+			KeyID:       opts.ExternalSigner.KeyID,
+			TLSCAPath:   opts.ExternalSigner.TLSCAFile,
+			TLSCertPath: opts.ExternalSigner.TLSCertFile,
+			TLSKeyPath:  opts.ExternalSigner.TLSKeyFile,
+		}
+	}
+
 	// We use runner manager inception here since we want the inner manager to be
 	// restarted when the CA server needs to be restarted because of file events.
 	// We don't want to restart the healthz server and file watcher on file
@@ -167,6 +184,9 @@ func Run() {
 		sentry, serr := sentry.New(ctx, sentry.Options{
 			Config:  cfg,
 			Healthz: healthz,
+			LoadConfig: func() (config.Config, error) {
+				return config.FromConfigName(opts.ConfigName, opts.Mode)
+			},
 			OIDC: sentry.OIDCOptions{
 				Enabled:             opts.OIDC.Enabled,
 				ServerListenAddress: opts.OIDC.ServerListenAddress,
@@ -38,21 +38,30 @@ const (
 )
 
 type Options struct {
-	ConfigName            string
-	Port                  int
-	ListenAddress         string
-	HealthzPort           int
-	HealthzListenAddress  string
-	IssuerCredentialsPath string
-	TrustDomain           string
-	Kubeconfig            string
-	Logger                logger.Options
-	Metrics               *metrics.FlagOptions
-	Mode                  string
-
-	X509 X509Options
-	JWT  JWTOptions
-	OIDC OIDCOptions
+	ConfigName                   string
+	Port                         int
+	ListenAddress                string
+	HealthzPort                  int
+	HealthzListenAddress         string
+	IssuerCredentialsPath        string
+	TrustDomain                  string
+	TrustAnchorPropagationPeriod time.Duration
+	Kubeconfig                   string
+	Logger                       logger.Options
+	Metrics                      *metrics.FlagOptions
+	Mode                         string
+
+	X509           X509Options
+	JWT            JWTOptions
+	OIDC           OIDCOptions
+	ExternalSigner ExternalSignerOptions
+	Audit          AuditOptions
+}
+
+type AuditOptions struct {
+	FilePath     string
+	OTLPEndpoint string
+	OTLPInsecure bool
 }
 
 type X509Options struct {
@@ -61,6 +70,14 @@ type X509Options struct {
 	IssuerKeyFilename  string
 }
 
+type ExternalSignerOptions struct {
+	Address     string
+	KeyID       string
+	TLSCAFile   string
+	TLSCertFile string
+	TLSKeyFile  string
+}
+
 type JWTOptions struct {
 	Enabled            bool
 	SigningKeyFilename string
@@ -121,7 +138,16 @@ func New(origArgs []string) *Options {
 	fs.StringVar(&opts.X509.RootCAFilename, "issuer-ca-filename", config.DefaultRootCertFilename, "Certificate Authority certificate filename")
 	fs.StringVar(&opts.X509.IssuerCertFilename, "issuer-certificate-filename", config.DefaultIssuerCertFilename, "Issuer certificate filename")
 	fs.StringVar(&opts.X509.IssuerKeyFilename, "issuer-key-filename", config.DefaultIssuerKeyFilename, "Issuer private key filename")
+	fs.StringVar(&opts.ExternalSigner.Address, "external-signer-address", "", "Address of an external signing service holding the issuer key (http://, https:// or unix://), instead of the issuer key file")
+	fs.StringVar(&opts.ExternalSigner.KeyID, "external-signer-key-id", "", "ID of the issuer key at the external signing service")
+	fs.StringVar(&opts.ExternalSigner.TLSCAFile, "external-signer-tls-ca-file", "", "CA certificate file to verify the external signing service")
+	fs.StringVar(&opts.ExternalSigner.TLSCertFile, "external-signer-tls-cert-file", "", "Client certificate file to authenticate with the external signing service")
+	fs.StringVar(&opts.ExternalSigner.TLSKeyFile, "external-signer-tls-key-file", "", "Client key file to authenticate with the external signing service")
+	fs.StringVar(&opts.Audit.FilePath, "audit-log-file", "", "Path of the file the audit records of issued and denied certificates are appended to, as JSON lines. Certificates are not issued if their audit record cannot be written")
+	fs.StringVar(&opts.Audit.OTLPEndpoint, "audit-otlp-endpoint", "", "Address of the OTLP gRPC endpoint the audit records of issued and denied certificates are exported to, as OpenTelemetry logs. Records are exported in background on a best-effort basis: records which cannot be exported are dropped, and do not block certificate issuance")
+	fs.BoolVar(&opts.Audit.OTLPInsecure, "audit-otlp-insecure", false, "Disable TLS to the audit OTLP endpoint")
 	fs.StringVar(&opts.TrustDomain, "trust-domain", "localhost", "The CA trust domain")
+	fs.DurationVar(&opts.TrustAnchorPropagationPeriod, "trust-anchor-propagation-period", config.DefaultTrustAnchorPropagationPeriod, "Minimum time a trust anchor rotation publishes the new root alongside the old one, before issuing from the new issuer. The new issuer doesn't issue either until no client has reported the old trust anchors for a workload certificate lifetime. Trust anchor rotation is not supported with an external signer, and only starts and completes while a single Sentry instance runs")
 	fs.IntVar(&opts.Port, "port", config.DefaultPort, "The port for the sentry server to listen on")
 	fs.StringVar(&opts.ListenAddress, "listen-address", "", "The listen address for the sentry server")
 	fs.IntVar(&opts.HealthzPort, "healthz-port", 8080, "The port for the healthz server to listen on")
@@ -200,5 +226,10 @@ func (o *Options) Validate() error {
 		return errors.New("jwt-issuer cannot be set when jwt-enabled is false")
 	}
 
+	if o.ExternalSigner.Address == "" && (o.ExternalSigner.KeyID != "" ||
+		o.ExternalSigner.TLSCAFile != "" || o.ExternalSigner.TLSCertFile != "" || o.ExternalSigner.TLSKeyFile != "") {
+		return errors.New("external-signer-address is required when other external-signer flags are set")
+	}
+
 	return nil
 }
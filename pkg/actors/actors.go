@@ -61,6 +61,7 @@ type Options struct {
 	Namespace          string
 	Port               int
 	PlacementAddresses []string
+	PlacementWeight    uint32
 	HealthEndpoint     string
 	Resiliency         resiliency.Provider
 	Security           security.Handler
@@ -103,6 +104,7 @@ type actors struct {
 	namespace          string
 	port               int
 	placementAddresses []string
+	placementWeight    uint32
 	healthEndpoint     string
 	resiliency         resiliency.Provider
 	security           security.Handler
@@ -149,6 +151,7 @@ func New(opts Options) Interface {
 		namespace:          opts.Namespace,
 		port:               opts.Port,
 		placementAddresses: opts.PlacementAddresses,
+		placementWeight:    opts.PlacementWeight,
 		healthEndpoint:     opts.HealthEndpoint,
 		resiliency:         opts.Resiliency,
 		security:           opts.Security,
@@ -197,6 +200,7 @@ func (a *actors) Init(opts InitOptions) error {
 	a.placement, err = placement.New(placement.Options{
 		AppID:     a.appID,
 		Addresses: a.placementAddresses,
+		Weight:    a.placementWeight,
 		Security:  a.security,
 		Table:     a.table,
 		Namespace: a.namespace,
@@ -49,6 +49,11 @@ const (
 	updateOperation = "update"
 
 	statusReportHeartbeatInterval = 1 * time.Second
+
+	// hostAPILevel is the actor API level advertised by this runtime. From
+	// hashing.WeightedAPILevel, the weights of the hosts are applied to the hash
+	// rings.
+	hostAPILevel = hashing.WeightedAPILevel
 )
 
 type Interface interface {
@@ -65,6 +70,9 @@ type Options struct {
 	Hostname  string
 	Port      int
 	Addresses []string
+	// Weight is the capacity of this host relative to the other hosts of its
+	// actor types. Defaults to 1.
+	Weight uint32
 
 	Scheduler schedclient.Reloader
 	APILevel  *apilevel.APILevel
@@ -115,8 +123,9 @@ func New(opts Options) (Interface, error) {
 		BaseHost: &v1pb.Host{
 			Name:      opts.Hostname + ":" + strconv.Itoa(opts.Port),
 			Id:        opts.AppID,
-			ApiLevel:  20,
+			ApiLevel:  hostAPILevel,
 			Namespace: opts.Namespace,
+			Weight:    opts.Weight,
 		},
 	})
 	if err != nil {
@@ -308,10 +317,17 @@ func (p *placement) handleUpdateOperation(ctx context.Context, in *v1pb.Placemen
 
 	entries := make(map[string]*hashing.Consistent)
 
+	// Weights are only applied once every host of the cluster understands them,
+	// so that all hosts compute the same hash rings.
+	weighted := in.GetApiLevel() >= hashing.WeightedAPILevel
+
 	for k, v := range in.GetEntries() {
 		loadMap := make(map[string]*hashing.Host, len(v.GetLoadMap()))
 		for lk, lv := range v.GetLoadMap() {
 			loadMap[lk] = hashing.NewHost(lv.GetName(), lv.GetId(), lv.GetLoad(), lv.GetPort())
+			if weighted {
+				loadMap[lk].Weight = lv.GetWeight()
+			}
 		}
 
 		entries[k] = hashing.NewFromExisting(loadMap, in.GetReplicationFactor(), p.virtualNodesCache)
@@ -15,6 +15,7 @@ package state
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -24,6 +25,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors/internal/key"
 	"github.com/dapr/dapr/pkg/actors/internal/placement"
 	"github.com/dapr/dapr/pkg/actors/table"
+	"github.com/dapr/dapr/pkg/encryption"
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
@@ -131,8 +133,13 @@ func (s *state) Get(ctx context.Context, req *api.GetStateRequest, lock bool) (*
 		return &api.StateResponse{}, nil
 	}
 
+	data, err := decryptValue(ctx, storeName, resp.Data)
+	if err != nil {
+		return nil, err
+	}
+
 	return &api.StateResponse{
-		Data:     resp.Data,
+		Data:     data,
 		Metadata: resp.Metadata,
 	}, nil
 }
@@ -184,8 +191,13 @@ func (s *state) GetBulk(ctx context.Context, req *api.GetBulkStateRequest, lock
 			return nil, fmt.Errorf("failed to retrieve key '%s': %s", r.Key, r.Error)
 		}
 
+		data, err := decryptValue(ctx, storeName, r.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve key '%s': %w", r.Key, err)
+		}
+
 		// Trim the prefix from the key
-		bulkRes[strings.TrimPrefix(r.Key, baseKey)] = r.Data
+		bulkRes[strings.TrimPrefix(r.Key, baseKey)] = data
 	}
 
 	return bulkRes, nil
@@ -237,6 +249,20 @@ func (s *state) executeStateStoreTransaction(ctx context.Context, operations []c
 			return ErrTransactionsTooManyOperations
 		}
 	}
+
+	if encryption.EnvelopeEncryptedStateStore(storeName) {
+		for i, op := range operations {
+			req, ok := op.(contribstate.SetRequest)
+			if !ok {
+				continue
+			}
+			req.Value, err = encryptValue(ctx, storeName, req.Value)
+			if err != nil {
+				return err
+			}
+			operations[i] = req
+		}
+	}
 	stateReq := &contribstate.TransactionalStateRequest{
 		Operations: operations,
 		Metadata:   metadata,
@@ -264,6 +290,32 @@ func (s *state) stateStore() (string, Backend, error) {
 	return s.storeName, store, nil
 }
 
+// encryptValue encrypts an actor state value with envelope encryption.
+// Values which are not raw bytes are serialized as JSON, as state stores do.
+func encryptValue(ctx context.Context, storeName string, value any) ([]byte, error) {
+	data, ok := value.([]byte)
+	if !ok {
+		var err error
+		data, err = json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return encryption.TryEncryptValue(ctx, storeName, data)
+}
+
+// decryptValue decrypts an actor state value if it was encrypted with envelope
+// encryption. Actor state is only encrypted with envelope encryption, so values
+// written before it was enabled are returned as is.
+func decryptValue(ctx context.Context, storeName string, data []byte) ([]byte, error) {
+	if !encryption.IsEnvelopeEncrypted(data) {
+		return data, nil
+	}
+
+	return encryption.TryDecryptValue(ctx, storeName, data)
+}
+
 func (s *state) constructActorStateKey(actorKey, actorID string) string {
 	return key.ConstructComposite(s.appID, actorKey, actorID)
 }
@@ -93,6 +93,101 @@ func (p *PubSubError) PublishForbidden(topic, appID string, err error) error {
 	)
 }
 
+func (p *PubSubError) SubscribeForbidden(topic, appID string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.PermissionDenied,
+		http.StatusForbidden,
+		fmt.Sprintf("subscribing to topic %s is not allowed for app id %s", topic, appID),
+		errorcodes.PubsubSubscribeForbidden,
+	)
+}
+
+func (p *PubSubError) SchedulePublish(topic string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.Internal,
+		http.StatusInternalServerError,
+		fmt.Sprintf("error when scheduling message to topic %s in pubsub %s: %s", topic, p.name, err),
+		errorcodes.PubsubSchedulePublish,
+	)
+}
+
+func (p *PubSubError) CancelScheduled(id string, err error) error {
+	return p.WithMetadata(map[string]string{
+		"id":    id,
+		"error": err.Error(),
+	}).build(
+		codes.Internal,
+		http.StatusInternalServerError,
+		fmt.Sprintf("error when cancelling scheduled message %s in pubsub %s: %s", id, p.name, err),
+		errorcodes.PubsubCancelScheduled,
+	)
+}
+
+func (p *PubSubError) ScheduledInvalid(id string, err error) error {
+	return p.WithMetadata(map[string]string{
+		"id":    id,
+		"error": err.Error(),
+	}).build(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		fmt.Sprintf("invalid scheduled message %s in pubsub %s: %s", id, p.name, err),
+		errorcodes.PubsubScheduledInvalid,
+	)
+}
+
+func (p *PubSubError) ScheduledNotFound(id string) error {
+	return p.WithMetadata(map[string]string{
+		"id": id,
+	}).build(
+		codes.NotFound,
+		http.StatusNotFound,
+		fmt.Sprintf("scheduled message %s is not found in pubsub %s", id, p.name),
+		errorcodes.PubsubScheduledNotFound,
+	)
+}
+
+func (p *PubSubError) ReplayInvalid(err error) error {
+	return p.WithMetadata(map[string]string{
+		"error": err.Error(),
+	}).build(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		fmt.Sprintf("invalid dead letter replay request for pubsub %s: %s", p.name, err),
+		errorcodes.PubsubReplayInvalid,
+	)
+}
+
+func (p *PubSubError) ReplayNotFound(id string) error {
+	return p.WithMetadata(map[string]string{
+		"id": id,
+	}).build(
+		codes.NotFound,
+		http.StatusNotFound,
+		fmt.Sprintf("dead letter replay job %s is not found in pubsub %s", id, p.name),
+		errorcodes.PubsubReplayNotFound,
+	)
+}
+
+func (p *PubSubError) SubscriptionNotFound(topic string) error {
+	return p.WithMetadata(map[string]string{
+		"topic": topic,
+	}).build(
+		codes.NotFound,
+		http.StatusNotFound,
+		fmt.Sprintf("subscription to topic %s is not found in pubsub %s", topic, p.name),
+		errorcodes.PubsubSubscriptionNotFound,
+	)
+}
+
+func (p *PubSubError) SchemaValidation(topic string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		fmt.Sprintf("invalid message for topic %s in pubsub %s: %s", topic, p.name, err),
+		errorcodes.PubsubSchemaValidation,
+	)
+}
+
 // TestNotFound is specifically for the error we are expecting for the api_tests. The not found
 // expected error codes are different than the existing ones for PubSubNotFound, hence
 // why this one is needed
@@ -37,6 +37,19 @@ func SchedulerURLName(metadata map[string]string) error {
 		Build()
 }
 
+func SchedulerReservedJobName(name string, metadata map[string]string) error {
+	message := "Job name " + name + " uses a prefix which is reserved by Dapr"
+	return kiterrors.NewBuilder(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		message,
+		"",
+		string(errorcodes.SchedulerJobName.Category),
+	).
+		WithErrorInfo(errorcodes.SchedulerJobName.Code, metadata).
+		Build()
+}
+
 func SchedulerScheduleJob(metadata map[string]string, err error) error {
 	code := status.Code(err)
 	if code == codes.Unknown {
@@ -153,6 +153,36 @@ func (s *StateStoreError) QueryFailed(detail string) error {
 	)
 }
 
+/**** Subscribe State Changes API ****/
+
+func (s *StateStoreError) SubscribeChangesKeysRequired() error {
+	return s.build(
+		errors.NewBuilder(
+			codes.InvalidArgument,
+			http.StatusBadRequest,
+			fmt.Sprintf("at least one key or key prefix is required to subscribe to the changes of state store %s", s.name),
+			errorcodes.StateSubscribeChanges.Code,
+			string(errorcodes.StateSubscribeChanges.Category),
+		),
+		errorcodes.StateSubscribeChanges.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) SubscribeChangesFailed(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.Internal,
+			http.StatusInternalServerError,
+			fmt.Sprintf("subscription to the changes of state store %s failed: %s", s.name, detail),
+			errorcodes.StateSubscribeChanges.Code,
+			string(errorcodes.StateSubscribeChanges.Category),
+		),
+		errorcodes.StateSubscribeChanges.GrpcCode,
+		nil,
+	)
+}
+
 func (s *StateStoreError) build(err *errors.ErrorBuilder, errCode string, metadata map[string]string) error {
 	if !s.skipResourceInfo {
 		err = err.WithResourceInfo("state", s.name, "", "")
@@ -0,0 +1,524 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	grpcGo "google.golang.org/grpc"
+	grpcMetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dapr/dapr/pkg/api/grpc/metadata"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/proto/runtime/v1/runtimeconnect"
+)
+
+// connectHandler serves the Dapr service over the Connect, gRPC and gRPC-Web
+// protocols on an HTTP server.
+// Requests are handled by the same API as the gRPC API server, through the
+// same interceptors, so they are subject to the same authentication, API
+// access lists and middleware.
+type connectHandler struct {
+	api    API
+	unary  grpcGo.UnaryServerInterceptor
+	stream grpcGo.StreamServerInterceptor
+}
+
+var _ runtimeconnect.DaprHandler = (*connectHandler)(nil)
+
+// connectUnary handles a unary request with the API method fn.
+func connectUnary[Req, Res any](ctx context.Context, h *connectHandler, req *connect.Request[Req], fn func(context.Context, *Req) (*Res, error)) (*connect.Response[Res], error) {
+	ts := &connectTransportStream{
+		method:  req.Spec().Procedure,
+		header:  make(http.Header),
+		trailer: make(http.Header),
+	}
+	ctx = connectIncomingContext(ctx, ts, req.Header())
+
+	info := &grpcGo.UnaryServerInfo{
+		Server:     h.api,
+		FullMethod: ts.method,
+	}
+	res, err := h.unary(ctx, req.Msg, info, func(ctx context.Context, req any) (any, error) {
+		return fn(ctx, req.(*Req))
+	})
+	if err != nil {
+		return nil, connectError(err, ts)
+	}
+
+	msg, _ := res.(*Res)
+	if msg == nil {
+		msg = new(Res)
+	}
+	resp := connect.NewResponse(msg)
+	copyHeader(resp.Header(), ts.header)
+	copyHeader(resp.Trailer(), ts.trailer)
+	return resp, nil
+}
+
+// serveStream handles a streaming request with fn, which calls the API method
+// with the stream it is given.
+func (h *connectHandler) serveStream(ctx context.Context, spec connect.Spec, header http.Header, conn connect.StreamingHandlerConn, fn func(grpcGo.ServerStream) error) error {
+	ts := &connectTransportStream{
+		method:  spec.Procedure,
+		header:  conn.ResponseHeader(),
+		trailer: conn.ResponseTrailer(),
+	}
+	ss := &connectServerStream{
+		ctx:  connectIncomingContext(ctx, ts, header),
+		conn: conn,
+		ts:   ts,
+	}
+
+	info := &grpcGo.StreamServerInfo{
+		FullMethod:     spec.Procedure,
+		IsClientStream: spec.StreamType == connect.StreamTypeClient || spec.StreamType == connect.StreamTypeBidi,
+		IsServerStream: spec.StreamType == connect.StreamTypeServer || spec.StreamType == connect.StreamTypeBidi,
+	}
+	err := h.stream(h.api, ss, info, func(_ any, ss grpcGo.ServerStream) error {
+		return fn(ss)
+	})
+	if err != nil {
+		return connectError(err, ts)
+	}
+	return nil
+}
+
+// connectIncomingContext returns a context with the request headers as the
+// incoming gRPC metadata, as the gRPC server does.
+func connectIncomingContext(ctx context.Context, ts *connectTransportStream, header http.Header) context.Context {
+	md := make(grpcMetadata.MD, len(header))
+	for k, vs := range header {
+		k = strings.ToLower(k)
+		if !strings.HasSuffix(k, "-bin") {
+			md.Append(k, vs...)
+			continue
+		}
+		for _, v := range vs {
+			b, err := connect.DecodeBinaryHeader(v)
+			if err == nil {
+				md.Append(k, string(b))
+			}
+		}
+	}
+
+	ctx = grpcMetadata.NewIncomingContext(ctx, md)
+	ctx = grpcGo.NewContextWithServerTransportStream(ctx, ts)
+	ctx, _ = metadata.SetMetadataInTapHandle(ctx, nil)
+	return ctx
+}
+
+// connectError converts an error returned by the API to a Connect error,
+// keeping its code and details.
+func connectError(err error, ts *connectTransportStream) error {
+	var cerr *connect.Error
+	if errors.As(err, &cerr) {
+		return err
+	}
+
+	st := status.Convert(err)
+	cerr = connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, d := range st.Proto().GetDetails() {
+		detail, derr := connect.NewErrorDetail(d)
+		if derr == nil {
+			cerr.AddDetail(detail)
+		}
+	}
+	copyHeader(cerr.Meta(), ts.header)
+	copyHeader(cerr.Meta(), ts.trailer)
+	return cerr
+}
+
+func copyHeader(dst, src http.Header) {
+	for k, vs := range src {
+		dst[k] = append(dst[k], vs...)
+	}
+}
+
+// connectTransportStream receives the headers and trailers set by the API with
+// grpc.SetHeader and grpc.SetTrailer.
+type connectTransportStream struct {
+	method  string
+	header  http.Header
+	trailer http.Header
+}
+
+func (s *connectTransportStream) Method() string {
+	return s.method
+}
+
+func (s *connectTransportStream) SetHeader(md grpcMetadata.MD) error {
+	appendMetadata(s.header, md)
+	return nil
+}
+
+func (s *connectTransportStream) SendHeader(md grpcMetadata.MD) error {
+	// Connect sends the headers with the first message.
+	appendMetadata(s.header, md)
+	return nil
+}
+
+func (s *connectTransportStream) SetTrailer(md grpcMetadata.MD) error {
+	appendMetadata(s.trailer, md)
+	return nil
+}
+
+func appendMetadata(dst http.Header, md grpcMetadata.MD) {
+	for k, vs := range md {
+		for _, v := range vs {
+			if strings.HasSuffix(k, "-bin") {
+				v = connect.EncodeBinaryHeader([]byte(v))
+			}
+			dst.Add(k, v)
+		}
+	}
+}
+
+// connectServerStream implements grpc.ServerStream on a Connect stream.
+type connectServerStream struct {
+	ctx  context.Context
+	conn connect.StreamingHandlerConn
+	ts   *connectTransportStream
+}
+
+func (s *connectServerStream) SetHeader(md grpcMetadata.MD) error {
+	return s.ts.SetHeader(md)
+}
+
+func (s *connectServerStream) SendHeader(md grpcMetadata.MD) error {
+	return s.ts.SendHeader(md)
+}
+
+func (s *connectServerStream) SetTrailer(md grpcMetadata.MD) {
+	s.ts.SetTrailer(md)
+}
+
+func (s *connectServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *connectServerStream) SendMsg(m any) error {
+	return s.conn.Send(m)
+}
+
+func (s *connectServerStream) RecvMsg(m any) error {
+	return s.conn.Receive(m)
+}
+
+// connectStreamServer implements the typed server of a streaming method of the
+// Dapr service, such as runtimev1pb.Dapr_EncryptAlpha1Server.
+type connectStreamServer[Req, Res any] struct {
+	grpcGo.ServerStream
+}
+
+func (s connectStreamServer[Req, Res]) Send(m *Res) error {
+	return s.SendMsg(m)
+}
+
+func (s connectStreamServer[Req, Res]) Recv() (*Req, error) {
+	m := new(Req)
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Methods of the Dapr service.
+
+func (h *connectHandler) InvokeService(ctx context.Context, req *connect.Request[runtimev1pb.InvokeServiceRequest]) (*connect.Response[commonv1pb.InvokeResponse], error) {
+	return connectUnary(ctx, h, req, h.api.InvokeService)
+}
+
+func (h *connectHandler) GetState(ctx context.Context, req *connect.Request[runtimev1pb.GetStateRequest]) (*connect.Response[runtimev1pb.GetStateResponse], error) {
+	return connectUnary(ctx, h, req, h.api.GetState)
+}
+
+func (h *connectHandler) GetBulkState(ctx context.Context, req *connect.Request[runtimev1pb.GetBulkStateRequest]) (*connect.Response[runtimev1pb.GetBulkStateResponse], error) {
+	return connectUnary(ctx, h, req, h.api.GetBulkState)
+}
+
+func (h *connectHandler) SaveState(ctx context.Context, req *connect.Request[runtimev1pb.SaveStateRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.SaveState)
+}
+
+func (h *connectHandler) QueryStateAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.QueryStateRequest]) (*connect.Response[runtimev1pb.QueryStateResponse], error) {
+	return connectUnary(ctx, h, req, h.api.QueryStateAlpha1)
+}
+
+func (h *connectHandler) DeleteState(ctx context.Context, req *connect.Request[runtimev1pb.DeleteStateRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.DeleteState)
+}
+
+func (h *connectHandler) DeleteBulkState(ctx context.Context, req *connect.Request[runtimev1pb.DeleteBulkStateRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.DeleteBulkState)
+}
+
+func (h *connectHandler) ExecuteStateTransaction(ctx context.Context, req *connect.Request[runtimev1pb.ExecuteStateTransactionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.ExecuteStateTransaction)
+}
+
+func (h *connectHandler) SubscribeStateChangesAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.SubscribeStateChangesRequestAlpha1], stream *connect.ServerStream[runtimev1pb.SubscribeStateChangesResponseAlpha1]) error {
+	return h.serveStream(ctx, req.Spec(), req.Header(), stream.Conn(), func(ss grpcGo.ServerStream) error {
+		return h.api.SubscribeStateChangesAlpha1(req.Msg, connectStreamServer[runtimev1pb.SubscribeStateChangesRequestAlpha1, runtimev1pb.SubscribeStateChangesResponseAlpha1]{ss})
+	})
+}
+
+func (h *connectHandler) PublishEvent(ctx context.Context, req *connect.Request[runtimev1pb.PublishEventRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.PublishEvent)
+}
+
+func (h *connectHandler) CancelScheduledPublishEventAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.CancelScheduledPublishEventRequestAlpha1]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.CancelScheduledPublishEventAlpha1)
+}
+
+func (h *connectHandler) BulkPublishEventAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.BulkPublishRequest]) (*connect.Response[runtimev1pb.BulkPublishResponse], error) {
+	return connectUnary(ctx, h, req, h.api.BulkPublishEventAlpha1)
+}
+
+func (h *connectHandler) SubscribeTopicEventsAlpha1(ctx context.Context, stream *connect.BidiStream[runtimev1pb.SubscribeTopicEventsRequestAlpha1, runtimev1pb.SubscribeTopicEventsResponseAlpha1]) error {
+	return h.serveStream(ctx, stream.Spec(), stream.RequestHeader(), stream.Conn(), func(ss grpcGo.ServerStream) error {
+		return h.api.SubscribeTopicEventsAlpha1(connectStreamServer[runtimev1pb.SubscribeTopicEventsRequestAlpha1, runtimev1pb.SubscribeTopicEventsResponseAlpha1]{ss})
+	})
+}
+
+func (h *connectHandler) InvokeBinding(ctx context.Context, req *connect.Request[runtimev1pb.InvokeBindingRequest]) (*connect.Response[runtimev1pb.InvokeBindingResponse], error) {
+	return connectUnary(ctx, h, req, h.api.InvokeBinding)
+}
+
+func (h *connectHandler) GetSecret(ctx context.Context, req *connect.Request[runtimev1pb.GetSecretRequest]) (*connect.Response[runtimev1pb.GetSecretResponse], error) {
+	return connectUnary(ctx, h, req, h.api.GetSecret)
+}
+
+func (h *connectHandler) GetBulkSecret(ctx context.Context, req *connect.Request[runtimev1pb.GetBulkSecretRequest]) (*connect.Response[runtimev1pb.GetBulkSecretResponse], error) {
+	return connectUnary(ctx, h, req, h.api.GetBulkSecret)
+}
+
+func (h *connectHandler) RegisterActorTimer(ctx context.Context, req *connect.Request[runtimev1pb.RegisterActorTimerRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.RegisterActorTimer)
+}
+
+func (h *connectHandler) UnregisterActorTimer(ctx context.Context, req *connect.Request[runtimev1pb.UnregisterActorTimerRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.UnregisterActorTimer)
+}
+
+func (h *connectHandler) RegisterActorReminder(ctx context.Context, req *connect.Request[runtimev1pb.RegisterActorReminderRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.RegisterActorReminder)
+}
+
+func (h *connectHandler) UnregisterActorReminder(ctx context.Context, req *connect.Request[runtimev1pb.UnregisterActorReminderRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.UnregisterActorReminder)
+}
+
+func (h *connectHandler) UnregisterActorRemindersByType(ctx context.Context, req *connect.Request[runtimev1pb.UnregisterActorRemindersByTypeRequest]) (*connect.Response[runtimev1pb.UnregisterActorRemindersByTypeResponse], error) {
+	return connectUnary(ctx, h, req, h.api.UnregisterActorRemindersByType)
+}
+
+func (h *connectHandler) ListActorReminders(ctx context.Context, req *connect.Request[runtimev1pb.ListActorRemindersRequest]) (*connect.Response[runtimev1pb.ListActorRemindersResponse], error) {
+	return connectUnary(ctx, h, req, h.api.ListActorReminders)
+}
+
+func (h *connectHandler) GetActorState(ctx context.Context, req *connect.Request[runtimev1pb.GetActorStateRequest]) (*connect.Response[runtimev1pb.GetActorStateResponse], error) {
+	return connectUnary(ctx, h, req, h.api.GetActorState)
+}
+
+func (h *connectHandler) GetActorReminder(ctx context.Context, req *connect.Request[runtimev1pb.GetActorReminderRequest]) (*connect.Response[runtimev1pb.GetActorReminderResponse], error) {
+	return connectUnary(ctx, h, req, h.api.GetActorReminder)
+}
+
+func (h *connectHandler) ExecuteActorStateTransaction(ctx context.Context, req *connect.Request[runtimev1pb.ExecuteActorStateTransactionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.ExecuteActorStateTransaction)
+}
+
+func (h *connectHandler) InvokeActor(ctx context.Context, req *connect.Request[runtimev1pb.InvokeActorRequest]) (*connect.Response[runtimev1pb.InvokeActorResponse], error) {
+	return connectUnary(ctx, h, req, h.api.InvokeActor)
+}
+
+func (h *connectHandler) GetConfigurationAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.GetConfigurationRequest]) (*connect.Response[runtimev1pb.GetConfigurationResponse], error) {
+	return connectUnary(ctx, h, req, h.api.GetConfigurationAlpha1)
+}
+
+func (h *connectHandler) GetConfiguration(ctx context.Context, req *connect.Request[runtimev1pb.GetConfigurationRequest]) (*connect.Response[runtimev1pb.GetConfigurationResponse], error) {
+	return connectUnary(ctx, h, req, h.api.GetConfiguration)
+}
+
+func (h *connectHandler) SubscribeConfigurationAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.SubscribeConfigurationRequest], stream *connect.ServerStream[runtimev1pb.SubscribeConfigurationResponse]) error {
+	return h.serveStream(ctx, req.Spec(), req.Header(), stream.Conn(), func(ss grpcGo.ServerStream) error {
+		return h.api.SubscribeConfigurationAlpha1(req.Msg, connectStreamServer[runtimev1pb.SubscribeConfigurationRequest, runtimev1pb.SubscribeConfigurationResponse]{ss})
+	})
+}
+
+func (h *connectHandler) SubscribeConfiguration(ctx context.Context, req *connect.Request[runtimev1pb.SubscribeConfigurationRequest], stream *connect.ServerStream[runtimev1pb.SubscribeConfigurationResponse]) error {
+	return h.serveStream(ctx, req.Spec(), req.Header(), stream.Conn(), func(ss grpcGo.ServerStream) error {
+		return h.api.SubscribeConfiguration(req.Msg, connectStreamServer[runtimev1pb.SubscribeConfigurationRequest, runtimev1pb.SubscribeConfigurationResponse]{ss})
+	})
+}
+
+func (h *connectHandler) UnsubscribeConfigurationAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.UnsubscribeConfigurationRequest]) (*connect.Response[runtimev1pb.UnsubscribeConfigurationResponse], error) {
+	return connectUnary(ctx, h, req, h.api.UnsubscribeConfigurationAlpha1)
+}
+
+func (h *connectHandler) UnsubscribeConfiguration(ctx context.Context, req *connect.Request[runtimev1pb.UnsubscribeConfigurationRequest]) (*connect.Response[runtimev1pb.UnsubscribeConfigurationResponse], error) {
+	return connectUnary(ctx, h, req, h.api.UnsubscribeConfiguration)
+}
+
+func (h *connectHandler) TryLockAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.TryLockRequest]) (*connect.Response[runtimev1pb.TryLockResponse], error) {
+	return connectUnary(ctx, h, req, h.api.TryLockAlpha1)
+}
+
+func (h *connectHandler) UnlockAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.UnlockRequest]) (*connect.Response[runtimev1pb.UnlockResponse], error) {
+	return connectUnary(ctx, h, req, h.api.UnlockAlpha1)
+}
+
+func (h *connectHandler) EncryptAlpha1(ctx context.Context, stream *connect.BidiStream[runtimev1pb.EncryptRequest, runtimev1pb.EncryptResponse]) error {
+	return h.serveStream(ctx, stream.Spec(), stream.RequestHeader(), stream.Conn(), func(ss grpcGo.ServerStream) error {
+		return h.api.EncryptAlpha1(connectStreamServer[runtimev1pb.EncryptRequest, runtimev1pb.EncryptResponse]{ss})
+	})
+}
+
+func (h *connectHandler) DecryptAlpha1(ctx context.Context, stream *connect.BidiStream[runtimev1pb.DecryptRequest, runtimev1pb.DecryptResponse]) error {
+	return h.serveStream(ctx, stream.Spec(), stream.RequestHeader(), stream.Conn(), func(ss grpcGo.ServerStream) error {
+		return h.api.DecryptAlpha1(connectStreamServer[runtimev1pb.DecryptRequest, runtimev1pb.DecryptResponse]{ss})
+	})
+}
+
+func (h *connectHandler) GetMetadata(ctx context.Context, req *connect.Request[runtimev1pb.GetMetadataRequest]) (*connect.Response[runtimev1pb.GetMetadataResponse], error) {
+	return connectUnary(ctx, h, req, h.api.GetMetadata)
+}
+
+func (h *connectHandler) SetMetadata(ctx context.Context, req *connect.Request[runtimev1pb.SetMetadataRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.SetMetadata)
+}
+
+func (h *connectHandler) SubtleGetKeyAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.SubtleGetKeyRequest]) (*connect.Response[runtimev1pb.SubtleGetKeyResponse], error) {
+	return connectUnary(ctx, h, req, h.api.SubtleGetKeyAlpha1)
+}
+
+func (h *connectHandler) SubtleEncryptAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.SubtleEncryptRequest]) (*connect.Response[runtimev1pb.SubtleEncryptResponse], error) {
+	return connectUnary(ctx, h, req, h.api.SubtleEncryptAlpha1)
+}
+
+func (h *connectHandler) SubtleDecryptAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.SubtleDecryptRequest]) (*connect.Response[runtimev1pb.SubtleDecryptResponse], error) {
+	return connectUnary(ctx, h, req, h.api.SubtleDecryptAlpha1)
+}
+
+func (h *connectHandler) SubtleWrapKeyAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.SubtleWrapKeyRequest]) (*connect.Response[runtimev1pb.SubtleWrapKeyResponse], error) {
+	return connectUnary(ctx, h, req, h.api.SubtleWrapKeyAlpha1)
+}
+
+func (h *connectHandler) SubtleUnwrapKeyAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.SubtleUnwrapKeyRequest]) (*connect.Response[runtimev1pb.SubtleUnwrapKeyResponse], error) {
+	return connectUnary(ctx, h, req, h.api.SubtleUnwrapKeyAlpha1)
+}
+
+func (h *connectHandler) SubtleSignAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.SubtleSignRequest]) (*connect.Response[runtimev1pb.SubtleSignResponse], error) {
+	return connectUnary(ctx, h, req, h.api.SubtleSignAlpha1)
+}
+
+func (h *connectHandler) SubtleVerifyAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.SubtleVerifyRequest]) (*connect.Response[runtimev1pb.SubtleVerifyResponse], error) {
+	return connectUnary(ctx, h, req, h.api.SubtleVerifyAlpha1)
+}
+
+func (h *connectHandler) StartWorkflowAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.StartWorkflowRequest]) (*connect.Response[runtimev1pb.StartWorkflowResponse], error) {
+	return connectUnary(ctx, h, req, h.api.StartWorkflowAlpha1)
+}
+
+func (h *connectHandler) GetWorkflowAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.GetWorkflowRequest]) (*connect.Response[runtimev1pb.GetWorkflowResponse], error) {
+	return connectUnary(ctx, h, req, h.api.GetWorkflowAlpha1)
+}
+
+func (h *connectHandler) PurgeWorkflowAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.PurgeWorkflowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.PurgeWorkflowAlpha1)
+}
+
+func (h *connectHandler) TerminateWorkflowAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.TerminateWorkflowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.TerminateWorkflowAlpha1)
+}
+
+func (h *connectHandler) PauseWorkflowAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.PauseWorkflowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.PauseWorkflowAlpha1)
+}
+
+func (h *connectHandler) ResumeWorkflowAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.ResumeWorkflowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.ResumeWorkflowAlpha1)
+}
+
+func (h *connectHandler) RaiseEventWorkflowAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.RaiseEventWorkflowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.RaiseEventWorkflowAlpha1)
+}
+
+func (h *connectHandler) StartWorkflowBeta1(ctx context.Context, req *connect.Request[runtimev1pb.StartWorkflowRequest]) (*connect.Response[runtimev1pb.StartWorkflowResponse], error) {
+	return connectUnary(ctx, h, req, h.api.StartWorkflowBeta1)
+}
+
+func (h *connectHandler) GetWorkflowBeta1(ctx context.Context, req *connect.Request[runtimev1pb.GetWorkflowRequest]) (*connect.Response[runtimev1pb.GetWorkflowResponse], error) {
+	return connectUnary(ctx, h, req, h.api.GetWorkflowBeta1)
+}
+
+func (h *connectHandler) PurgeWorkflowBeta1(ctx context.Context, req *connect.Request[runtimev1pb.PurgeWorkflowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.PurgeWorkflowBeta1)
+}
+
+func (h *connectHandler) TerminateWorkflowBeta1(ctx context.Context, req *connect.Request[runtimev1pb.TerminateWorkflowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.TerminateWorkflowBeta1)
+}
+
+func (h *connectHandler) PauseWorkflowBeta1(ctx context.Context, req *connect.Request[runtimev1pb.PauseWorkflowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.PauseWorkflowBeta1)
+}
+
+func (h *connectHandler) ResumeWorkflowBeta1(ctx context.Context, req *connect.Request[runtimev1pb.ResumeWorkflowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.ResumeWorkflowBeta1)
+}
+
+func (h *connectHandler) RaiseEventWorkflowBeta1(ctx context.Context, req *connect.Request[runtimev1pb.RaiseEventWorkflowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.RaiseEventWorkflowBeta1)
+}
+
+func (h *connectHandler) Shutdown(ctx context.Context, req *connect.Request[runtimev1pb.ShutdownRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connectUnary(ctx, h, req, h.api.Shutdown)
+}
+
+func (h *connectHandler) ScheduleJobAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.ScheduleJobRequest]) (*connect.Response[runtimev1pb.ScheduleJobResponse], error) {
+	return connectUnary(ctx, h, req, h.api.ScheduleJobAlpha1)
+}
+
+func (h *connectHandler) GetJobAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.GetJobRequest]) (*connect.Response[runtimev1pb.GetJobResponse], error) {
+	return connectUnary(ctx, h, req, h.api.GetJobAlpha1)
+}
+
+func (h *connectHandler) DeleteJobAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.DeleteJobRequest]) (*connect.Response[runtimev1pb.DeleteJobResponse], error) {
+	return connectUnary(ctx, h, req, h.api.DeleteJobAlpha1)
+}
+
+func (h *connectHandler) DeleteJobsByPrefixAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.DeleteJobsByPrefixRequestAlpha1]) (*connect.Response[runtimev1pb.DeleteJobsByPrefixResponseAlpha1], error) {
+	return connectUnary(ctx, h, req, h.api.DeleteJobsByPrefixAlpha1)
+}
+
+func (h *connectHandler) ListJobsAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.ListJobsRequestAlpha1]) (*connect.Response[runtimev1pb.ListJobsResponseAlpha1], error) {
+	return connectUnary(ctx, h, req, h.api.ListJobsAlpha1)
+}
+
+func (h *connectHandler) ConverseAlpha1(ctx context.Context, req *connect.Request[runtimev1pb.ConversationRequest]) (*connect.Response[runtimev1pb.ConversationResponse], error) {
+	return connectUnary(ctx, h, req, h.api.ConverseAlpha1)
+}
+
+func (h *connectHandler) ConverseAlpha2(ctx context.Context, req *connect.Request[runtimev1pb.ConversationRequestAlpha2]) (*connect.Response[runtimev1pb.ConversationResponseAlpha2], error) {
+	return connectUnary(ctx, h, req, h.api.ConverseAlpha2)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcMetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/pkg/config"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/proto/runtime/v1/runtimeconnect"
+	securityConsts "github.com/dapr/dapr/pkg/security/consts"
+	"github.com/dapr/kit/logger"
+)
+
+type connectTestAPI struct {
+	runtimev1pb.UnimplementedDaprServer
+	internalv1pb.UnimplementedServiceInvocationServer
+}
+
+func (connectTestAPI) Close() error {
+	return nil
+}
+
+func (connectTestAPI) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*runtimev1pb.GetStateResponse, error) {
+	if in.GetKey() == "missing" {
+		st, _ := status.New(codes.NotFound, "key not found").WithDetails(&errdetails.ErrorInfo{
+			Reason: "DAPR_STATE_NOT_FOUND",
+		})
+		return nil, st.Err()
+	}
+
+	grpc.SetHeader(ctx, grpcMetadata.Pairs("x-store", in.GetStoreName()))
+	return &runtimev1pb.GetStateResponse{Data: []byte(in.GetKey())}, nil
+}
+
+func (connectTestAPI) SubscribeConfiguration(in *runtimev1pb.SubscribeConfigurationRequest, stream runtimev1pb.Dapr_SubscribeConfigurationServer) error {
+	for _, key := range in.GetKeys() {
+		err := stream.Send(&runtimev1pb.SubscribeConfigurationResponse{Id: key})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestConnectHandler(t *testing.T) {
+	s := &server{
+		api:       connectTestAPI{},
+		config:    ServerConfig{},
+		logger:    logger.NewLogger("dapr.runtime.grpc.test"),
+		authToken: "token",
+		apiSpec: config.APISpec{
+			Denied: []config.APIAccessRule{
+				{Name: "configuration", Version: "v1", Protocol: "grpc"},
+			},
+		},
+	}
+	path, handler := s.ConnectHandler()
+	assert.Equal(t, "/"+runtimeconnect.DaprName+"/", path)
+
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	clients := map[string]runtimeconnect.DaprClient{
+		"connect":  runtimeconnect.NewDaprClient(srv.Client(), srv.URL),
+		"grpc-web": runtimeconnect.NewDaprClient(srv.Client(), srv.URL, connect.WithGRPCWeb()),
+	}
+	for name, client := range clients {
+		t.Run(name, func(t *testing.T) {
+			t.Run("unary", func(t *testing.T) {
+				req := connect.NewRequest(&runtimev1pb.GetStateRequest{StoreName: "store", Key: "key"})
+				req.Header().Set(securityConsts.APITokenHeader, "token")
+				res, err := client.GetState(t.Context(), req)
+				require.NoError(t, err)
+				assert.Equal(t, []byte("key"), res.Msg.GetData())
+				assert.Equal(t, "store", res.Header().Get("x-store"))
+			})
+
+			t.Run("unauthenticated", func(t *testing.T) {
+				req := connect.NewRequest(&runtimev1pb.GetStateRequest{StoreName: "store", Key: "key"})
+				_, err := client.GetState(t.Context(), req)
+				require.Error(t, err)
+				assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+			})
+
+			t.Run("error details", func(t *testing.T) {
+				req := connect.NewRequest(&runtimev1pb.GetStateRequest{StoreName: "store", Key: "missing"})
+				req.Header().Set(securityConsts.APITokenHeader, "token")
+				_, err := client.GetState(t.Context(), req)
+				require.Error(t, err)
+
+				var cerr *connect.Error
+				require.ErrorAs(t, err, &cerr)
+				assert.Equal(t, connect.CodeNotFound, cerr.Code())
+				assert.Equal(t, "key not found", cerr.Message())
+				require.Len(t, cerr.Details(), 1)
+				detail, err := cerr.Details()[0].Value()
+				require.NoError(t, err)
+				assert.Equal(t, "DAPR_STATE_NOT_FOUND", detail.(*errdetails.ErrorInfo).GetReason())
+			})
+
+			t.Run("denied by API access list", func(t *testing.T) {
+				req := connect.NewRequest(&runtimev1pb.SubscribeConfigurationRequest{Keys: []string{"a", "b"}})
+				req.Header().Set(securityConsts.APITokenHeader, "token")
+				stream, err := client.SubscribeConfiguration(t.Context(), req)
+				require.NoError(t, err)
+				assert.False(t, stream.Receive())
+				assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(stream.Err()))
+			})
+		})
+	}
+
+	t.Run("server stream", func(t *testing.T) {
+		s := &server{
+			api:       connectTestAPI{},
+			logger:    logger.NewLogger("dapr.runtime.grpc.test"),
+			authToken: "token",
+		}
+		_, handler := s.ConnectHandler()
+		srv := httptest.NewServer(handler)
+		t.Cleanup(srv.Close)
+
+		client := runtimeconnect.NewDaprClient(srv.Client(), srv.URL)
+		req := connect.NewRequest(&runtimev1pb.SubscribeConfigurationRequest{Keys: []string{"a", "b"}})
+		req.Header().Set(securityConsts.APITokenHeader, "token")
+		stream, err := client.SubscribeConfiguration(t.Context(), req)
+		require.NoError(t, err)
+
+		var ids []string
+		for stream.Receive() {
+			ids = append(ids, stream.Msg().GetId())
+		}
+		require.NoError(t, stream.Err())
+		assert.Equal(t, []string{"a", "b"}, ids)
+	})
+}
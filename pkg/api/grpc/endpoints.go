@@ -40,12 +40,14 @@ var endpoints = map[string][]string{
 	},
 	"state.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/QueryStateAlpha1",
+		daprRuntimePrefix + "v1.Dapr/SubscribeStateChangesAlpha1",
 	},
 	"publish.v1": {
 		daprRuntimePrefix + "v1.Dapr/PublishEvent",
 	},
 	"publish.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/BulkPublishEventAlpha1",
+		daprRuntimePrefix + "v1.Dapr/CancelScheduledPublishEventAlpha1",
 	},
 	"bindings.v1": {
 		daprRuntimePrefix + "v1.Dapr/InvokeBinding",
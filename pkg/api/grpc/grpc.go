@@ -64,14 +64,16 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/processor"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
 	"github.com/dapr/dapr/utils"
 	kiterrors "github.com/dapr/kit/errors"
 	"github.com/dapr/kit/logger"
 )
 
 const (
-	daprHTTPStatusHeader = "dapr-http-status"
-	metadataPrefix       = "metadata."
+	daprHTTPStatusHeader  = "dapr-http-status"
+	daprScheduledIDHeader = "dapr-scheduled-id"
+	metadataPrefix        = "metadata."
 )
 
 // API is the gRPC interface for the Dapr gRPC API. It implements both the internal and external proto definitions.
@@ -184,6 +186,10 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 
 	data := body
 
+	// The ID of a delayed message is the CloudEvent ID, or is generated when
+	// publishing a raw payload.
+	var scheduledID string
+
 	if !rawPayload {
 		span := diagUtils.SpanFromContext(ctx)
 		traceID, traceState := diag.TraceIDAndStateFromSpan(span)
@@ -207,6 +213,7 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 
 		features := thepubsub.Features()
 		pubsub.ApplyMetadata(envelope, features, in.GetMetadata())
+		scheduledID, _ = envelope[pubsub.IDField].(string)
 
 		data, err = json.Marshal(envelope)
 		if err != nil {
@@ -225,8 +232,24 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 		Metadata:   in.GetMetadata(),
 	}
 
+	deliverAt, delayed, err := publisher.DeliverAt(in.GetMetadata(), time.Now())
+	if err != nil {
+		nerr := apierrors.PubSub(pubsubName).WithMetadata(in.GetMetadata()).DeserializeError(err)
+		apiServerLogger.Debug(nerr)
+		return &emptypb.Empty{}, nerr
+	}
+	if delayed {
+		scheduledID, err = a.Universal.SchedulePublishEvent(ctx, &req, scheduledID, deliverAt)
+		if err != nil {
+			apiServerLogger.Debug(err)
+			return &emptypb.Empty{}, err
+		}
+		grpc.SetHeader(ctx, grpcMetadata.Pairs(daprScheduledIDHeader, scheduledID))
+		return &emptypb.Empty{}, nil
+	}
+
 	start := time.Now()
-	err := a.pubsubAdapter.Publish(ctx, &req)
+	err = a.pubsubAdapter.Publish(ctx, &req)
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.PubsubEgressEvent(context.Background(), pubsubName, topic, err == nil, elapsed)
@@ -239,6 +262,8 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 			nerr = apierrors.PubSub(pubsubName).PublishForbidden(topic, a.AppID(), err)
 		case errors.As(err, &runtimePubsub.NotFoundError{}):
 			nerr = apierrors.PubSub(pubsubName).TestNotFound(topic, err)
+		case errors.As(err, &runtimePubsub.SchemaValidationError{}):
+			nerr = apierrors.PubSub(pubsubName).SchemaValidation(topic, err)
 		default:
 			nerr = apierrors.PubSub(pubsubName).PublishMessage(topic, err)
 		}
@@ -250,6 +275,16 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 	return &emptypb.Empty{}, nil
 }
 
+func (a *api) CancelScheduledPublishEventAlpha1(ctx context.Context, in *runtimev1pb.CancelScheduledPublishEventRequestAlpha1) (*emptypb.Empty, error) {
+	err := a.Universal.CancelScheduledPublishEventAlpha1(ctx, in.GetPubsubName(), in.GetId())
+	if err != nil {
+		apiServerLogger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 type invokeServiceResp struct {
 	message  *commonv1pb.InvokeResponse
 	headers  metadata.MD
@@ -471,6 +506,8 @@ func (a *api) BulkPublishEventAlpha1(ctx context.Context, in *runtimev1pb.BulkPu
 			nerr = apierrors.PubSub(pubsubName).PublishForbidden(topic, a.AppID(), err)
 		case errors.As(err, &runtimePubsub.NotFoundError{}):
 			nerr = apierrors.PubSub(pubsubName).TestNotFound(topic, err)
+		case errors.As(err, &runtimePubsub.SchemaValidationError{}):
+			nerr = apierrors.PubSub(pubsubName).SchemaValidation(topic, err)
 		default:
 			nerr = apierrors.PubSub(pubsubName).PublishMessage(topic, err)
 		}
@@ -594,18 +631,21 @@ func (a *api) GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequ
 		reqs[i] = r
 	}
 
-	start := time.Now()
-	policyDef := a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore)
-	bgrPolicyRunner := resiliency.NewRunner[[]state.BulkGetResponse](ctx, policyDef)
-	responses, err := bgrPolicyRunner(func(ctx context.Context) ([]state.BulkGetResponse, error) {
-		return store.BulkGet(ctx, reqs, state.BulkGetOpts{
-			Parallelism: int(in.GetParallelism()),
+	cache := a.Universal.CompStore().GetStateStoreCache(in.GetStoreName())
+	responses, err := cache.BulkGet(ctx, reqs, func(ctx context.Context, reqs []state.GetRequest) ([]state.BulkGetResponse, error) {
+		start := time.Now()
+		policyDef := a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore)
+		bgrPolicyRunner := resiliency.NewRunner[[]state.BulkGetResponse](ctx, policyDef)
+		responses, err := bgrPolicyRunner(func(ctx context.Context) ([]state.BulkGetResponse, error) {
+			return store.BulkGet(ctx, reqs, state.BulkGetOpts{
+				Parallelism: int(in.GetParallelism()),
+			})
 		})
-	})
-
-	elapsed := diag.ElapsedSince(start)
-	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.BulkGet, err == nil, elapsed)
 
+		elapsed := diag.ElapsedSince(start)
+		diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.BulkGet, err == nil, elapsed)
+		return responses, err
+	})
 	if err != nil {
 		return bulkResp, err
 	}
@@ -629,7 +669,7 @@ func (a *api) GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequ
 				continue
 			}
 
-			val, err := encryption.TryDecryptValue(in.GetStoreName(), bulkResp.GetItems()[i].GetData())
+			val, err := encryption.TryDecryptValue(ctx, in.GetStoreName(), bulkResp.GetItems()[i].GetData())
 			if err != nil {
 				apiServerLogger.Debugf("Bulk get error: %v", err)
 				bulkResp.Items[i].Data = nil
@@ -662,17 +702,20 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 		},
 	}
 
-	start := time.Now()
-	policyRunner := resiliency.NewRunner[*state.GetResponse](ctx,
-		a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
-	)
-	getResponse, err := policyRunner(func(ctx context.Context) (*state.GetResponse, error) {
-		return store.Get(ctx, req)
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.Get, err == nil, elapsed)
+	cache := a.Universal.CompStore().GetStateStoreCache(in.GetStoreName())
+	getResponse, err := cache.Get(ctx, req, func(ctx context.Context, req *state.GetRequest) (*state.GetResponse, error) {
+		start := time.Now()
+		policyRunner := resiliency.NewRunner[*state.GetResponse](ctx,
+			a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
+		)
+		getResponse, err := policyRunner(func(ctx context.Context) (*state.GetResponse, error) {
+			return store.Get(ctx, req)
+		})
+		elapsed := diag.ElapsedSince(start)
 
+		diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.Get, err == nil, elapsed)
+		return getResponse, err
+	})
 	if err != nil {
 		kerr, ok := kiterrors.FromError(err)
 		if ok {
@@ -689,7 +732,7 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 		getResponse = &state.GetResponse{}
 	}
 	if encryption.EncryptedStateStore(in.GetStoreName()) {
-		val, err := encryption.TryDecryptValue(in.GetStoreName(), getResponse.Data)
+		val, err := encryption.TryDecryptValue(ctx, in.GetStoreName(), getResponse.Data)
 		if err != nil {
 			err = apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.StateGet, fmt.Sprintf(messages.ErrStateGet, in.GetKey(), in.GetStoreName(), err.Error()))
 			a.logger.Debug(err)
@@ -757,7 +800,7 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 			}
 		}
 		if encryption.EncryptedStateStore(in.GetStoreName()) {
-			val, encErr := encryption.TryEncryptValue(in.GetStoreName(), s.GetValue())
+			val, encErr := encryption.TryEncryptValue(ctx, in.GetStoreName(), s.GetValue())
 			if encErr != nil {
 				a.logger.Debug(encErr)
 				return empty, encErr
@@ -779,6 +822,7 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.Set, err == nil, elapsed)
+	universal.InvalidateStateCache(a.Universal, in.GetStoreName(), reqs...)
 
 	if err != nil {
 		if kerr, ok := kiterrors.FromError(err); ok {
@@ -789,6 +833,8 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 		a.logger.Debug(err)
 		return empty, err
 	}
+
+	universal.NotifyStateChanges(ctx, a.Universal, in.GetStoreName(), reqs...)
 	return empty, nil
 }
 
@@ -844,6 +890,7 @@ func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateReques
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.Delete, err == nil, elapsed)
+	universal.InvalidateStateCache(a.Universal, in.GetStoreName(), req)
 
 	if err != nil {
 		if kerr, ok := kiterrors.FromError(err); ok {
@@ -854,6 +901,8 @@ func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateReques
 		a.logger.Debug(err)
 		return empty, err
 	}
+
+	universal.NotifyStateChanges(ctx, a.Universal, in.GetStoreName(), req)
 	return empty, nil
 }
 
@@ -898,6 +947,7 @@ func (a *api) DeleteBulkState(ctx context.Context, in *runtimev1pb.DeleteBulkSta
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.BulkDelete, err == nil, elapsed)
+	universal.InvalidateStateCache(a.Universal, in.GetStoreName(), reqs...)
 
 	if err != nil {
 		if kerr, ok := kiterrors.FromError(err); ok {
@@ -909,6 +959,7 @@ func (a *api) DeleteBulkState(ctx context.Context, in *runtimev1pb.DeleteBulkSta
 		return empty, err
 	}
 
+	universal.NotifyStateChanges(ctx, a.Universal, in.GetStoreName(), reqs...)
 	return empty, nil
 }
 
@@ -1004,7 +1055,7 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 			switch req := op.(type) {
 			case state.SetRequest:
 				data := []byte(fmt.Sprintf("%v", req.Value))
-				val, err := encryption.TryEncryptValue(in.GetStoreName(), data)
+				val, err := encryption.TryEncryptValue(ctx, in.GetStoreName(), data)
 				if err != nil {
 					err = apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.StateTransaction, fmt.Sprintf(messages.ErrStateTransaction, err.Error()))
 					apiServerLogger.Debug(err)
@@ -1045,15 +1096,22 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.StateTransaction, err == nil, elapsed)
+	universal.InvalidateStateCache(a.Universal, in.GetStoreName(), operations...)
 
 	if err != nil {
 		err = apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.StateTransaction, fmt.Sprintf(messages.ErrStateTransaction, err.Error()))
 		apiServerLogger.Debug(err)
 		return &emptypb.Empty{}, err
 	}
+
+	universal.NotifyStateChanges(ctx, a.Universal, in.GetStoreName(), operations...)
 	return &emptypb.Empty{}, nil
 }
 
+func (a *api) SubscribeStateChangesAlpha1(in *runtimev1pb.SubscribeStateChangesRequestAlpha1, stream runtimev1pb.Dapr_SubscribeStateChangesAlpha1Server) error { //nolint:nosnakecase
+	return a.Universal.SubscribeStateChanges(stream.Context(), in, stream.Send)
+}
+
 func (a *api) GetActorState(ctx context.Context, in *runtimev1pb.GetActorStateRequest) (*runtimev1pb.GetActorStateResponse, error) {
 	astate, err := a.ActorState(ctx)
 	if err != nil {
@@ -3266,6 +3266,144 @@ func TestSubscribeConfigurationAPI(t *testing.T) {
 	}))
 }
 
+func TestStateReadCache(t *testing.T) {
+	store := daprt.NewFakeStateStore()
+	cache, err := stateLoader.NewCacheFromMetadata("store1", map[string]string{stateLoader.CacheSizeKey: "10"})
+	require.NoError(t, err)
+
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", store)
+	compStore.AddStateStoreCache("store1", cache)
+
+	lis := startDaprAPIServer(t, &api{
+		logger: logger.NewLogger("grpc.api.test"),
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("grpc.api.test"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}, "")
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	getState := func(t *testing.T, consistency commonv1pb.StateOptions_StateConsistency) []byte {
+		t.Helper()
+		res, err := client.GetState(t.Context(), &runtimev1pb.GetStateRequest{
+			StoreName:   "store1",
+			Key:         "key1",
+			Consistency: consistency,
+		})
+		require.NoError(t, err)
+		return res.GetData()
+	}
+
+	_, err = client.SaveState(t.Context(), &runtimev1pb.SaveStateRequest{
+		StoreName: "store1",
+		States:    []*commonv1pb.StateItem{{Key: "key1", Value: []byte("v1")}},
+	})
+	require.NoError(t, err)
+
+	v1 := getState(t, commonv1pb.StateOptions_CONSISTENCY_UNSPECIFIED)
+	assert.NotEmpty(t, v1)
+	assert.Equal(t, v1, getState(t, commonv1pb.StateOptions_CONSISTENCY_EVENTUAL))
+	assert.Equal(t, uint64(1), store.CallCount("Get"))
+
+	assert.Equal(t, v1, getState(t, commonv1pb.StateOptions_CONSISTENCY_STRONG))
+	assert.Equal(t, uint64(2), store.CallCount("Get"))
+
+	_, err = client.SaveState(t.Context(), &runtimev1pb.SaveStateRequest{
+		StoreName: "store1",
+		States:    []*commonv1pb.StateItem{{Key: "key1", Value: []byte("v2")}},
+	})
+	require.NoError(t, err)
+	v2 := getState(t, commonv1pb.StateOptions_CONSISTENCY_UNSPECIFIED)
+	assert.NotEqual(t, v1, v2)
+	assert.Equal(t, v2, getState(t, commonv1pb.StateOptions_CONSISTENCY_UNSPECIFIED))
+	assert.Equal(t, uint64(3), store.CallCount("Get"))
+
+	_, err = client.DeleteState(t.Context(), &runtimev1pb.DeleteStateRequest{
+		StoreName: "store1",
+		Key:       "key1",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, getState(t, commonv1pb.StateOptions_CONSISTENCY_UNSPECIFIED))
+	assert.Equal(t, uint64(4), store.CallCount("Get"))
+}
+
+func TestSubscribeStateChangesAlpha1(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", daprt.NewFakeStateStore())
+
+	lis := startDaprAPIServer(t, &api{
+		logger: logger.NewLogger("grpc.api.test"),
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("grpc.api.test"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}, "")
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	t.Run("no keys", func(t *testing.T) {
+		s, err := client.SubscribeStateChangesAlpha1(t.Context(), &runtimev1pb.SubscribeStateChangesRequestAlpha1{
+			StoreName: "store1",
+		})
+		require.NoError(t, err)
+		_, err = s.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("receive changes", func(t *testing.T) {
+		s, err := client.SubscribeStateChangesAlpha1(t.Context(), &runtimev1pb.SubscribeStateChangesRequestAlpha1{
+			StoreName:   "store1",
+			Keys:        []string{"key1"},
+			KeyPrefixes: []string{"order-"},
+		})
+		require.NoError(t, err)
+
+		// The first message signals that the subscription is ready.
+		res, err := s.Recv()
+		require.NoError(t, err)
+		assert.Empty(t, res.GetChanges())
+
+		_, err = client.SaveState(t.Context(), &runtimev1pb.SaveStateRequest{
+			StoreName: "store1",
+			States: []*commonv1pb.StateItem{
+				{Key: "key1", Value: []byte("v1")},
+				{Key: "key2", Value: []byte("v2")},
+			},
+		})
+		require.NoError(t, err)
+		_, err = client.DeleteState(t.Context(), &runtimev1pb.DeleteStateRequest{
+			StoreName: "store1",
+			Key:       "order-1",
+		})
+		require.NoError(t, err)
+
+		var changes []*runtimev1pb.StateChangeAlpha1
+		for len(changes) < 2 {
+			res, err = s.Recv()
+			require.NoError(t, err)
+			changes = append(changes, res.GetChanges()...)
+		}
+		require.Len(t, changes, 2)
+		assert.Equal(t, "key1", changes[0].GetKey())
+		assert.Equal(t, runtimev1pb.StateChangeAlpha1_SET, changes[0].GetOperation())
+		assert.Equal(t, []byte("v1"), changes[0].GetValue())
+		assert.Equal(t, "order-1", changes[1].GetKey())
+		assert.Equal(t, runtimev1pb.StateChangeAlpha1_DELETE, changes[1].GetOperation())
+	})
+}
+
 func TestStateAPIWithResiliency(t *testing.T) {
 	failingStore := &daprt.FailingStatestore{
 		Failure: daprt.NewFailure(
@@ -34,6 +34,7 @@ import (
 	grpcChannel "github.com/dapr/dapr/pkg/channel/grpc"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/middleware"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/security"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
@@ -59,6 +60,8 @@ type AppChannelConfig struct {
 	ReadBufferSize     int // In bytes
 	BaseAddress        string
 	AppAPIToken        string
+	// Middleware is the gRPC middleware pipeline applied to calls to the app.
+	Middleware middleware.GRPC
 }
 
 // Manager is a wrapper around gRPC connection pooling.
@@ -140,7 +143,7 @@ func (g *Manager) defaultLocalConnCreateFn() (grpc.ClientConnInterface, error) {
 }
 
 func (g *Manager) createLocalConnection(parentCtx context.Context, port int, enableTLS bool) (conn *grpc.ClientConn, err error) {
-	opts := make([]grpc.DialOption, 0, 2)
+	opts := make([]grpc.DialOption, 0, 5)
 
 	if diag.DefaultGRPCMonitoring.IsEnabled() {
 		opts = append(opts,
@@ -148,6 +151,17 @@ func (g *Manager) createLocalConnection(parentCtx context.Context, port int, ena
 		)
 	}
 
+	if g.channelConfig.Middleware.Unary != nil {
+		opts = append(opts,
+			grpc.WithChainUnaryInterceptor(g.channelConfig.Middleware.UnaryClientInterceptor()),
+		)
+	}
+	if g.channelConfig.Middleware.Stream != nil {
+		opts = append(opts,
+			grpc.WithChainStreamInterceptor(g.channelConfig.Middleware.StreamClientInterceptor()),
+		)
+	}
+
 	if enableTLS {
 		//nolint:gosec
 		tlsConfig := &tls.Config{InsecureSkipVerify: true}
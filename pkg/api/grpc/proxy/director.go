@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // StreamDirector returns a gRPC ClientConn to be used to forward the call to.
@@ -31,5 +32,10 @@ type ProxyTarget struct {
 	Namespace string
 }
 
+// AppIDResolver returns the ID of the app to forward a call for appID to,
+// given the incoming metadata of the call. It's used to split the calls of an
+// app between other apps.
+type AppIDResolver func(appID string, md metadata.MD) string
+
 // DirectorConnectionFactory is a method signature for a gRPC connection establisher method used for client/server streams
 type DirectorConnectionFactory func(ctx context.Context, address string, id string, namespace string, customOpts ...grpc.DialOption) (*grpc.ClientConn, func(destroy bool), error)
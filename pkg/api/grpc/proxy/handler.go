@@ -68,10 +68,11 @@ func RegisterService(server *grpc.Server, director StreamDirector, getPolicyFn g
 // backends. It should be used as a `grpc.UnknownServiceHandler`.
 //
 // This can *only* be used if the `server` also uses grpcproxy.CodecForServer() ServerOption.
-func TransparentHandler(director StreamDirector, getPolicyFn getPolicyFn, connFactory DirectorConnectionFactory, maxMessageBodySize int) grpc.StreamHandler {
+func TransparentHandler(director StreamDirector, getPolicyFn getPolicyFn, resolveAppID AppIDResolver, connFactory DirectorConnectionFactory, maxMessageBodySize int) grpc.StreamHandler {
 	streamer := &handler{
 		director:           director,
 		getPolicyFn:        getPolicyFn,
+		resolveAppID:       resolveAppID,
 		connFactory:        connFactory,
 		maxRequestBodySize: maxMessageBodySize,
 	}
@@ -81,6 +82,7 @@ func TransparentHandler(director StreamDirector, getPolicyFn getPolicyFn, connFa
 type handler struct {
 	director           StreamDirector
 	getPolicyFn        getPolicyFn
+	resolveAppID       AppIDResolver
 	connFactory        DirectorConnectionFactory
 	maxRequestBodySize int
 }
@@ -96,9 +98,31 @@ func (s *handler) handler(srv any, serverStream grpc.ServerStream) error {
 
 	// Fetch the AppId so we can reference it for resiliency.
 	ctx := serverStream.Context()
+	streamCtx := ctx
 	md, _ := metadata.FromIncomingContext(ctx)
 	v := md[diagConsts.GRPCProxyAppIDKey]
 
+	// Resolve the app to forward the call to once, so all attempts target the same app.
+	// The callee, if set, takes precedence over the app id, as in the StreamDirector.
+	if s.resolveAppID != nil {
+		target := md[diagConsts.GRPCProxyCalleeIDKey]
+		if len(target) == 0 {
+			target = v
+		}
+		if len(target) > 0 {
+			if appID := s.resolveAppID(target[0], md); appID != target[0] {
+				md = md.Copy()
+				md.Set(diagConsts.GRPCProxyAppIDKey, appID)
+				if len(md[diagConsts.GRPCProxyCalleeIDKey]) > 0 {
+					md.Set(diagConsts.GRPCProxyCalleeIDKey, appID)
+				}
+				v = md[diagConsts.GRPCProxyAppIDKey]
+				ctx = metadata.NewIncomingContext(ctx, md)
+				streamCtx = ctx
+			}
+		}
+	}
+
 	// The app id check is handled in the StreamDirector. If we don't have it here, we just use a NoOp policy since we know the request is impossible.
 	var policyDef *resiliency.PolicyDefinition
 	var grpcDestinationAppID string
@@ -152,7 +176,7 @@ func (s *handler) handler(srv any, serverStream grpc.ServerStream) error {
 
 		// If we're using streams, we need to replace ctx with serverStream.Context(), as ctx is canceled when this method returns
 		if isStream {
-			ctx = serverStream.Context()
+			ctx = streamCtx
 		}
 
 		// We require that the director's returned context inherits from the server stream's context (directly or through ctx)
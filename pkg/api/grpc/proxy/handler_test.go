@@ -827,7 +827,7 @@ func (s *proxyTestSuite) SetupSuite() {
 	getPolicyFn := func(appID, methodName string) *resiliency.PolicyDefinition {
 		return s.policyDef
 	}
-	th := TransparentHandler(director, getPolicyFn,
+	th := TransparentHandler(director, getPolicyFn, nil,
 		func(ctx context.Context, address, id, namespace string, customOpts ...grpc.DialOption) (*grpc.ClientConn, func(destroy bool), error) {
 			return s.getServerClientConn()
 		},
@@ -964,3 +964,73 @@ func createGrpcStreamingChaosInterceptor(interruptionsCounter *atomic.Int32, cod
 		return streamer(ctx, desc, cc, method, opts...)
 	}
 }
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+type fakeServerTransportStream struct {
+	grpc.ServerTransportStream
+	method string
+}
+
+func (f *fakeServerTransportStream) Method() string {
+	return f.method
+}
+
+func TestHandlerResolveAppID(t *testing.T) {
+	resolveAppID := func(appID string, md metadata.MD) string {
+		if len(md.Get("x-version")) > 0 {
+			return appID + "-" + md.Get("x-version")[0]
+		}
+		return appID
+	}
+
+	call := func(t *testing.T, md metadata.MD) (policyAppID string, directorMD metadata.MD) {
+		t.Helper()
+		director := func(ctx context.Context, fullMethodName string) (context.Context, *grpc.ClientConn, *ProxyTarget, func(destroy bool), error) {
+			directorMD, _ = metadata.FromIncomingContext(ctx)
+			return ctx, nil, nil, func(bool) {}, errors.New("rejected")
+		}
+		getPolicyFn := func(appID, methodName string) *resiliency.PolicyDefinition {
+			policyAppID = appID
+			return resiliency.NoOp{}.EndpointPolicy("", "")
+		}
+		h := &handler{director: director, getPolicyFn: getPolicyFn, resolveAppID: resolveAppID}
+
+		ctx := metadata.NewIncomingContext(t.Context(), md)
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &fakeServerTransportStream{method: "/test.Service/Method"})
+		require.Error(t, h.handler(nil, &fakeServerStream{ctx: ctx}))
+		return policyAppID, directorMD
+	}
+
+	t.Run("call is resolved to another app", func(t *testing.T) {
+		policyAppID, md := call(t, metadata.Pairs(diagConsts.GRPCProxyAppIDKey, "orders", "x-version", "v2"))
+		assert.Equal(t, "orders-v2", policyAppID)
+		assert.Equal(t, []string{"orders-v2"}, md.Get(diagConsts.GRPCProxyAppIDKey))
+	})
+
+	t.Run("call is not resolved", func(t *testing.T) {
+		policyAppID, md := call(t, metadata.Pairs(diagConsts.GRPCProxyAppIDKey, "orders"))
+		assert.Equal(t, "orders", policyAppID)
+		assert.Equal(t, []string{"orders"}, md.Get(diagConsts.GRPCProxyAppIDKey))
+	})
+
+	t.Run("call with a callee is resolved", func(t *testing.T) {
+		policyAppID, md := call(t, metadata.Pairs(diagConsts.GRPCProxyAppIDKey, "orders", diagConsts.GRPCProxyCalleeIDKey, "orders", "x-version", "v2"))
+		assert.Equal(t, "orders-v2", policyAppID)
+		assert.Equal(t, []string{"orders-v2"}, md.Get(diagConsts.GRPCProxyAppIDKey))
+		assert.Equal(t, []string{"orders-v2"}, md.Get(diagConsts.GRPCProxyCalleeIDKey))
+	})
+
+	t.Run("callee takes precedence over the app id", func(t *testing.T) {
+		policyAppID, md := call(t, metadata.Pairs(diagConsts.GRPCProxyAppIDKey, "payments", diagConsts.GRPCProxyCalleeIDKey, "orders", "x-version", "v2"))
+		assert.Equal(t, "orders-v2", policyAppID)
+		assert.Equal(t, []string{"orders-v2"}, md.Get(diagConsts.GRPCProxyCalleeIDKey))
+	})
+}
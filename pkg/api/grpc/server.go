@@ -20,10 +20,12 @@ import (
 	"io"
 	"math"
 	"net"
+	"net/http"
 	"strconv"
 	"sync"
 	"time"
 
+	"connectrpc.com/connect"
 	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcGo "google.golang.org/grpc"
 	grpcCodes "google.golang.org/grpc/codes"
@@ -38,8 +40,10 @@ import (
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/messaging"
+	"github.com/dapr/dapr/pkg/middleware"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/proto/runtime/v1/runtimeconnect"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/dapr/pkg/security"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
@@ -57,6 +61,11 @@ const (
 type Server interface {
 	io.Closer
 	StartNonBlocking() error
+
+	// ConnectHandler returns the path and the handler serving the Dapr service
+	// over the Connect, gRPC and gRPC-Web protocols on an HTTP server, with the
+	// same interceptors as the gRPC server.
+	ConnectHandler() (string, http.Handler)
 }
 
 type Options struct {
@@ -68,6 +77,7 @@ type Options struct {
 	Proxy          messaging.Proxy
 	WorkflowEngine wfengine.Interface
 	Healthz        healthz.Healthz
+	Middleware     middleware.GRPC
 }
 
 type OptionsInternal struct {
@@ -97,6 +107,13 @@ type server struct {
 	sec            security.Handler
 	wg             sync.WaitGroup
 	htarget        healthz.Target
+	middleware     middleware.GRPC
+
+	// The interceptor chain is built once and shared by every gRPC listener
+	// and the Connect handler.
+	interceptorsOnce sync.Once
+	unary            grpcGo.UnaryServerInterceptor
+	stream           grpcGo.StreamServerInterceptor
 }
 
 var (
@@ -147,6 +164,7 @@ func NewAPIServer(opts Options) Server {
 		proxy:          opts.Proxy,
 		workflowEngine: opts.WorkflowEngine,
 		htarget:        opts.Healthz.AddTarget("grpc-api-server"),
+		middleware:     opts.Middleware,
 		grpcServerOpts: serverOpts,
 	}
 }
@@ -275,11 +293,29 @@ func (s *server) Close() error {
 }
 
 func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
+	unary, stream := s.getInterceptors()
+	return []grpcGo.ServerOption{
+		grpcGo.UnaryInterceptor(unary),
+		grpcGo.StreamInterceptor(stream),
+		grpcGo.InTapHandle(metadata.SetMetadataInTapHandle),
+	}
+}
+
+// getInterceptors returns the interceptor chain of the server, building it on
+// the first call.
+func (s *server) getInterceptors() (grpcGo.UnaryServerInterceptor, grpcGo.StreamServerInterceptor) {
+	s.interceptorsOnce.Do(func() {
+		s.unary, s.stream = s.buildInterceptors()
+	})
+	return s.unary, s.stream
+}
+
+func (s *server) buildInterceptors() (grpcGo.UnaryServerInterceptor, grpcGo.StreamServerInterceptor) {
 	// We initialize these slices with an initial capacity to give the compiler a "hint" of how much memory we may use.
 	// These capacities are the worst-case scenario below (max number of items added to each slice).
 	// Specifying an initial capacity helps us reducing the risk that we may need to re-allocate the slice, which is wasteful both on the allocator and on the GC.
-	intr := make([]grpcGo.UnaryServerInterceptor, 0, 6)
-	intrStream := make([]grpcGo.StreamServerInterceptor, 0, 5)
+	intr := make([]grpcGo.UnaryServerInterceptor, 0, 7)
+	intrStream := make([]grpcGo.StreamServerInterceptor, 0, 6)
 
 	intr = append(intr, metadata.SetMetadataInContextUnary)
 
@@ -316,17 +352,35 @@ func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
 		}
 	}
 
+	if s.middleware.Unary != nil {
+		intr = append(intr, s.middleware.Unary)
+	}
+	if s.middleware.Stream != nil {
+		intrStream = append(intrStream, s.middleware.Stream)
+	}
+
 	if s.config.EnableAPILogging && s.infoLogger != nil {
 		unary, stream := s.getGRPCAPILoggingMiddlewares()
 		intr = append(intr, unary)
 		intrStream = append(intrStream, stream)
 	}
 
-	return []grpcGo.ServerOption{
-		grpcGo.UnaryInterceptor(grpcMiddleware.ChainUnaryServer(intr...)),
-		grpcGo.StreamInterceptor(grpcMiddleware.ChainStreamServer(intrStream...)),
-		grpcGo.InTapHandle(metadata.SetMetadataInTapHandle),
+	return grpcMiddleware.ChainUnaryServer(intr...), grpcMiddleware.ChainStreamServer(intrStream...)
+}
+
+func (s *server) ConnectHandler() (string, http.Handler) {
+	unary, stream := s.getInterceptors()
+	h := &connectHandler{
+		api:    s.api,
+		unary:  unary,
+		stream: stream,
+	}
+
+	var opts []connect.HandlerOption
+	if s.config.MaxRequestBodySize > 0 {
+		opts = append(opts, connect.WithReadMaxBytes(s.config.MaxRequestBodySize))
 	}
+	return runtimeconnect.NewDaprHandler(h, opts...)
 }
 
 func (s *server) getGRPCServer() (*grpcGo.Server, error) {
@@ -15,11 +15,14 @@ package grpc
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 )
 
 // SubscribeTopicEvents is called by the Dapr runtime to ad hoc stream
@@ -65,15 +68,21 @@ func (a *api) SubscribeTopicEventsAlpha1(stream runtimev1pb.Dapr_SubscribeTopicE
 		return errors.New("topic is required")
 	}
 
+	ordering, subMetadata, err := orderingFromMetadata(req.GetMetadata())
+	if err != nil {
+		return err
+	}
+
 	key := a.pubsubAdapterStreamer.StreamerKey(req.GetPubsubName(), req.GetTopic())
 	sub := &subapi.Subscription{
 		ObjectMeta: metav1.ObjectMeta{Name: key},
 		Spec: subapi.SubscriptionSpec{
 			Pubsubname:      req.GetPubsubName(),
 			Topic:           req.GetTopic(),
-			Metadata:        req.GetMetadata(),
+			Metadata:        subMetadata,
 			DeadLetterTopic: req.GetDeadLetterTopic(),
 			Routes:          subapi.Routes{Default: "/"},
+			Ordering:        ordering,
 		},
 	}
 	connectionID := a.Universal.CompStore().NextSubscriberIndex()
@@ -102,3 +111,38 @@ func (a *api) SubscribeTopicEventsAlpha1(stream runtimev1pb.Dapr_SubscribeTopicE
 
 	return a.pubsubAdapterStreamer.Subscribe(stream, req, connectionID)
 }
+
+// orderingFromMetadata extracts the ordered delivery configuration of a
+// streaming subscription from its metadata. The returned metadata no longer
+// contains the ordering keys.
+func orderingFromMetadata(md map[string]string) (*subapi.Ordering, map[string]string, error) {
+	key, okKey := md[runtimePubsub.MetadataKeyOrderingKey]
+	expression, okExpr := md[runtimePubsub.MetadataKeyOrderingExpression]
+	maxConcurrency, okMax := md[runtimePubsub.MetadataKeyOrderingMaxConcurrency]
+	if !okKey && !okExpr && !okMax {
+		return nil, md, nil
+	}
+
+	ordering := &subapi.Ordering{
+		Key:        key,
+		Expression: expression,
+	}
+	if okMax {
+		n, err := strconv.ParseInt(maxConcurrency, 10, 32)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid %s metadata: %w", runtimePubsub.MetadataKeyOrderingMaxConcurrency, err)
+		}
+		ordering.MaxConcurrency = int32(n)
+	}
+
+	subMetadata := make(map[string]string, len(md))
+	for k, v := range md {
+		switch k {
+		case runtimePubsub.MetadataKeyOrderingKey, runtimePubsub.MetadataKeyOrderingExpression, runtimePubsub.MetadataKeyOrderingMaxConcurrency:
+		default:
+			subMetadata[k] = v
+		}
+	}
+
+	return ordering, subMetadata, nil
+}
@@ -53,7 +53,10 @@ import (
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/processor"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/replay"
 	"github.com/dapr/dapr/utils"
 	kiterrors "github.com/dapr/kit/errors"
 )
@@ -71,6 +74,8 @@ type api struct {
 	directMessaging       invokev1.DirectMessaging
 	channels              *channels.Channels
 	pubsubAdapter         runtimePubsub.Adapter
+	pubsubReplayer        *replay.Manager
+	subscriber            processor.SubscribeManager
 	outbox                outbox.Outbox
 	sendToOutputBindingFn func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
 	metricSpec            *config.MetricSpec
@@ -92,6 +97,7 @@ const (
 	actorIDParam             = "actorId"
 	storeNameParam           = "storeName"
 	stateKeyParam            = "key"
+	stateKeyPrefixParam      = "keyPrefix"
 	configurationKeyParam    = "key"
 	configurationSubscribeID = "configurationSubscribeID"
 	secretStoreNameParam     = "secretStoreName"
@@ -106,6 +112,8 @@ const (
 	pubsubnameparam          = "pubsubname"
 	traceparentHeader        = "traceparent"
 	tracestateHeader         = "tracestate"
+	scheduledIDHeader        = "dapr-scheduled-id"
+	scheduledIDParam         = "id"
 	daprRuntimeVersionKey    = "daprRuntimeVersion"
 )
 
@@ -115,6 +123,8 @@ type APIOpts struct {
 	Channels              *channels.Channels
 	DirectMessaging       invokev1.DirectMessaging
 	PubSubAdapter         runtimePubsub.Adapter
+	PubSubReplayer        *replay.Manager
+	Subscriber            processor.SubscribeManager
 	Outbox                outbox.Outbox
 	SendToOutputBindingFn func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
 	TracingSpec           config.TracingSpec
@@ -131,6 +141,8 @@ func NewAPI(opts APIOpts) API {
 		channels:              opts.Channels,
 		directMessaging:       opts.DirectMessaging,
 		pubsubAdapter:         opts.PubSubAdapter,
+		pubsubReplayer:        opts.PubSubReplayer,
+		subscriber:            opts.Subscriber,
 		outbox:                opts.Outbox,
 		sendToOutputBindingFn: opts.SendToOutputBindingFn,
 		tracingSpec:           opts.TracingSpec,
@@ -146,9 +158,11 @@ func NewAPI(opts APIOpts) API {
 	api.endpoints = append(api.endpoints, api.constructStateEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructSecretsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructPubSubEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructPubSubReplayEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructActorEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructDirectMessagingEndpoints()...)
 	api.endpoints = append(api.endpoints, metadataEndpoints...)
+	api.endpoints = append(api.endpoints, api.constructSubscriptionEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructShutdownEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructBindingsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructConfigurationEndpoints()...)
@@ -255,6 +269,20 @@ func (a *api) constructStateEndpoints() []endpoints.Endpoint {
 				Name: "QueryStateAlpha1",
 			},
 		},
+		{
+			Methods: []string{nethttp.MethodGet},
+			Route:   "state/{storeName}/subscribe",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupState,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: appendStateSpanAttributes,
+			},
+			Handler: a.onSubscribeStateChanges,
+			Settings: endpoints.EndpointSettings{
+				Name: "SubscribeStateChangesAlpha1",
+			},
+		},
 	}
 }
 
@@ -293,6 +321,20 @@ func (a *api) constructPubSubEndpoints() []endpoints.Endpoint {
 				Name: "BulkPublishEvent",
 			},
 		},
+		{
+			Methods: []string{nethttp.MethodDelete},
+			Route:   "publish/scheduled/{pubsubname}/{id}",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupPubsub,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: nil,
+			},
+			Handler: a.onCancelScheduledPublish,
+			Settings: endpoints.EndpointSettings{
+				Name: "CancelScheduledPublishEvent",
+			},
+		},
 	}
 }
 
@@ -530,19 +572,22 @@ func (a *api) onBulkGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 		reqs[i] = r
 	}
 
-	start := time.Now()
-	policyRunner := resiliency.NewRunner[[]state.BulkGetResponse](r.Context(),
-		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
-	)
-	responses, err := policyRunner(func(ctx context.Context) ([]state.BulkGetResponse, error) {
-		return store.BulkGet(ctx, reqs, state.BulkGetOpts{
-			Parallelism: req.Parallelism,
+	cache := a.universal.CompStore().GetStateStoreCache(storeName)
+	responses, err := cache.BulkGet(r.Context(), reqs, func(ctx context.Context, reqs []state.GetRequest) ([]state.BulkGetResponse, error) {
+		start := time.Now()
+		policyRunner := resiliency.NewRunner[[]state.BulkGetResponse](ctx,
+			a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
+		)
+		responses, err := policyRunner(func(ctx context.Context) ([]state.BulkGetResponse, error) {
+			return store.BulkGet(ctx, reqs, state.BulkGetOpts{
+				Parallelism: req.Parallelism,
+			})
 		})
-	})
-
-	elapsed := diag.ElapsedSince(start)
-	diag.DefaultComponentMonitoring.StateInvoked(context.Background(), storeName, diag.BulkGet, err == nil, elapsed)
 
+		elapsed := diag.ElapsedSince(start)
+		diag.DefaultComponentMonitoring.StateInvoked(context.Background(), storeName, diag.BulkGet, err == nil, elapsed)
+		return responses, err
+	})
 	if err != nil {
 		code := nethttp.StatusInternalServerError
 		kerr, ok := kiterrors.FromError(err)
@@ -575,7 +620,7 @@ func (a *api) onBulkGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 				continue
 			}
 
-			val, err := encryption.TryDecryptValue(storeName, bulkResp[i].Data)
+			val, err := encryption.TryDecryptValue(r.Context(), storeName, bulkResp[i].Data)
 			if err != nil {
 				log.Debugf("Bulk get error: %v", err)
 				bulkResp[i].Data = nil
@@ -638,17 +683,20 @@ func (a *api) onGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 		Metadata: metadata,
 	}
 
-	start := time.Now()
-	policyRunner := resiliency.NewRunner[*state.GetResponse](r.Context(),
-		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
-	)
-	resp, err := policyRunner(func(ctx context.Context) (*state.GetResponse, error) {
-		return store.Get(ctx, req)
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.StateInvoked(context.Background(), storeName, diag.Get, err == nil, elapsed)
+	cache := a.universal.CompStore().GetStateStoreCache(storeName)
+	resp, err := cache.Get(r.Context(), req, func(ctx context.Context, req *state.GetRequest) (*state.GetResponse, error) {
+		start := time.Now()
+		policyRunner := resiliency.NewRunner[*state.GetResponse](ctx,
+			a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
+		)
+		resp, err := policyRunner(func(ctx context.Context) (*state.GetResponse, error) {
+			return store.Get(ctx, req)
+		})
+		elapsed := diag.ElapsedSince(start)
 
+		diag.DefaultComponentMonitoring.StateInvoked(context.Background(), storeName, diag.Get, err == nil, elapsed)
+		return resp, err
+	})
 	if err != nil {
 		code := nethttp.StatusInternalServerError
 		kerr, ok := kiterrors.FromError(err)
@@ -668,7 +716,7 @@ func (a *api) onGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	}
 
 	if encryption.EncryptedStateStore(storeName) {
-		val, err := encryption.TryDecryptValue(storeName, resp.Data)
+		val, err := encryption.TryDecryptValue(r.Context(), storeName, resp.Data)
 		if err != nil {
 			resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateGet, key, storeName, err.Error()), errorcodes.StateGet, nethttp.StatusInternalServerError)
 			respondWithError(w, resp)
@@ -964,6 +1012,7 @@ func (a *api) onDeleteState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(r.Context(), storeName, diag.Delete, err == nil, elapsed)
+	universal.InvalidateStateCache(a.universal, storeName, req)
 
 	if err != nil {
 		statusCode, errMsg := a.stateErrorResponse(err)
@@ -972,6 +1021,8 @@ func (a *api) onDeleteState(w nethttp.ResponseWriter, r *nethttp.Request) {
 		log.Debug(apiResp)
 		return
 	}
+
+	universal.NotifyStateChanges(r.Context(), a.universal, storeName, req)
 	respondWithEmpty(w)
 }
 
@@ -1002,6 +1053,7 @@ func (a *api) onPostState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	}
 
 	metadata := getMetadataFromRequest(r)
+	ctx := r.Context()
 
 	for i, r := range reqs {
 		if len(reqs[i].Key) == 0 {
@@ -1030,7 +1082,7 @@ func (a *api) onPostState(w nethttp.ResponseWriter, r *nethttp.Request) {
 
 		if encryption.EncryptedStateStore(storeName) {
 			data := []byte(fmt.Sprintf("%v", r.Value))
-			val, encErr := encryption.TryEncryptValue(storeName, data)
+			val, encErr := encryption.TryEncryptValue(ctx, storeName, data)
 			if encErr != nil {
 				statusCode, errMsg := a.stateErrorResponse(encErr)
 				apiResp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateSave, storeName, errMsg), errorcodes.StateSave, statusCode)
@@ -1053,6 +1105,7 @@ func (a *api) onPostState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(r.Context(), storeName, diag.Set, err == nil, elapsed)
+	universal.InvalidateStateCache(a.universal, storeName, reqs...)
 
 	if err != nil {
 		statusCode, errMsg := a.stateErrorResponse(err)
@@ -1062,6 +1115,7 @@ func (a *api) onPostState(w nethttp.ResponseWriter, r *nethttp.Request) {
 		return
 	}
 
+	universal.NotifyStateChanges(r.Context(), a.universal, storeName, reqs...)
 	respondWithEmpty(w)
 }
 
@@ -1127,6 +1181,10 @@ func (a *api) onPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 
 	data := body
 
+	// The ID of a delayed message is the CloudEvent ID, or is generated when
+	// publishing a raw payload.
+	var scheduledID string
+
 	if !rawPayload {
 		span := diagUtils.SpanFromContext(r.Context())
 		traceID, traceState := diag.TraceIDAndStateFromSpan(span)
@@ -1151,6 +1209,7 @@ func (a *api) onPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 		features := thepubsub.Features()
 
 		pubsub.ApplyMetadata(envelope, features, metadata)
+		scheduledID, _ = envelope[pubsub.IDField].(string)
 
 		data, err = json.Marshal(envelope)
 		if err != nil {
@@ -1170,8 +1229,27 @@ func (a *api) onPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 		Metadata:   metadata,
 	}
 
+	deliverAt, delayed, err := publisher.DeliverAt(metadata, time.Now())
+	if err != nil {
+		nerr := apierrors.PubSub(pubsubName).WithMetadata(metadata).DeserializeError(err)
+		respondWithError(w, nerr)
+		log.Debug(nerr)
+		return
+	}
+	if delayed {
+		scheduledID, err = a.universal.SchedulePublishEvent(r.Context(), &req, scheduledID, deliverAt)
+		if err != nil {
+			respondWithError(w, err)
+			log.Debug(err)
+			return
+		}
+		w.Header().Set(scheduledIDHeader, scheduledID)
+		respondWithEmpty(w)
+		return
+	}
+
 	start := time.Now()
-	err := a.pubsubAdapter.Publish(r.Context(), &req)
+	err = a.pubsubAdapter.Publish(r.Context(), &req)
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.PubsubEgressEvent(context.Background(), pubsubName, topic, err == nil, elapsed)
@@ -1184,6 +1262,8 @@ func (a *api) onPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 			nerr = apierrors.PubSub(pubsubName).PublishForbidden(topic, a.universal.AppID(), err)
 		case errors.As(err, &runtimePubsub.NotFoundError{}):
 			nerr = apierrors.PubSub(pubsubName).TestNotFound(topic, err)
+		case errors.As(err, &runtimePubsub.SchemaValidationError{}):
+			nerr = apierrors.PubSub(pubsubName).SchemaValidation(topic, err)
 		default:
 			nerr = apierrors.PubSub(pubsubName).PublishMessage(topic, err)
 		}
@@ -1195,6 +1275,20 @@ func (a *api) onPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 	}
 }
 
+func (a *api) onCancelScheduledPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
+	pubsubName := chi.URLParam(r, pubsubnameparam)
+	id := chi.URLParam(r, scheduledIDParam)
+
+	err := a.universal.CancelScheduledPublishEventAlpha1(r.Context(), pubsubName, id)
+	if err != nil {
+		respondWithError(w, err)
+		log.Debug(err)
+		return
+	}
+
+	respondWithEmpty(w)
+}
+
 type bulkPublishMessageEntry struct {
 	EntryID     string            `json:"entryId,omitempty"`
 	Event       interface{}       `json:"event"`
@@ -1377,6 +1471,15 @@ func (a *api) onBulkPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 				respondWithError(w, standardizedErr)
 			}
 			return
+		case errors.As(err, &runtimePubsub.SchemaValidationError{}):
+			nerr := apierrors.PubSub(pubsubName).SchemaValidation(topic, err)
+			standardizedErr, ok := kiterrors.FromError(nerr)
+			if ok {
+				closeChildSpans(standardizedErr.HTTPStatusCode())
+				respondWithError(w, standardizedErr)
+			}
+			log.Debug(nerr)
+			return
 		default:
 			err = apierrors.PubSub(pubsubName).PublishMessage(topic, err)
 			log.Debug(err)
@@ -1600,7 +1703,7 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 			switch req := op.(type) {
 			case state.SetRequest:
 				data := []byte(fmt.Sprintf("%v", req.Value))
-				val, err := encryption.TryEncryptValue(storeName, data)
+				val, err := encryption.TryEncryptValue(r.Context(), storeName, data)
 				if err != nil {
 					resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateSave, storeName, err.Error()), errorcodes.StateSave, nethttp.StatusBadRequest)
 					respondWithError(w, resp)
@@ -1643,12 +1746,14 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(context.Background(), storeName, diag.StateTransaction, err == nil, elapsed)
+	universal.InvalidateStateCache(a.universal, storeName, operations...)
 
 	if err != nil {
 		resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateTransaction, err.Error()), errorcodes.StateTransaction, nethttp.StatusInternalServerError)
 		respondWithError(w, resp)
 		log.Debug(resp)
 	} else {
+		universal.NotifyStateChanges(r.Context(), a.universal, storeName, operations...)
 		respondWithEmpty(w)
 	}
 }
@@ -15,6 +15,7 @@ limitations under the License.
 package http
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -23,6 +24,7 @@ import (
 	"io"
 	"net"
 	nethttp "net/http"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -78,6 +80,8 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/processor"
+	"github.com/dapr/dapr/pkg/runtime/processor/subscriber"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/fake"
 	daprt "github.com/dapr/dapr/pkg/testing"
@@ -1684,6 +1688,131 @@ func TestV1MetadataEndpoint(t *testing.T) {
 	fakeServer.Shutdown()
 }
 
+type fakeSubscriber struct {
+	processor.SubscribeManager
+	paused map[string]bool
+}
+
+func (f *fakeSubscriber) ListSubscriptionStatuses() []subscriber.SubscriptionStatus {
+	state := subscriber.SubscriptionStateActive
+	if f.paused["topic"] {
+		state = subscriber.SubscriptionStatePaused
+	}
+	return []subscriber.SubscriptionStatus{{
+		PubsubName: "test",
+		Topic:      "topic",
+		Type:       "DECLARATIVE",
+		State:      state,
+	}}
+}
+
+func (f *fakeSubscriber) PauseSubscription(pubsubName, topic string) error {
+	if pubsubName != "test" || topic != "topic" {
+		return daprerrors.PubSub(pubsubName).SubscriptionNotFound(topic)
+	}
+	f.paused[topic] = true
+	return nil
+}
+
+func (f *fakeSubscriber) ResumeSubscription(pubsubName, topic string) error {
+	if pubsubName != "test" || topic != "topic" {
+		return daprerrors.PubSub(pubsubName).SubscriptionNotFound(topic)
+	}
+	delete(f.paused, topic)
+	return nil
+}
+
+func TestV1Alpha1SubscriptionEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		subscriber: &fakeSubscriber{paused: make(map[string]bool)},
+	}
+	fakeServer.StartServer(testAPI.constructSubscriptionEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	listState := func(t *testing.T) string {
+		t.Helper()
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/metadata/subscriptions", nil, nil)
+		require.Equal(t, 200, resp.StatusCode)
+		var statuses []map[string]string
+		require.NoError(t, json.Unmarshal(resp.RawBody, &statuses))
+		require.Len(t, statuses, 1)
+		assert.Equal(t, "test", statuses[0]["pubsubname"])
+		assert.Equal(t, "topic", statuses[0]["topic"])
+		return statuses[0]["state"]
+	}
+
+	assert.Equal(t, "ACTIVE", listState(t))
+
+	t.Run("pause subscription", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/metadata/subscriptions/test/topic/pause", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.Equal(t, "PAUSED", listState(t))
+	})
+
+	t.Run("resume subscription", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/metadata/subscriptions/test/topic/resume", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.Equal(t, "ACTIVE", listState(t))
+	})
+
+	t.Run("subscription not found", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/metadata/subscriptions/test/other/pause", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_SUBSCRIPTION_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+}
+
+func TestV1Alpha1SubscribeStateChangesEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", daprt.NewFakeStateStore())
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("fakeLogger"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructStateEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("no keys", func(t *testing.T) {
+		resp := fakeServer.DoRequest(nethttp.MethodGet, "v1.0-alpha1/state/store1/subscribe", nil, nil)
+		assert.Equal(t, nethttp.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_SUBSCRIBE_CHANGES", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("stream changes", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, "http://127.0.0.1/v1.0-alpha1/state/store1/subscribe?key=key1&keyPrefix=order-", nil)
+		require.NoError(t, err)
+		res, err := fakeServer.client.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, nethttp.StatusOK, res.StatusCode)
+		assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+		resp := fakeServer.DoRequest(nethttp.MethodPost, "v1.0/state/store1", []byte(`[{"key":"key1","value":{"id":1}},{"key":"key2","value":"v2"}]`), nil)
+		require.Equal(t, nethttp.StatusNoContent, resp.StatusCode)
+		resp = fakeServer.DoRequest(nethttp.MethodDelete, "v1.0/state/store1/order-1", nil, nil)
+		require.Equal(t, nethttp.StatusNoContent, resp.StatusCode)
+
+		scanner := bufio.NewScanner(res.Body)
+		var events []string
+		for len(events) < 2 && scanner.Scan() {
+			if data, ok := strings.CutPrefix(scanner.Text(), "data: "); ok {
+				events = append(events, data)
+			}
+		}
+		require.Len(t, events, 2)
+		assert.JSONEq(t, `{"key":"key1","operation":"set","value":{"id":1}}`, events[0])
+		assert.JSONEq(t, `{"key":"order-1","operation":"delete"}`, events[1])
+	})
+}
+
 func createExporters(buffer *string) {
 	exporter := testtrace.NewStringExporter(buffer, logger.NewLogger("fakeLogger"))
 	exporter.Register("fakeID")
@@ -0,0 +1,193 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/replay"
+)
+
+const replayIDParam = "id"
+
+var endpointGroupPubsubV1Alpha1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupPubsub,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: nil,
+}
+
+// replayRequest is the body of a request to start a dead letter replay job.
+type replayRequest struct {
+	DeadLetterTopic string  `json:"deadLetterTopic"`
+	Filter          string  `json:"filter,omitempty"`
+	RatePerSecond   float64 `json:"ratePerSecond,omitempty"`
+	MaxEvents       int64   `json:"maxEvents,omitempty"`
+	IdleTimeout     string  `json:"idleTimeout,omitempty"`
+}
+
+func (a *api) constructPubSubReplayEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "pubsub/{pubsubname}/replay",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupPubsubV1Alpha1,
+			Handler: a.onStartReplay,
+			Settings: endpoints.EndpointSettings{
+				Name: "StartDeadLetterReplay",
+			},
+		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "pubsub/{pubsubname}/replay",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupPubsubV1Alpha1,
+			Handler: a.onListReplays,
+			Settings: endpoints.EndpointSettings{
+				Name: "ListDeadLetterReplays",
+			},
+		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "pubsub/{pubsubname}/replay/{id}",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupPubsubV1Alpha1,
+			Handler: a.onGetReplay,
+			Settings: endpoints.EndpointSettings{
+				Name: "GetDeadLetterReplay",
+			},
+		},
+		{
+			Methods: []string{http.MethodDelete},
+			Route:   "pubsub/{pubsubname}/replay/{id}",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupPubsubV1Alpha1,
+			Handler: a.onStopReplay,
+			Settings: endpoints.EndpointSettings{
+				Name: "StopDeadLetterReplay",
+			},
+		},
+	}
+}
+
+func (a *api) onStartReplay(w http.ResponseWriter, r *http.Request) {
+	pubsubName := chi.URLParam(r, pubsubnameparam)
+	if a.pubsubReplayer == nil {
+		respondWithError(w, apierrors.PubSub(pubsubName).WithMetadata(nil).NotConfigured())
+		return
+	}
+
+	var body replayRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err = apierrors.PubSub(pubsubName).ReplayInvalid(err)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	req := replay.Request{
+		PubsubName:      pubsubName,
+		DeadLetterTopic: body.DeadLetterTopic,
+		Filter:          body.Filter,
+		RatePerSecond:   body.RatePerSecond,
+		MaxEvents:       body.MaxEvents,
+	}
+	if len(body.IdleTimeout) > 0 {
+		d, err := time.ParseDuration(body.IdleTimeout)
+		if err != nil {
+			err = apierrors.PubSub(pubsubName).ReplayInvalid(fmt.Errorf("invalid idleTimeout: %w", err))
+			log.Debug(err)
+			respondWithError(w, err)
+			return
+		}
+		req.IdleTimeout = d
+	}
+
+	progress, err := a.pubsubReplayer.Start(req)
+	if err != nil {
+		var (
+			notFound   runtimePubsub.NotFoundError
+			notAllowed runtimePubsub.NotAllowedError
+		)
+		switch {
+		case errors.As(err, &notFound):
+			err = apierrors.PubSub(pubsubName).WithMetadata(nil).NotFound()
+		case errors.As(err, &notAllowed):
+			err = apierrors.PubSub(pubsubName).SubscribeForbidden(body.DeadLetterTopic, a.universal.AppID(), err)
+		default:
+			err = apierrors.PubSub(pubsubName).ReplayInvalid(err)
+		}
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusAccepted, progress)
+}
+
+func (a *api) onListReplays(w http.ResponseWriter, r *http.Request) {
+	pubsubName := chi.URLParam(r, pubsubnameparam)
+	if a.pubsubReplayer == nil {
+		respondWithError(w, apierrors.PubSub(pubsubName).WithMetadata(nil).NotConfigured())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, a.pubsubReplayer.List(pubsubName))
+}
+
+func (a *api) onGetReplay(w http.ResponseWriter, r *http.Request) {
+	pubsubName := chi.URLParam(r, pubsubnameparam)
+	id := chi.URLParam(r, replayIDParam)
+	if a.pubsubReplayer == nil {
+		respondWithError(w, apierrors.PubSub(pubsubName).WithMetadata(nil).NotConfigured())
+		return
+	}
+
+	progress, err := a.pubsubReplayer.Get(pubsubName, id)
+	if err != nil {
+		err = apierrors.PubSub(pubsubName).ReplayNotFound(id)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, progress)
+}
+
+func (a *api) onStopReplay(w http.ResponseWriter, r *http.Request) {
+	pubsubName := chi.URLParam(r, pubsubnameparam)
+	id := chi.URLParam(r, replayIDParam)
+	if a.pubsubReplayer == nil {
+		respondWithError(w, apierrors.PubSub(pubsubName).WithMetadata(nil).NotConfigured())
+		return
+	}
+
+	if err := a.pubsubReplayer.Stop(pubsubName, id); err != nil {
+		err = apierrors.PubSub(pubsubName).ReplayNotFound(id)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithEmpty(w)
+}
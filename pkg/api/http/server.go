@@ -68,6 +68,7 @@ type server struct {
 	middleware         middleware.HTTP
 	api                API
 	apiSpec            config.APISpec
+	connectHandlers    map[string]http.Handler
 	servers            []*http.Server
 	profilingListeners []net.Listener
 	wg                 sync.WaitGroup
@@ -81,18 +82,25 @@ type NewServerOpts struct {
 	MetricSpec  config.MetricSpec
 	Middleware  middleware.HTTP
 	APISpec     config.APISpec
+
+	// ConnectHandlers are the handlers serving gRPC services over the Connect,
+	// gRPC and gRPC-Web protocols, keyed by the path they are served on.
+	// Requests to these paths are not handled by the HTTP middleware, as the
+	// handlers apply the middleware of the gRPC server.
+	ConnectHandlers map[string]http.Handler
 }
 
 // NewServer returns a new HTTP server.
 func NewServer(opts NewServerOpts) Server {
 	infoLog.SetOutputLevel(logger.LogLevel("info"))
 	return &server{
-		api:         opts.API,
-		config:      opts.Config,
-		tracingSpec: opts.TracingSpec,
-		metricSpec:  opts.MetricSpec,
-		middleware:  opts.Middleware,
-		apiSpec:     opts.APISpec,
+		api:             opts.API,
+		config:          opts.Config,
+		tracingSpec:     opts.TracingSpec,
+		metricSpec:      opts.MetricSpec,
+		middleware:      opts.Middleware,
+		apiSpec:         opts.APISpec,
+		connectHandlers: opts.ConnectHandlers,
 	}
 }
 
@@ -140,9 +148,9 @@ func (s *server) StartNonBlocking() error {
 	}
 
 	// Create a handler with support for HTTP/2 Cleartext
-	var handler http.Handler = r
+	handler := s.withConnectHandlers(r)
 	if !kitstrings.IsTruthy(os.Getenv("DAPR_HTTP_DISABLE_H2C")) {
-		handler = h2c.NewHandler(r, &http2.Server{})
+		handler = h2c.NewHandler(handler, &http2.Server{})
 	}
 
 	for _, listener := range listeners {
@@ -361,19 +369,52 @@ func (s *server) useComponents(r chi.Router) {
 	r.Use(s.middleware)
 }
 
-func (s *server) useCors(r chi.Router) {
+// withConnectHandlers returns a handler which passes the requests to the
+// Connect handlers mounted on their path, and every other request to next.
+// Connect requests are routed before the HTTP middlewares on purpose, so they
+// skip the max body size, context setup, tracing, metrics, API token, API
+// logging and HTTP pipeline middlewares. The Connect handlers run the same
+// interceptor chain as the gRPC server instead, which provides the access
+// list, token authentication, tracing, metrics, API logging and the gRPC
+// pipeline, and enforce the max request body size themselves. Only CORS is
+// applied here.
+func (s *server) withConnectHandlers(next http.Handler) http.Handler {
+	if len(s.connectHandlers) == 0 {
+		return next
+	}
+
+	r := chi.NewRouter()
+	// Allow browsers to read the status of gRPC-Web responses.
+	s.useCors(r, "Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin")
+	for path, h := range s.connectHandlers {
+		log.Infof("Serving %s over the Connect, gRPC and gRPC-Web protocols", strings.Trim(path, "/"))
+		r.Handle(path+"*", h)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		for path := range s.connectHandlers {
+			if strings.HasPrefix(req.URL.Path, path) {
+				r.ServeHTTP(w, req)
+				return
+			}
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (s *server) useCors(r chi.Router, exposedHeaders ...string) {
 	if s.config.AllowedOrigins == corsDapr.DefaultAllowedOrigins {
 		return
 	}
 	log.Info("Enabled CORS HTTP middleware")
 
-	if s.config.AllowedOrigins == corsDapr.AllowAllOrigins {
-		r.Use(cors.AllowAll().Handler)
-		return
+	allowedOrigins := []string{"*"}
+	if s.config.AllowedOrigins != corsDapr.AllowAllOrigins {
+		allowedOrigins = strings.Split(s.config.AllowedOrigins, ",")
 	}
 
 	r.Use(cors.New(cors.Options{
-		AllowedOrigins: strings.Split(s.config.AllowedOrigins, ","),
+		AllowedOrigins: allowedOrigins,
 		AllowedMethods: []string{
 			http.MethodHead,
 			http.MethodGet,
@@ -383,6 +424,7 @@ func (s *server) useCors(r chi.Router) {
 			http.MethodDelete,
 		},
 		AllowedHeaders: []string{"*"},
+		ExposedHeaders: exposedHeaders,
 		Debug:          false,
 	}).Handler)
 }
@@ -16,9 +16,11 @@ package http
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -29,6 +31,7 @@ import (
 	"github.com/phayes/freeport"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
 
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
 	"github.com/dapr/dapr/pkg/config"
@@ -145,6 +148,132 @@ func TestCorsHandler(t *testing.T) {
 	})
 }
 
+func TestConnectHandlers(t *testing.T) {
+	srv := newServer()
+	srv.config.AllowedOrigins = cors.AllowAllOrigins
+	srv.connectHandlers = map[string]http.Handler{
+		"/test.v1.Service/": http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		}),
+	}
+
+	r := chi.NewRouter()
+	r.Use(APITokenAuthMiddleware("test"))
+	r.Get("/*", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	h := srv.withConnectHandlers(r)
+
+	t.Run("request to a connect handler skips the HTTP middleware", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/test.v1.Service/Method", nil))
+		assert.Equal(t, http.StatusTeapot, w.Code)
+	})
+
+	t.Run("CORS preflight request to a connect handler", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodOptions, "/test.v1.Service/Method", nil)
+		r.Header.Set("Origin", "http://test.com")
+		r.Header.Set("Access-Control-Request-Method", "POST")
+		h.ServeHTTP(w, r)
+		assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+
+		w = httptest.NewRecorder()
+		r = httptest.NewRequest(http.MethodPost, "/test.v1.Service/Method", nil)
+		r.Header.Set("Origin", "http://test.com")
+		h.ServeHTTP(w, r)
+		assert.Contains(t, w.Header().Get("Access-Control-Expose-Headers"), "Grpc-Status")
+	})
+
+	t.Run("other requests use the HTTP middleware", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1.0/metadata", nil))
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		w = httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/v1.0/metadata", nil)
+		r.Header.Set("dapr-api-token", "test")
+		h.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestConnectHandlersServed(t *testing.T) {
+	start := func(t *testing.T) string {
+		port, err := freeport.GetFreePort()
+		require.NoError(t, err)
+		server := NewServer(NewServerOpts{
+			API: &api{},
+			Config: ServerConfig{
+				AppID:              "test",
+				HostAddress:        "127.0.0.1",
+				Port:               port,
+				APIListenAddresses: []string{"127.0.0.1"},
+				MaxRequestBodySize: 4 << 20,
+				ReadBufferSize:     4 << 10,
+			},
+			Middleware: func(n http.Handler) http.Handler { return n },
+			ConnectHandlers: map[string]http.Handler{
+				"/test.v1.Service/": http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("X-Proto", r.Proto)
+					w.WriteHeader(http.StatusTeapot)
+				}),
+			},
+		})
+		require.NoError(t, server.StartNonBlocking())
+		t.Cleanup(func() { require.NoError(t, server.Close()) })
+		addr := fmt.Sprintf("127.0.0.1:%d", port)
+		dapr_testing.WaitForListeningAddress(t, 5*time.Second, addr)
+		return "http://" + addr + "/test.v1.Service/Method"
+	}
+
+	h2cClient := &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return new(net.Dialer).DialContext(ctx, network, addr)
+			},
+		},
+	}
+
+	post := func(t *testing.T, client *http.Client, url string) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, url, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return resp, err
+	}
+
+	t.Run("h2c enabled", func(t *testing.T) {
+		url := start(t)
+
+		resp, err := post(t, http.DefaultClient, url)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusTeapot, resp.StatusCode)
+		assert.Equal(t, "HTTP/1.1", resp.Header.Get("X-Proto"))
+
+		resp, err = post(t, h2cClient, url)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusTeapot, resp.StatusCode)
+		assert.Equal(t, "HTTP/2.0", resp.Header.Get("X-Proto"))
+	})
+
+	t.Run("h2c disabled", func(t *testing.T) {
+		t.Setenv("DAPR_HTTP_DISABLE_H2C", "true")
+		url := start(t)
+
+		resp, err := post(t, http.DefaultClient, url)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusTeapot, resp.StatusCode)
+		assert.Equal(t, "HTTP/1.1", resp.Header.Get("X-Proto"))
+
+		_, err = post(t, h2cClient, url)
+		require.Error(t, err)
+	})
+}
+
 func TestUnescapeRequestParametersHandler(t *testing.T) {
 	mh := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var param string
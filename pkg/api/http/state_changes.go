@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/sse"
+)
+
+// stateChangeEvent is the data of the Server-Sent Events sent for state
+// changes.
+type stateChangeEvent struct {
+	Key       string            `json:"key"`
+	Operation string            `json:"operation"`
+	Value     json.RawMessage   `json:"value,omitempty"`
+	ETag      string            `json:"etag,omitempty"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+}
+
+// onSubscribeStateChanges streams the changes of the keys and key prefixes
+// given in the query string as Server-Sent Events, one event per change.
+func (a *api) onSubscribeStateChanges(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	in := &runtimev1pb.SubscribeStateChangesRequestAlpha1{
+		StoreName:   chi.URLParam(r, storeNameParam),
+		Keys:        query[stateKeyParam],
+		KeyPrefixes: query[stateKeyPrefixParam],
+	}
+
+	var writer *sse.Writer
+	err := a.universal.SubscribeStateChanges(r.Context(), in, func(res *runtimev1pb.SubscribeStateChangesResponseAlpha1) error {
+		if writer == nil {
+			var ok bool
+			writer, ok = sse.NewWriter(w)
+			if !ok {
+				return errors.New("streaming is not supported by the response writer")
+			}
+		}
+
+		for _, change := range res.GetChanges() {
+			data, err := json.Marshal(newStateChangeEvent(change))
+			if err != nil {
+				return err
+			}
+			err = writer.WriteEvent("", data)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil && writer == nil {
+		log.Debug(err)
+		respondWithError(w, err)
+	}
+}
+
+func newStateChangeEvent(change *runtimev1pb.StateChangeAlpha1) stateChangeEvent {
+	ev := stateChangeEvent{
+		Key:      change.GetKey(),
+		ETag:     change.GetEtag().GetValue(),
+		Metadata: change.GetMetadata(),
+	}
+
+	switch change.GetOperation() {
+	case runtimev1pb.StateChangeAlpha1_DELETE:
+		ev.Operation = "delete"
+	default:
+		ev.Operation = "set"
+		// Values which are not JSON are sent as strings.
+		if json.Valid(change.GetValue()) {
+			ev.Value = change.GetValue()
+		} else {
+			ev.Value, _ = json.Marshal(string(change.GetValue()))
+		}
+	}
+
+	return ev
+}
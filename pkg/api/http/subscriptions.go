@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+)
+
+var endpointGroupMetadataV1Alpha1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupMetadata,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: nil,
+}
+
+// constructSubscriptionEndpoints returns the endpoints to inspect, pause and
+// resume the subscriptions of the app. Unlike the other metadata endpoints,
+// these are not served on the public port.
+func (a *api) constructSubscriptionEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "metadata/subscriptions",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupMetadataV1Alpha1,
+			Handler: a.onListSubscriptions,
+			Settings: endpoints.EndpointSettings{
+				Name: "ListSubscriptions",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "metadata/subscriptions/{pubsubname}/{topic}/pause",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupMetadataV1Alpha1,
+			Handler: a.onPauseSubscription,
+			Settings: endpoints.EndpointSettings{
+				Name: "PauseSubscription",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "metadata/subscriptions/{pubsubname}/{topic}/resume",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupMetadataV1Alpha1,
+			Handler: a.onResumeSubscription,
+			Settings: endpoints.EndpointSettings{
+				Name: "ResumeSubscription",
+			},
+		},
+	}
+}
+
+func (a *api) onListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	if a.subscriber == nil {
+		respondWithJSON(w, http.StatusOK, []any{})
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, a.subscriber.ListSubscriptionStatuses())
+}
+
+func (a *api) onPauseSubscription(w http.ResponseWriter, r *http.Request) {
+	pubsubName := chi.URLParam(r, pubsubnameparam)
+	topic := chi.URLParam(r, topicParam)
+	if a.subscriber == nil {
+		respondWithError(w, apierrors.PubSub(pubsubName).SubscriptionNotFound(topic))
+		return
+	}
+
+	if err := a.subscriber.PauseSubscription(pubsubName, topic); err != nil {
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithEmpty(w)
+}
+
+func (a *api) onResumeSubscription(w http.ResponseWriter, r *http.Request) {
+	pubsubName := chi.URLParam(r, pubsubnameparam)
+	topic := chi.URLParam(r, topicParam)
+	if a.subscriber == nil {
+		respondWithError(w, apierrors.PubSub(pubsubName).SubscriptionNotFound(topic))
+		return
+	}
+
+	if err := a.subscriber.ResumeSubscription(pubsubName, topic); err != nil {
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithEmpty(w)
+}
@@ -27,6 +27,7 @@ import (
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
 )
 
 const (
@@ -72,6 +73,12 @@ func (a *Universal) scheduleJob(ctx context.Context, jobRequest *runtimev1pb.Sch
 		return &runtimev1pb.ScheduleJobResponse{}, apierrors.Empty("Name", errMetadata, errorcodes.SchedulerJobNameEmpty)
 	}
 
+	// Delayed pub/sub messages are stored as jobs of the app, under names which
+	// are reserved for them.
+	if publisher.IsScheduledJob(job.GetName()) {
+		return &runtimev1pb.ScheduleJobResponse{}, apierrors.SchedulerReservedJobName(job.GetName(), errMetadata)
+	}
+
 	if job.Schedule == nil && job.DueTime == nil {
 		return &runtimev1pb.ScheduleJobResponse{}, apierrors.Empty("Schedule", errMetadata, errorcodes.SchedulerScheduleEmpty)
 	}
@@ -122,6 +129,10 @@ func (a *Universal) DeleteJobAlpha1(ctx context.Context, inReq *runtimev1pb.Dele
 		return &runtimev1pb.DeleteJobResponse{}, apierrors.Empty("Name", errMetadata, errorcodes.SchedulerJobNameEmpty)
 	}
 
+	if publisher.IsScheduledJob(inReq.GetName()) {
+		return &runtimev1pb.DeleteJobResponse{}, apierrors.SchedulerReservedJobName(inReq.GetName(), errMetadata)
+	}
+
 	internalDeleteJobReq := &schedulerv1pb.DeleteJobRequest{
 		Name: inReq.GetName(),
 		Metadata: &schedulerv1pb.JobMetadata{
@@ -158,6 +169,10 @@ func (a *Universal) GetJobAlpha1(ctx context.Context, inReq *runtimev1pb.GetJobR
 		return new(runtimev1pb.GetJobResponse), apierrors.Empty("Name", errMetadata, errorcodes.SchedulerJobNameEmpty)
 	}
 
+	if publisher.IsScheduledJob(inReq.GetName()) {
+		return new(runtimev1pb.GetJobResponse), apierrors.SchedulerReservedJobName(inReq.GetName(), errMetadata)
+	}
+
 	internalGetJobReq := &schedulerv1pb.GetJobRequest{
 		Name: inReq.GetName(),
 		Metadata: &schedulerv1pb.JobMetadata{
@@ -247,6 +262,11 @@ func (a *Universal) ListJobsAlpha1(ctx context.Context, req *runtimev1pb.ListJob
 
 	jobs := make([]*runtimev1pb.Job, 0, len(resp.GetJobs()))
 	for _, namedJob := range resp.GetJobs() {
+		// Delayed pub/sub messages are stored as jobs, but are not app jobs.
+		if publisher.IsScheduledJob(namedJob.GetName()) {
+			continue
+		}
+
 		job := namedJob.GetJob()
 		//nolint:protogetter
 		jobs = append(jobs, &runtimev1pb.Job{
@@ -0,0 +1,54 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestReservedJobNames(t *testing.T) {
+	// The Scheduler client is not set, so that any call to it panics.
+	fakeAPI := &Universal{
+		logger: testLogger,
+	}
+
+	name, err := publisher.ScheduledJobName("mypubsub", "abc")
+	require.NoError(t, err)
+
+	t.Run("ScheduleJobAlpha1", func(t *testing.T) {
+		_, err := fakeAPI.ScheduleJobAlpha1(t.Context(), &runtimev1pb.ScheduleJobRequest{
+			Job: &runtimev1pb.Job{Name: name, DueTime: ptr.Of("1m")},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("GetJobAlpha1", func(t *testing.T) {
+		_, err := fakeAPI.GetJobAlpha1(t.Context(), &runtimev1pb.GetJobRequest{Name: name})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("DeleteJobAlpha1", func(t *testing.T) {
+		_, err := fakeAPI.DeleteJobAlpha1(t.Context(), &runtimev1pb.DeleteJobRequest{Name: name})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
+)
+
+// SchedulePublishEvent stores the publish request as a Scheduler job. When the
+// job is triggered, the sidecar publishes the message itself, so delayed
+// delivery works for every pub/sub component. If id is empty, a random ID is
+// generated. Returns the ID which can be used to cancel the message.
+func (a *Universal) SchedulePublishEvent(ctx context.Context, req *contribpubsub.PublishRequest, id string, deliverAt time.Time) (string, error) {
+	ps, ok := a.compStore.GetPubSub(req.PubsubName)
+	if !ok {
+		return "", apierrors.PubSub(req.PubsubName).WithMetadata(nil).NotFound()
+	}
+
+	if !rtpubsub.IsOperationAllowed(req.Topic, ps, ps.ScopedPublishings) {
+		err := rtpubsub.NotAllowedError{Topic: req.Topic, ID: a.appID}
+		return "", apierrors.PubSub(req.PubsubName).PublishForbidden(req.Topic, a.appID, err)
+	}
+
+	// Reject invalid messages now, rather than when they are delivered.
+	if err := rtpubsub.ValidatePublishSchema(ps, req); err != nil {
+		return "", apierrors.PubSub(req.PubsubName).SchemaValidation(req.Topic, err)
+	}
+
+	if len(id) == 0 {
+		randomID, err := uuid.NewRandom()
+		if err != nil {
+			return "", apierrors.PubSub(req.PubsubName).SchedulePublish(req.Topic, err)
+		}
+		id = randomID.String()
+	}
+
+	if _, err := publisher.ScheduledJobName(req.PubsubName, id); err != nil {
+		return "", apierrors.PubSub(req.PubsubName).ScheduledInvalid(id, err)
+	}
+
+	schedCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	err := publisher.Schedule(schedCtx, a.scheduler, a.appID, a.Namespace(), id, req, deliverAt, false)
+	if err != nil {
+		a.logger.Errorf("Error scheduling message %s to topic %s: %s", id, req.Topic, err)
+		return "", apierrors.PubSub(req.PubsubName).SchedulePublish(req.Topic, err)
+	}
+
+	return id, nil
+}
+
+// CancelScheduledPublishEventAlpha1 cancels a message which was scheduled for
+// delayed delivery and has not yet been published.
+func (a *Universal) CancelScheduledPublishEventAlpha1(ctx context.Context, pubsubName, id string) error {
+	if pubsubName == "" {
+		return apierrors.PubSub("").WithMetadata(nil).NameEmpty()
+	}
+
+	name, err := publisher.ScheduledJobName(pubsubName, id)
+	if err != nil {
+		return apierrors.PubSub(pubsubName).ScheduledInvalid(id, err)
+	}
+
+	schedCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	_, err = a.scheduler.DeleteJob(schedCtx, &schedulerv1pb.DeleteJobRequest{
+		Name:     name,
+		Metadata: publisher.ScheduledJobMetadata(a.appID, a.Namespace()),
+	}, grpc.WaitForReady(true))
+	if status.Code(err) == codes.NotFound {
+		return apierrors.PubSub(pubsubName).ScheduledNotFound(id)
+	}
+	if err != nil {
+		a.logger.Errorf("Error cancelling scheduled message %s: %s", id, err)
+		return apierrors.PubSub(pubsubName).CancelScheduled(id, err)
+	}
+
+	return nil
+}
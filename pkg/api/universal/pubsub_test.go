@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
+)
+
+// fakeScheduler records the jobs deleted through it. Calls to other methods
+// of the Scheduler client panic.
+type fakeScheduler struct {
+	schedulerv1pb.SchedulerClient
+
+	deleted   []*schedulerv1pb.DeleteJobRequest
+	deleteErr error
+}
+
+func (f *fakeScheduler) Addresses() []string {
+	return nil
+}
+
+func (f *fakeScheduler) DeleteJob(_ context.Context, req *schedulerv1pb.DeleteJobRequest, _ ...grpc.CallOption) (*schedulerv1pb.DeleteJobResponse, error) {
+	f.deleted = append(f.deleted, req)
+	return new(schedulerv1pb.DeleteJobResponse), f.deleteErr
+}
+
+func TestCancelScheduledPublishEventAlpha1(t *testing.T) {
+	t.Run("deletes the job of the message", func(t *testing.T) {
+		scheduler := new(fakeScheduler)
+		fakeAPI := &Universal{
+			logger:    testLogger,
+			appID:     "myapp",
+			namespace: "ns1",
+			scheduler: scheduler,
+		}
+
+		require.NoError(t, fakeAPI.CancelScheduledPublishEventAlpha1(t.Context(), "mypubsub", "abc"))
+
+		name, err := publisher.ScheduledJobName("mypubsub", "abc")
+		require.NoError(t, err)
+		require.Len(t, scheduler.deleted, 1)
+		assert.Equal(t, name, scheduler.deleted[0].GetName())
+		assert.Equal(t, "myapp", scheduler.deleted[0].GetMetadata().GetAppId())
+		assert.Equal(t, "ns1", scheduler.deleted[0].GetMetadata().GetNamespace())
+		assert.NotNil(t, scheduler.deleted[0].GetMetadata().GetTarget().GetJob())
+	})
+
+	t.Run("pubsub name and ID are required", func(t *testing.T) {
+		scheduler := new(fakeScheduler)
+		fakeAPI := &Universal{
+			logger:    testLogger,
+			scheduler: scheduler,
+		}
+
+		require.Error(t, fakeAPI.CancelScheduledPublishEventAlpha1(t.Context(), "", "abc"))
+		err := fakeAPI.CancelScheduledPublishEventAlpha1(t.Context(), "mypubsub", "")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		err = fakeAPI.CancelScheduledPublishEventAlpha1(t.Context(), "my__pubsub", "abc")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Empty(t, scheduler.deleted)
+	})
+
+	t.Run("scheduled message not found", func(t *testing.T) {
+		fakeAPI := &Universal{
+			logger:    testLogger,
+			scheduler: &fakeScheduler{deleteErr: status.Error(codes.NotFound, "job not found")},
+		}
+
+		err := fakeAPI.CancelScheduledPublishEventAlpha1(t.Context(), "mypubsub", "abc")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("scheduler error", func(t *testing.T) {
+		fakeAPI := &Universal{
+			logger:    testLogger,
+			scheduler: &fakeScheduler{deleteErr: errors.New("not found")},
+		}
+
+		err := fakeAPI.CancelScheduledPublishEventAlpha1(t.Context(), "mypubsub", "abc")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"github.com/dapr/components-contrib/state"
+)
+
+// InvalidateStateCache removes the keys of the given operations from the read
+// cache of a state store, if it has one. It must be called after every write,
+// including failed ones, since a failed write may have been partially applied.
+func InvalidateStateCache[T state.TransactionalStateOperation](a *Universal, storeName string, ops ...T) {
+	cache := a.compStore.GetStateStoreCache(storeName)
+	if cache == nil {
+		return
+	}
+
+	keys := make([]string, len(ops))
+	for i, op := range ops {
+		keys[i] = op.GetKey()
+	}
+	cache.Invalidate(keys...)
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/dapr/components-contrib/state"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/encryption"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+const (
+	// stateChangesBufferSize is the number of changes buffered for a
+	// subscriber. A subscriber which falls further behind is terminated, so
+	// writes are never blocked by slow subscribers.
+	stateChangesBufferSize = 256
+	// stateChangesMaxBatch is the maximum number of changes sent in a single
+	// response.
+	stateChangesMaxBatch = 64
+)
+
+// stateChanges fans out the changes of state stores to their subscribers.
+// The changes of a store come from its native change feed if it implements
+// stateLoader.ChangeFeeder, or else from the writes made through the state
+// API of the sidecar. Writes made by actors and workflows, and by other
+// sidecars, are only seen through a native change feed. No state store
+// implements ChangeFeeder yet.
+type stateChanges struct {
+	lock   sync.Mutex
+	stores map[string]*stateChangesStore
+}
+
+type stateChangesStore struct {
+	subscribers map[*stateChangesSubscriber]struct{}
+	// cancelFeed stops reading the native change feed of the store. It is nil
+	// if the store has no change feed.
+	cancelFeed context.CancelFunc
+}
+
+type stateChangesSubscriber struct {
+	keys      map[string]struct{}
+	prefixes  []string
+	changesCh chan *runtimev1pb.StateChangeAlpha1
+	closeOnce sync.Once
+	closedCh  chan struct{}
+	err       error
+}
+
+// SubscribeStateChanges subscribes to the changes of keys and key
+// prefixes in a state store, and calls send with batches of changes until
+// the context is canceled. The first call of send has no changes, and
+// signals that the subscription is ready.
+func (a *Universal) SubscribeStateChanges(ctx context.Context, in *runtimev1pb.SubscribeStateChangesRequestAlpha1, send func(*runtimev1pb.SubscribeStateChangesResponseAlpha1) error) error {
+	store, err := a.GetStateStore(in.GetStoreName())
+	if err != nil {
+		// Error has already been logged
+		return err
+	}
+
+	if len(in.GetKeys()) == 0 && len(in.GetKeyPrefixes()) == 0 {
+		err = apierrors.StateStore(in.GetStoreName()).SubscribeChangesKeysRequired()
+		a.logger.Debug(err)
+		return err
+	}
+
+	sub := &stateChangesSubscriber{
+		keys:      make(map[string]struct{}, len(in.GetKeys())),
+		prefixes:  make([]string, len(in.GetKeyPrefixes())),
+		changesCh: make(chan *runtimev1pb.StateChangeAlpha1, stateChangesBufferSize),
+		closedCh:  make(chan struct{}),
+	}
+	for _, key := range in.GetKeys() {
+		key, err = stateLoader.GetModifiedStateKey(key, in.GetStoreName(), a.appID)
+		if err != nil {
+			a.logger.Debug(err)
+			return err
+		}
+		sub.keys[key] = struct{}{}
+	}
+	for i, prefix := range in.GetKeyPrefixes() {
+		sub.prefixes[i], err = stateLoader.GetModifiedStateKey(prefix, in.GetStoreName(), a.appID)
+		if err != nil {
+			a.logger.Debug(err)
+			return err
+		}
+	}
+
+	a.stateChanges.subscribe(in.GetStoreName(), store, sub)
+	defer a.stateChanges.unsubscribe(in.GetStoreName(), sub)
+
+	err = send(&runtimev1pb.SubscribeStateChangesResponseAlpha1{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.closedCh:
+			a.logger.Debug(sub.err)
+			return sub.err
+		case change := <-sub.changesCh:
+			changes := []*runtimev1pb.StateChangeAlpha1{change}
+		batch:
+			for len(changes) < stateChangesMaxBatch {
+				select {
+				case change = <-sub.changesCh:
+					changes = append(changes, change)
+				default:
+					break batch
+				}
+			}
+
+			err = send(&runtimev1pb.SubscribeStateChangesResponseAlpha1{
+				Changes: changes,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// NotifyStateChanges notifies the subscribers of a state store of operations
+// which were applied through the state API. Keys are expected to be modified
+// with their key prefix. It is a no-op for state stores with a native change
+// feed, whose changes are read from the feed instead.
+func NotifyStateChanges[T state.TransactionalStateOperation](ctx context.Context, a *Universal, storeName string, ops ...T) {
+	for _, op := range ops {
+		a.stateChanges.publish(ctx, storeName, op, false)
+	}
+}
+
+func (s *stateChanges) subscribe(storeName string, store state.Store, sub *stateChangesSubscriber) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.stores == nil {
+		s.stores = make(map[string]*stateChangesStore)
+	}
+
+	st, ok := s.stores[storeName]
+	if !ok {
+		st = &stateChangesStore{
+			subscribers: make(map[*stateChangesSubscriber]struct{}),
+		}
+		s.stores[storeName] = st
+		if feeder, ok := store.(stateLoader.ChangeFeeder); ok {
+			var ctx context.Context
+			ctx, st.cancelFeed = context.WithCancel(context.Background())
+			go s.readFeed(ctx, storeName, st, feeder)
+		}
+	}
+	st.subscribers[sub] = struct{}{}
+}
+
+func (s *stateChanges) unsubscribe(storeName string, sub *stateChangesSubscriber) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	st, ok := s.stores[storeName]
+	if !ok {
+		return
+	}
+	if _, ok = st.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(st.subscribers, sub)
+	if len(st.subscribers) == 0 {
+		if st.cancelFeed != nil {
+			st.cancelFeed()
+		}
+		delete(s.stores, storeName)
+	}
+}
+
+// readFeed reads the native change feed of a store until the context is
+// canceled. If the feed stops, all the subscribers of the store are
+// terminated, so that they can subscribe again.
+func (s *stateChanges) readFeed(ctx context.Context, storeName string, st *stateChangesStore, feeder stateLoader.ChangeFeeder) {
+	err := feeder.SubscribeChanges(ctx, func(ctx context.Context, op state.TransactionalStateOperation) error {
+		s.publish(ctx, storeName, op, true)
+		return nil
+	})
+	if ctx.Err() != nil {
+		return
+	}
+	if err == nil {
+		err = errors.New("change feed was closed")
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.stores[storeName] != st {
+		return
+	}
+	delete(s.stores, storeName)
+	st.cancelFeed()
+	for sub := range st.subscribers {
+		sub.close(apierrors.StateStore(storeName).SubscribeChangesFailed(err.Error()))
+	}
+}
+
+func (s *stateChanges) publish(ctx context.Context, storeName string, op state.TransactionalStateOperation, fromFeed bool) {
+	s.lock.Lock()
+	st, ok := s.stores[storeName]
+	if !ok || (st.cancelFeed != nil) != fromFeed {
+		s.lock.Unlock()
+		return
+	}
+	var subs []*stateChangesSubscriber
+	for sub := range st.subscribers {
+		if sub.matches(op.GetKey()) {
+			subs = append(subs, sub)
+		}
+	}
+	s.lock.Unlock()
+
+	if len(subs) == 0 {
+		return
+	}
+
+	// Computing the change may decrypt the value, which can call a remote key
+	// store, so it is done without holding the lock.
+	change, err := stateChange(ctx, storeName, op, fromFeed)
+	for _, sub := range subs {
+		if err != nil {
+			sub.close(apierrors.StateStore(storeName).SubscribeChangesFailed(err.Error()))
+			continue
+		}
+
+		select {
+		case sub.changesCh <- change:
+		default:
+			sub.close(apierrors.StateStore(storeName).SubscribeChangesFailed("subscriber is too slow to receive the changes"))
+		}
+	}
+}
+
+func (s *stateChangesSubscriber) matches(key string) bool {
+	if _, ok := s.keys[key]; ok {
+		return true
+	}
+	for _, prefix := range s.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *stateChangesSubscriber) close(err error) {
+	s.closeOnce.Do(func() {
+		s.err = err
+		close(s.closedCh)
+	})
+}
+
+// stateChange returns the change sent to subscribers for the given operation.
+// Values are returned as read from the store: raw bytes are returned as is,
+// other values are serialized as JSON, and encrypted values are decrypted.
+// The ETag of requests made through the state API is the ETag they were
+// conditioned on, so it is only reported for changes read from a change feed.
+func stateChange(ctx context.Context, storeName string, op state.TransactionalStateOperation, withETag bool) (*runtimev1pb.StateChangeAlpha1, error) {
+	change := &runtimev1pb.StateChangeAlpha1{
+		Key:      stateLoader.GetOriginalStateKey(op.GetKey()),
+		Metadata: op.GetMetadata(),
+	}
+
+	var (
+		value any
+		etag  *string
+	)
+	switch req := op.(type) {
+	case state.SetRequest:
+		value, etag = req.Value, req.ETag
+	case *state.SetRequest:
+		value, etag = req.Value, req.ETag
+	default:
+		change.Operation = runtimev1pb.StateChangeAlpha1_DELETE
+		return change, nil
+	}
+
+	change.Operation = runtimev1pb.StateChangeAlpha1_SET
+	if withETag && etag != nil {
+		change.Etag = &commonv1pb.Etag{Value: *etag}
+	}
+
+	data, ok := value.([]byte)
+	if !ok {
+		var err error
+		data, err = json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if encryption.EncryptedStateStore(storeName) {
+		var err error
+		data, err = encryption.TryDecryptValue(ctx, storeName, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	change.Value = data
+
+	return change, nil
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/ptr"
+)
+
+// changeFeedStateStore is a state store with a native change feed.
+type changeFeedStateStore struct {
+	*daprt.FakeStateStore
+	opsCh chan state.TransactionalStateOperation
+	errCh chan error
+}
+
+func (s *changeFeedStateStore) SubscribeChanges(ctx context.Context, handler stateLoader.ChangeFeedHandler) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-s.errCh:
+			return err
+		case op := <-s.opsCh:
+			if err := handler(ctx, op); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type stateChangesSubscription struct {
+	changesCh chan []*runtimev1pb.StateChangeAlpha1
+	errCh     chan error
+}
+
+func subscribeStateChanges(t *testing.T, u *Universal, in *runtimev1pb.SubscribeStateChangesRequestAlpha1) *stateChangesSubscription {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	t.Cleanup(cancel)
+
+	sub := &stateChangesSubscription{
+		changesCh: make(chan []*runtimev1pb.StateChangeAlpha1, 10),
+		errCh:     make(chan error, 1),
+	}
+	readyCh := make(chan struct{})
+	go func() {
+		sub.errCh <- u.SubscribeStateChanges(ctx, in, func(res *runtimev1pb.SubscribeStateChangesResponseAlpha1) error {
+			if res.GetChanges() == nil {
+				close(readyCh)
+				return nil
+			}
+			sub.changesCh <- res.GetChanges()
+			return nil
+		})
+	}()
+
+	select {
+	case <-readyCh:
+	case err := <-sub.errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for the subscription")
+	}
+	return sub
+}
+
+func (s *stateChangesSubscription) next(t *testing.T) []*runtimev1pb.StateChangeAlpha1 {
+	t.Helper()
+	select {
+	case changes := <-s.changesCh:
+		return changes
+	case err := <-s.errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for state changes")
+	}
+	return nil
+}
+
+func TestSubscribeStateChanges(t *testing.T) {
+	newUniversal := func(store state.Store) *Universal {
+		compStore := compstore.New()
+		compStore.AddStateStore("store1", store)
+		return &Universal{
+			appID:     "myapp",
+			logger:    testLogger,
+			compStore: compStore,
+		}
+	}
+
+	t.Run("invalid requests", func(t *testing.T) {
+		u := newUniversal(daprt.NewFakeStateStore())
+
+		err := u.SubscribeStateChanges(t.Context(), &runtimev1pb.SubscribeStateChangesRequestAlpha1{
+			StoreName: "store1",
+		}, nil)
+		require.ErrorContains(t, err, "at least one key or key prefix is required")
+
+		err = u.SubscribeStateChanges(t.Context(), &runtimev1pb.SubscribeStateChangesRequestAlpha1{
+			StoreName: "store2",
+			Keys:      []string{"key1"},
+		}, nil)
+		require.Error(t, err)
+
+		err = u.SubscribeStateChanges(t.Context(), &runtimev1pb.SubscribeStateChangesRequestAlpha1{
+			StoreName: "store1",
+			Keys:      []string{"app||key1"},
+		}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("changes made through the state API", func(t *testing.T) {
+		u := newUniversal(daprt.NewFakeStateStore())
+		sub := subscribeStateChanges(t, u, &runtimev1pb.SubscribeStateChangesRequestAlpha1{
+			StoreName:   "store1",
+			Keys:        []string{"key1"},
+			KeyPrefixes: []string{"order-"},
+		})
+
+		NotifyStateChanges(t.Context(), u, "store1",
+			state.SetRequest{Key: "myapp||key1", Value: []byte("v1"), ETag: ptr.Of("condition")},
+			state.SetRequest{Key: "myapp||key2", Value: []byte("v2")},
+			state.SetRequest{Key: "otherapp||key1", Value: []byte("v3")},
+		)
+		NotifyStateChanges(t.Context(), u, "store2", state.DeleteRequest{Key: "myapp||key1"})
+		NotifyStateChanges(t.Context(), u, "store1", state.DeleteRequest{Key: "myapp||order-1"})
+
+		changes := sub.next(t)
+		if len(changes) == 1 {
+			changes = append(changes, sub.next(t)...)
+		}
+		require.Len(t, changes, 2)
+		assert.Equal(t, "key1", changes[0].GetKey())
+		assert.Equal(t, runtimev1pb.StateChangeAlpha1_SET, changes[0].GetOperation())
+		assert.Equal(t, []byte("v1"), changes[0].GetValue())
+		assert.Nil(t, changes[0].GetEtag())
+		assert.Equal(t, "order-1", changes[1].GetKey())
+		assert.Equal(t, runtimev1pb.StateChangeAlpha1_DELETE, changes[1].GetOperation())
+
+		NotifyStateChanges(t.Context(), u, "store1", state.SetRequest{Key: "myapp||order-2", Value: map[string]any{"id": 2}})
+		changes = sub.next(t)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "order-2", changes[0].GetKey())
+		assert.JSONEq(t, `{"id":2}`, string(changes[0].GetValue()))
+	})
+
+	t.Run("slow subscribers are terminated", func(t *testing.T) {
+		u := newUniversal(daprt.NewFakeStateStore())
+
+		readyCh := make(chan struct{})
+		unblockCh := make(chan struct{})
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- u.SubscribeStateChanges(t.Context(), &runtimev1pb.SubscribeStateChangesRequestAlpha1{
+				StoreName: "store1",
+				Keys:      []string{"key1"},
+			}, func(res *runtimev1pb.SubscribeStateChangesResponseAlpha1) error {
+				if res.GetChanges() == nil {
+					close(readyCh)
+					return nil
+				}
+				<-unblockCh
+				return nil
+			})
+		}()
+		<-readyCh
+
+		for range stateChangesBufferSize + 2 {
+			NotifyStateChanges(t.Context(), u, "store1", state.DeleteRequest{Key: "myapp||key1"})
+		}
+		close(unblockCh)
+
+		select {
+		case err := <-errCh:
+			require.ErrorContains(t, err, "too slow")
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timed out waiting for the subscription to end")
+		}
+	})
+
+	t.Run("native change feed", func(t *testing.T) {
+		store := &changeFeedStateStore{
+			FakeStateStore: daprt.NewFakeStateStore(),
+			opsCh:          make(chan state.TransactionalStateOperation),
+			errCh:          make(chan error),
+		}
+		u := newUniversal(store)
+		sub := subscribeStateChanges(t, u, &runtimev1pb.SubscribeStateChangesRequestAlpha1{
+			StoreName: "store1",
+			Keys:      []string{"key1"},
+		})
+
+		// Writes through the state API are read from the feed instead.
+		NotifyStateChanges(t.Context(), u, "store1", state.DeleteRequest{Key: "myapp||key1"})
+
+		store.opsCh <- &state.SetRequest{Key: "myapp||key1", Value: []byte("v1"), ETag: ptr.Of("2")}
+		changes := sub.next(t)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "key1", changes[0].GetKey())
+		assert.Equal(t, runtimev1pb.StateChangeAlpha1_SET, changes[0].GetOperation())
+		assert.Equal(t, []byte("v1"), changes[0].GetValue())
+		assert.Equal(t, "2", changes[0].GetEtag().GetValue())
+
+		store.errCh <- errors.New("feed error")
+		select {
+		case err := <-sub.errCh:
+			require.ErrorContains(t, err, "feed error")
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timed out waiting for the subscription to end")
+		}
+
+		u.stateChanges.lock.Lock()
+		assert.Empty(t, u.stateChanges.stores)
+		u.stateChanges.lock.Unlock()
+	})
+}
@@ -65,6 +65,7 @@ type Universal struct {
 
 	extendedMetadataLock sync.RWMutex
 	actors               actors.Interface
+	stateChanges         stateChanges
 }
 
 func New(opts Options) *Universal {
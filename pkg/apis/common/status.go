@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceStatus is the status of a resource, aggregated by the operator
+// from the results reported by the sidecars which load it.
+type ResourceStatus struct {
+	// ReadyCount is the number of sidecars which loaded the resource
+	// successfully.
+	//+optional
+	ReadyCount int32 `json:"readyCount"`
+	// FailingAppIDs are the IDs of the apps with at least one sidecar which
+	// failed to load the resource.
+	//+optional
+	FailingAppIDs []string `json:"failingAppIDs,omitempty"`
+	// LastError is the last error reported by a sidecar for the resource.
+	//+optional
+	LastError string `json:"lastError,omitempty"`
+	// LastErrorTime is the time LastError was reported.
+	//+optional
+	LastErrorTime *metav1.Time `json:"lastErrorTime,omitempty"`
+	// Sidecars are the results reported by each sidecar which loads the
+	// resource.
+	//+optional
+	Sidecars []SidecarResourceStatus `json:"sidecars,omitempty"`
+}
+
+// SidecarResourceStatus is the result of loading a resource reported by a
+// single sidecar.
+type SidecarResourceStatus struct {
+	// PodName is the name of the pod of the sidecar.
+	PodName string `json:"podName"`
+	// AppID is the app ID of the sidecar.
+	AppID string `json:"appID"`
+	// Ready is true if the sidecar loaded the resource successfully.
+	Ready bool `json:"ready"`
+	// Error is the error the sidecar failed to load the resource with.
+	//+optional
+	Error string `json:"error,omitempty"`
+	// LastReportTime is the time the result was last reported or refreshed.
+	LastReportTime metav1.Time `json:"lastReportTime"`
+}
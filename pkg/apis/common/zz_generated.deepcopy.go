@@ -55,6 +55,37 @@ func (in *NameValuePair) DeepCopy() *NameValuePair {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
+	*out = *in
+	if in.FailingAppIDs != nil {
+		in, out := &in.FailingAppIDs, &out.FailingAppIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastErrorTime != nil {
+		in, out := &in.LastErrorTime, &out.LastErrorTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]SidecarResourceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceStatus.
+func (in *ResourceStatus) DeepCopy() *ResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Scoped) DeepCopyInto(out *Scoped) {
 	*out = *in
@@ -90,6 +121,22 @@ func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SidecarResourceStatus) DeepCopyInto(out *SidecarResourceStatus) {
+	*out = *in
+	in.LastReportTime.DeepCopyInto(&out.LastReportTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarResourceStatus.
+func (in *SidecarResourceStatus) DeepCopy() *SidecarResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLS) DeepCopyInto(out *TLS) {
 	*out = *in
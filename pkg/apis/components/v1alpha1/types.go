@@ -28,8 +28,11 @@ const (
 )
 
 //+genclient
-//+genclient:noStatus
 //+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyCount`
+//+kubebuilder:printcolumn:name="Failing",type=string,JSONPath=`.status.failingAppIDs`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Component describes an Dapr component type.
 //
@@ -43,6 +46,8 @@ type Component struct {
 	//+optional
 	Auth          `json:"auth,omitempty"`
 	common.Scoped `json:",inline"`
+	//+optional
+	Status common.ResourceStatus `json:"status,omitempty"`
 }
 
 // Kind returns the component kind.
@@ -88,7 +93,8 @@ func (c Component) GetScopes() []string {
 }
 
 // EmptyMetaDeepCopy returns a new instance of the component type with the
-// TypeMeta's Kind and APIVersion fields set.
+// TypeMeta's Kind and APIVersion fields set, and the status reported by
+// sidecars cleared.
 func (c Component) EmptyMetaDeepCopy() metav1.Object {
 	n := c.DeepCopy()
 	n.TypeMeta = metav1.TypeMeta{
@@ -96,6 +102,7 @@ func (c Component) EmptyMetaDeepCopy() metav1.Object {
 		APIVersion: components.GroupName + "/" + Version,
 	}
 	n.ObjectMeta = metav1.ObjectMeta{Name: c.Name}
+	n.Status = common.ResourceStatus{}
 	return n
 }
 
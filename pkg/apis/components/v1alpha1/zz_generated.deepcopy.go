@@ -48,6 +48,7 @@ func (in *Component) DeepCopyInto(out *Component) {
 	in.Spec.DeepCopyInto(&out.Spec)
 	out.Auth = in.Auth
 	in.Scoped.DeepCopyInto(&out.Scoped)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Component.
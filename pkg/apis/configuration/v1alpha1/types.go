@@ -19,6 +19,9 @@ import (
 
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/dapr/dapr/pkg/apis/common"
 )
 
 // +genclient
@@ -26,6 +29,8 @@ import (
 // +kubebuilder:object:root=true
 
 // Configuration describes an Dapr configuration setting.
+//
+//nolint:recvcheck
 type Configuration struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
@@ -34,6 +39,63 @@ type Configuration struct {
 	Spec ConfigurationSpec `json:"spec,omitempty"`
 }
 
+// Kind returns the configuration kind.
+func (Configuration) Kind() string {
+	return "Configuration"
+}
+
+func (Configuration) APIVersion() string {
+	return SchemeGroupVersion.String()
+}
+
+// GetName returns the configuration name.
+func (c Configuration) GetName() string {
+	return c.Name
+}
+
+// GetNamespace returns the configuration namespace.
+func (c Configuration) GetNamespace() string {
+	return c.Namespace
+}
+
+// LogName returns the name of the configuration that can be used in logging.
+func (c Configuration) LogName() string {
+	return c.Name
+}
+
+// GetSecretStore returns the name of the secret store. Configurations do not
+// reference secrets.
+func (c Configuration) GetSecretStore() string {
+	return ""
+}
+
+// NameValuePairs returns nil, as configurations have no metadata.
+func (c Configuration) NameValuePairs() []common.NameValuePair {
+	return nil
+}
+
+func (c Configuration) ClientObject() client.Object {
+	return &c
+}
+
+// GetScopes returns nil, as configurations apply to every app referencing
+// them by name.
+func (c Configuration) GetScopes() []string {
+	return nil
+}
+
+// EmptyMetaDeepCopy returns a new instance of the configuration type with the
+// TypeMeta's Kind and APIVersion fields set.
+func (c Configuration) EmptyMetaDeepCopy() metav1.Object {
+	n := c.DeepCopy()
+	n.TypeMeta = metav1.TypeMeta{
+		Kind:       c.Kind(),
+		APIVersion: c.APIVersion(),
+	}
+	n.ObjectMeta = metav1.ObjectMeta{Name: c.Name}
+	return n
+}
+
 // ConfigurationSpec is the spec for a configuration.
 type ConfigurationSpec struct {
 	// +optional
@@ -41,6 +103,10 @@ type ConfigurationSpec struct {
 	// +optional
 	HTTPPipelineSpec *PipelineSpec `json:"httpPipeline,omitempty"`
 	// +optional
+	AppGRPCPipelineSpec *PipelineSpec `json:"appGrpcPipeline,omitempty"`
+	// +optional
+	GRPCPipelineSpec *PipelineSpec `json:"grpcPipeline,omitempty"`
+	// +optional
 	TracingSpec *TracingSpec `json:"tracing,omitempty"`
 	// +kubebuilder:default={enabled:true}
 	MetricSpec *MetricSpec `json:"metric,omitempty"`
@@ -66,6 +132,8 @@ type ConfigurationSpec struct {
 	WasmSpec *WasmSpec `json:"wasm,omitempty"`
 	// +optional
 	WorkflowSpec *WorkflowSpec `json:"workflow,omitempty"`
+	// +optional
+	ServiceInvocationSpec *ServiceInvocationSpec `json:"serviceInvocation,omitempty"`
 }
 
 // WorkflowSpec defines the configuration for Dapr workflows.
@@ -149,6 +217,77 @@ type NameResolutionSpec struct {
 	Configuration *DynamicValue `json:"configuration"`
 }
 
+// ServiceInvocationSpec is the spec for the invocation of other apps.
+type ServiceInvocationSpec struct {
+	// +optional
+	Targets []ServiceInvocationTargetSpec `json:"targets,omitempty"`
+}
+
+// ServiceInvocationTargetSpec configures the invocation of a target app.
+type ServiceInvocationTargetSpec struct {
+	AppID string `json:"appId"`
+	// +optional
+	LoadBalancing *LoadBalancingSpec `json:"loadBalancing,omitempty"`
+	// +optional
+	Mirror *MirrorSpec `json:"mirror,omitempty"`
+	// +optional
+	TrafficSplit *TrafficSplitSpec `json:"trafficSplit,omitempty"`
+}
+
+// TrafficSplitSpec splits the invocations of a target app between weighted
+// backend apps.
+type TrafficSplitSpec struct {
+	Backends []TrafficSplitBackendSpec `json:"backends"`
+	// +optional
+	Overrides []TrafficSplitOverrideSpec `json:"overrides,omitempty"`
+}
+
+// TrafficSplitBackendSpec is a backend app of a traffic split.
+type TrafficSplitBackendSpec struct {
+	AppID string `json:"appId"`
+	// +kubebuilder:validation:Minimum=0
+	Weight int `json:"weight"`
+}
+
+// TrafficSplitOverrideSpec routes the invocations with a header to a backend
+// app.
+type TrafficSplitOverrideSpec struct {
+	Header string `json:"header"`
+	// +optional
+	Value string `json:"value,omitempty"`
+	AppID string `json:"appId"`
+}
+
+// MirrorSpec configures the mirroring of the invocations of a target app to a
+// shadow app.
+type MirrorSpec struct {
+	AppID string `json:"appId"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage int `json:"percentage"`
+}
+
+// LoadBalancingSpec configures the balancing of invocations over the
+// instances of a target app.
+type LoadBalancingSpec struct {
+	// +kubebuilder:validation:Enum=random;roundRobin;leastRequest;powerOfTwoChoices
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
+	// +optional
+	OutlierDetection *OutlierDetectionSpec `json:"outlierDetection,omitempty"`
+}
+
+// OutlierDetectionSpec configures the ejection of the instances of a target
+// app after consecutive invocation errors.
+type OutlierDetectionSpec struct {
+	// +optional
+	ConsecutiveErrors int `json:"consecutiveErrors,omitempty"`
+	// +optional
+	BaseEjectionTime string `json:"baseEjectionTime,omitempty"`
+	// +optional
+	MaxEjectionPercent int `json:"maxEjectionPercent,omitempty"`
+}
+
 // SecretsSpec is the spec for secrets configuration.
 type SecretsSpec struct {
 	Scopes []SecretsScope `json:"scopes"`
@@ -192,6 +331,18 @@ type MTLSSpec struct {
 	// In self-hosted mode, enabling a custom validator will disable the built-in "insecure" validator.
 	// +optional
 	TokenValidators []ValidatorSpec `json:"tokenValidators,omitempty"`
+	// SPIFFE IDs and namespaces Sentry refuses to sign certificates for.
+	// +optional
+	DenyList *MTLSDenyListSpec `json:"denyList,omitempty"`
+}
+
+// MTLSDenyListSpec is the list of SPIFFE IDs and namespaces Sentry refuses to
+// sign certificates for.
+type MTLSDenyListSpec struct {
+	// +optional
+	SPIFFEIDs []string `json:"spiffeIds,omitempty"`
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
 }
 
 // GetEnabled returns true if mTLS is enabled.
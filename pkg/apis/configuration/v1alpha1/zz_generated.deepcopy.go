@@ -250,6 +250,16 @@ func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
 		*out = new(PipelineSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AppGRPCPipelineSpec != nil {
+		in, out := &in.AppGRPCPipelineSpec, &out.AppGRPCPipelineSpec
+		*out = new(PipelineSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPCPipelineSpec != nil {
+		in, out := &in.GRPCPipelineSpec, &out.GRPCPipelineSpec
+		*out = new(PipelineSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TracingSpec != nil {
 		in, out := &in.TracingSpec, &out.TracingSpec
 		*out = new(TracingSpec)
@@ -317,6 +327,11 @@ func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
 		*out = new(WorkflowSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceInvocationSpec != nil {
+		in, out := &in.ServiceInvocationSpec, &out.ServiceInvocationSpec
+		*out = new(ServiceInvocationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationSpec.
@@ -385,6 +400,26 @@ func (in *HandlerSpec) DeepCopy() *HandlerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancingSpec) DeepCopyInto(out *LoadBalancingSpec) {
+	*out = *in
+	if in.OutlierDetection != nil {
+		in, out := &in.OutlierDetection, &out.OutlierDetection
+		*out = new(OutlierDetectionSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancingSpec.
+func (in *LoadBalancingSpec) DeepCopy() *LoadBalancingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoggingSpec) DeepCopyInto(out *LoggingSpec) {
 	*out = *in
@@ -405,6 +440,31 @@ func (in *LoggingSpec) DeepCopy() *LoggingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MTLSDenyListSpec) DeepCopyInto(out *MTLSDenyListSpec) {
+	*out = *in
+	if in.SPIFFEIDs != nil {
+		in, out := &in.SPIFFEIDs, &out.SPIFFEIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MTLSDenyListSpec.
+func (in *MTLSDenyListSpec) DeepCopy() *MTLSDenyListSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MTLSDenyListSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MTLSSpec) DeepCopyInto(out *MTLSSpec) {
 	*out = *in
@@ -430,6 +490,11 @@ func (in *MTLSSpec) DeepCopyInto(out *MTLSSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DenyList != nil {
+		in, out := &in.DenyList, &out.DenyList
+		*out = new(MTLSDenyListSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MTLSSpec.
@@ -562,6 +627,21 @@ func (in *MetricsRule) DeepCopy() *MetricsRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorSpec) DeepCopyInto(out *MirrorSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorSpec.
+func (in *MirrorSpec) DeepCopy() *MirrorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NameResolutionSpec) DeepCopyInto(out *NameResolutionSpec) {
 	*out = *in
@@ -602,6 +682,21 @@ func (in *OtelSpec) DeepCopy() *OtelSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutlierDetectionSpec) DeepCopyInto(out *OutlierDetectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutlierDetectionSpec.
+func (in *OutlierDetectionSpec) DeepCopy() *OutlierDetectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutlierDetectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 	*out = *in
@@ -706,6 +801,58 @@ func (in *SelectorSpec) DeepCopy() *SelectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInvocationSpec) DeepCopyInto(out *ServiceInvocationSpec) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]ServiceInvocationTargetSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInvocationSpec.
+func (in *ServiceInvocationSpec) DeepCopy() *ServiceInvocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceInvocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInvocationTargetSpec) DeepCopyInto(out *ServiceInvocationTargetSpec) {
+	*out = *in
+	if in.LoadBalancing != nil {
+		in, out := &in.LoadBalancing, &out.LoadBalancing
+		*out = new(LoadBalancingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(MirrorSpec)
+		**out = **in
+	}
+	if in.TrafficSplit != nil {
+		in, out := &in.TrafficSplit, &out.TrafficSplit
+		*out = new(TrafficSplitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInvocationTargetSpec.
+func (in *ServiceInvocationTargetSpec) DeepCopy() *ServiceInvocationTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceInvocationTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracingSpec) DeepCopyInto(out *TracingSpec) {
 	*out = *in
@@ -736,6 +883,61 @@ func (in *TracingSpec) DeepCopy() *TracingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficSplitBackendSpec) DeepCopyInto(out *TrafficSplitBackendSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficSplitBackendSpec.
+func (in *TrafficSplitBackendSpec) DeepCopy() *TrafficSplitBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficSplitBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficSplitOverrideSpec) DeepCopyInto(out *TrafficSplitOverrideSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficSplitOverrideSpec.
+func (in *TrafficSplitOverrideSpec) DeepCopy() *TrafficSplitOverrideSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficSplitOverrideSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficSplitSpec) DeepCopyInto(out *TrafficSplitSpec) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]TrafficSplitBackendSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]TrafficSplitOverrideSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficSplitSpec.
+func (in *TrafficSplitSpec) DeepCopy() *TrafficSplitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficSplitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValidatorSpec) DeepCopyInto(out *ValidatorSpec) {
 	*out = *in
@@ -27,8 +27,11 @@ const (
 )
 
 //+genclient
-//+genclient:noStatus
 //+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyCount`
+//+kubebuilder:printcolumn:name="Failing",type=string,JSONPath=`.status.failingAppIDs`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // HTTPEndpoint describes a Dapr HTTPEndpoint type for external service invocation.
 // This endpoint can be external to Dapr, or external to the environment.
@@ -43,6 +46,8 @@ type HTTPEndpoint struct {
 	//+optional
 	Auth          `json:"auth,omitempty"`
 	common.Scoped `json:",inline"`
+	//+optional
+	Status common.ResourceStatus `json:"status,omitempty"`
 }
 
 const kind = "HTTPEndpoint"
@@ -120,7 +125,8 @@ func (h HTTPEndpoint) GetScopes() []string {
 }
 
 // EmptyMetaDeepCopy returns a new instance of the component type with the
-// TypeMeta's Kind and APIVersion fields set.
+// TypeMeta's Kind and APIVersion fields set, and the status reported by
+// sidecars cleared.
 func (h HTTPEndpoint) EmptyMetaDeepCopy() metav1.Object {
 	n := h.DeepCopy()
 	n.TypeMeta = metav1.TypeMeta{
@@ -128,6 +134,7 @@ func (h HTTPEndpoint) EmptyMetaDeepCopy() metav1.Object {
 		APIVersion: httpendpoint.GroupName + "/" + Version,
 	}
 	n.ObjectMeta = metav1.ObjectMeta{Name: h.Name}
+	n.Status = common.ResourceStatus{}
 	return n
 }
 
@@ -17,12 +17,16 @@ import (
 	"encoding/json"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/dapr/dapr/pkg/apis/common"
 )
 
 // +genclient
 // +genclient:noStatus
 // +kubebuilder:object:root=true
 
+//nolint:recvcheck
 type Resiliency struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
@@ -33,6 +37,61 @@ type Resiliency struct {
 	Scopes []string `json:"scopes,omitempty"`
 }
 
+// Kind returns the resiliency kind.
+func (Resiliency) Kind() string {
+	return "Resiliency"
+}
+
+func (Resiliency) APIVersion() string {
+	return SchemeGroupVersion.String()
+}
+
+// GetName returns the resiliency name.
+func (r Resiliency) GetName() string {
+	return r.Name
+}
+
+// GetNamespace returns the resiliency namespace.
+func (r Resiliency) GetNamespace() string {
+	return r.Namespace
+}
+
+// LogName returns the name of the resiliency that can be used in logging.
+func (r Resiliency) LogName() string {
+	return r.Name
+}
+
+// GetSecretStore returns the name of the secret store. Resiliencies do not
+// reference secrets.
+func (r Resiliency) GetSecretStore() string {
+	return ""
+}
+
+// NameValuePairs returns nil, as resiliencies have no metadata.
+func (r Resiliency) NameValuePairs() []common.NameValuePair {
+	return nil
+}
+
+func (r Resiliency) ClientObject() client.Object {
+	return &r
+}
+
+func (r Resiliency) GetScopes() []string {
+	return r.Scopes
+}
+
+// EmptyMetaDeepCopy returns a new instance of the resiliency type with the
+// TypeMeta's Kind and APIVersion fields set.
+func (r Resiliency) EmptyMetaDeepCopy() metav1.Object {
+	n := r.DeepCopy()
+	n.TypeMeta = metav1.TypeMeta{
+		Kind:       r.Kind(),
+		APIVersion: r.APIVersion(),
+	}
+	n.ObjectMeta = metav1.ObjectMeta{Name: r.Name}
+	return n
+}
+
 // String implements fmt.Stringer and is used for debugging. It returns the policy object encoded as JSON.
 func (r *Resiliency) String() string {
 	b, _ := json.Marshal(r)
@@ -27,9 +27,12 @@ const (
 )
 
 // +genclient
-// +genclient:noStatus
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyCount`
+// +kubebuilder:printcolumn:name="Failing",type=string,JSONPath=`.status.failingAppIDs`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Subscription describes an pub/sub event subscription.
 //
@@ -41,6 +44,8 @@ type Subscription struct {
 	Spec              SubscriptionSpec `json:"spec,omitempty"`
 	// +optional
 	Scopes []string `json:"scopes,omitempty"`
+	// +optional
+	Status common.ResourceStatus `json:"status,omitempty"`
 }
 
 // SubscriptionSpec is the spec for an event subscription.
@@ -58,6 +63,57 @@ type SubscriptionSpec struct {
 	DeadLetterTopic string `json:"deadLetterTopic,omitempty"`
 	// The option to enable bulk subscription for this topic.
 	BulkSubscribe BulkSubscribe `json:"bulkSubscribe,omitempty"`
+	// The optional per-key ordered delivery configuration for this topic.
+	// +optional
+	Ordering *Ordering `json:"ordering,omitempty"`
+	// The optional list of non-blocking retry tiers for this topic. Events
+	// which fail to be processed are republished to each tier in turn before
+	// being sent to the dead letter topic.
+	// +optional
+	RetryTopics []RetryTopic `json:"retryTopics,omitempty"`
+	// The optional deduplication configuration for this topic.
+	// +optional
+	Deduplication *Deduplication `json:"deduplication,omitempty"`
+}
+
+// Deduplication configures the subscription to record the IDs of processed
+// events in a state store, so that redelivered events are acknowledged
+// without being delivered to the app again. Deduplication is not applied to
+// bulk subscriptions.
+type Deduplication struct {
+	// The name of the state store component the processed event IDs are
+	// recorded in.
+	StateStore string `json:"stateStore"`
+	// The duration processed event IDs are remembered for, for example "24h".
+	// Defaults to 24h.
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+}
+
+// RetryTopic is a non-blocking retry tier of a subscription.
+type RetryTopic struct {
+	// The topic failed events are republished to.
+	Topic string `json:"topic"`
+	// The delay after which republished events are redelivered to the app,
+	// for example "10m".
+	Delay string `json:"delay"`
+}
+
+// Ordering configures the delivery of events which share the same ordering
+// key to the app strictly in sequence. Events with different keys are
+// delivered in parallel. Ordering is not applied to bulk subscriptions.
+type Ordering struct {
+	// The CloudEvent attribute whose value is used as the ordering key.
+	// +optional
+	Key string `json:"key,omitempty"`
+	// The optional CEL expression used to compute the ordering key from the
+	// event. Takes precedence over key if both are set.
+	// +optional
+	Expression string `json:"expression,omitempty"`
+	// The maximum number of distinct keys delivered to the app in parallel.
+	// Zero means no limit.
+	// +optional
+	MaxConcurrency int32 `json:"maxConcurrency,omitempty"`
 }
 
 // BulkSubscribe encapsulates the bulk subscription configuration for a topic.
@@ -111,7 +167,8 @@ func (Subscription) APIVersion() string {
 }
 
 // EmptyMetaDeepCopy returns a new instance of the subscription type with the
-// TypeMeta's Kind and APIVersion fields set.
+// TypeMeta's Kind and APIVersion fields set, and the status reported by
+// sidecars cleared.
 func (s Subscription) EmptyMetaDeepCopy() metav1.Object {
 	n := s.DeepCopy()
 	n.TypeMeta = metav1.TypeMeta{
@@ -119,6 +176,7 @@ func (s Subscription) EmptyMetaDeepCopy() metav1.Object {
 		APIVersion: subscriptions.GroupName + "/" + Version,
 	}
 	n.ObjectMeta = metav1.ObjectMeta{Name: s.Name}
+	n.Status = common.ResourceStatus{}
 	return n
 }
 
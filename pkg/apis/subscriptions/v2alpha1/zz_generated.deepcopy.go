@@ -39,6 +39,51 @@ func (in *BulkSubscribe) DeepCopy() *BulkSubscribe {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Deduplication) DeepCopyInto(out *Deduplication) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deduplication.
+func (in *Deduplication) DeepCopy() *Deduplication {
+	if in == nil {
+		return nil
+	}
+	out := new(Deduplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ordering) DeepCopyInto(out *Ordering) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ordering.
+func (in *Ordering) DeepCopy() *Ordering {
+	if in == nil {
+		return nil
+	}
+	out := new(Ordering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryTopic) DeepCopyInto(out *RetryTopic) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryTopic.
+func (in *RetryTopic) DeepCopy() *RetryTopic {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryTopic)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Routes) DeepCopyInto(out *Routes) {
 	*out = *in
@@ -85,6 +130,7 @@ func (in *Subscription) DeepCopyInto(out *Subscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subscription.
@@ -149,6 +195,21 @@ func (in *SubscriptionSpec) DeepCopyInto(out *SubscriptionSpec) {
 	}
 	in.Routes.DeepCopyInto(&out.Routes)
 	out.BulkSubscribe = in.BulkSubscribe
+	if in.Ordering != nil {
+		in, out := &in.Ordering, &out.Ordering
+		*out = new(Ordering)
+		**out = **in
+	}
+	if in.RetryTopics != nil {
+		in, out := &in.RetryTopics, &out.RetryTopics
+		*out = make([]RetryTopic, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deduplication != nil {
+		in, out := &in.Deduplication, &out.Deduplication
+		*out = new(Deduplication)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionSpec.
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpcgo "google.golang.org/grpc"
+
+	h "github.com/dapr/components-contrib/middleware"
+	"github.com/dapr/dapr/pkg/components/middleware/grpc"
+	"github.com/dapr/dapr/pkg/middleware"
+	"github.com/dapr/kit/logger"
+)
+
+func TestRegistry(t *testing.T) {
+	testRegistry := grpc.NewRegistry()
+
+	t.Run("middleware is registered", func(t *testing.T) {
+		const (
+			middlewareName   = "mockMiddleware"
+			middlewareNameV2 = "mockMiddleware/v2"
+			componentName    = "middleware.grpc." + middlewareName
+		)
+
+		// Initiate mock object
+		mock := middleware.GRPC{
+			Unary: func(ctx context.Context, req any, _ *grpcgo.UnaryServerInfo, handler grpcgo.UnaryHandler) (any, error) {
+				return handler(ctx, req)
+			},
+		}
+		mockV2 := middleware.GRPC{
+			Unary: func(ctx context.Context, req any, _ *grpcgo.UnaryServerInfo, handler grpcgo.UnaryHandler) (any, error) {
+				return handler(ctx, req)
+			},
+		}
+		metadata := h.Metadata{}
+
+		// act
+		testRegistry.RegisterComponent(func(_ logger.Logger) grpc.FactoryMethod {
+			return func(h.Metadata) (middleware.GRPC, error) {
+				return mock, nil
+			}
+		}, middlewareName)
+		testRegistry.RegisterComponent(func(_ logger.Logger) grpc.FactoryMethod {
+			return func(h.Metadata) (middleware.GRPC, error) {
+				return mockV2, nil
+			}
+		}, middlewareNameV2)
+
+		// assert v0 and v1
+		p, e := testRegistry.Create(componentName, "v0", metadata, "")
+		require.NoError(t, e)
+		assert.Equal(t, reflect.ValueOf(mock.Unary), reflect.ValueOf(p.Unary))
+		p, e = testRegistry.Create(componentName, "v1", metadata, "")
+		require.NoError(t, e)
+		assert.Equal(t, reflect.ValueOf(mock.Unary), reflect.ValueOf(p.Unary))
+
+		// assert v2
+		pV2, e := testRegistry.Create(componentName, "v2", metadata, "")
+		require.NoError(t, e)
+		assert.Equal(t, reflect.ValueOf(mockV2.Unary), reflect.ValueOf(pV2.Unary))
+
+		// check case-insensitivity
+		pV2, e = testRegistry.Create(strings.ToUpper(componentName), "V2", metadata, "")
+		require.NoError(t, e)
+		assert.Equal(t, reflect.ValueOf(mockV2.Unary), reflect.ValueOf(pV2.Unary))
+	})
+
+	t.Run("middleware is not registered", func(t *testing.T) {
+		const (
+			middlewareName = "fakeMiddleware"
+			componentName  = "middleware.grpc." + middlewareName
+		)
+
+		metadata := h.Metadata{}
+
+		// act
+		p, actualError := testRegistry.Create(componentName, "v1", metadata, "")
+		expectedError := fmt.Errorf("gRPC middleware %s/v1 has not been registered", componentName)
+
+		// assert
+		assert.Nil(t, p.Unary)
+		assert.Nil(t, p.Stream)
+		assert.Equal(t, expectedError.Error(), actualError.Error())
+	})
+}
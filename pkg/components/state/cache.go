@@ -0,0 +1,259 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+)
+
+const (
+	// CacheSizeKey is the component metadata key holding the maximum number of
+	// keys in the read cache of a state store. The cache is disabled unless it
+	// is set to a positive number.
+	CacheSizeKey = "readCacheSize"
+	// CacheTTLKey is the component metadata key holding how long a value is
+	// kept in the read cache of a state store, as a Go duration.
+	CacheTTLKey = "readCacheTTL"
+
+	defaultCacheTTL = 10 * time.Second
+)
+
+// Cache is a read-through cache of the values read from a state store through
+// the state API. Writes made through the state API invalidate the keys they
+// change, and requests with strong consistency or metadata bypass the cache.
+// A nil Cache is disabled.
+type Cache struct {
+	storeName string
+	ttl       time.Duration
+	clock     clock.Clock
+
+	lock  sync.Mutex
+	items *lru.Cache[string, cacheItem]
+	// generation is incremented on every invalidation, so that values read
+	// from the store concurrently with a write are not added to the cache.
+	generation uint64
+}
+
+type cacheItem struct {
+	res     *state.GetResponse
+	expires time.Time
+}
+
+// NewCacheFromMetadata returns the read cache configured in the metadata of a
+// state store, or nil if the cache is not enabled.
+func NewCacheFromMetadata(storeName string, metadata map[string]string) (*Cache, error) {
+	var size, ttl string
+	for k, v := range metadata {
+		switch {
+		case strings.EqualFold(k, CacheSizeKey):
+			size = v
+		case strings.EqualFold(k, CacheTTLKey):
+			ttl = v
+		}
+	}
+
+	if size == "" {
+		return nil, nil
+	}
+
+	n, err := strconv.Atoi(size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s for state store %s: %w", CacheSizeKey, storeName, err)
+	}
+	if n <= 0 {
+		return nil, nil
+	}
+
+	c := &Cache{
+		storeName: storeName,
+		ttl:       defaultCacheTTL,
+		clock:     clock.RealClock{},
+	}
+	if ttl != "" {
+		c.ttl, err = time.ParseDuration(ttl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s for state store %s: %w", CacheTTLKey, storeName, err)
+		}
+		if c.ttl <= 0 {
+			return nil, fmt.Errorf("invalid %s for state store %s: must be positive", CacheTTLKey, storeName)
+		}
+	}
+
+	c.items, err = lru.New[string, cacheItem](n)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Get returns the cached response of the request, or calls get on a miss and
+// caches its response.
+func (c *Cache) Get(ctx context.Context, req *state.GetRequest, get func(ctx context.Context, req *state.GetRequest) (*state.GetResponse, error)) (*state.GetResponse, error) {
+	if !c.cacheable(req) {
+		return get(ctx, req)
+	}
+
+	res, generation, ok := c.lookup(req.Key)
+	if ok {
+		diag.DefaultComponentMonitoring.StateCacheHit(ctx, c.storeName)
+		return res, nil
+	}
+	diag.DefaultComponentMonitoring.StateCacheMiss(ctx, c.storeName)
+
+	res, err := get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	c.add(req.Key, res, generation)
+
+	return copyResponse(res), nil
+}
+
+// BulkGet returns the cached responses of the requests, and calls bulkGet for
+// the keys which are not cached. The responses read from the store are cached
+// unless they contain an error.
+func (c *Cache) BulkGet(ctx context.Context, reqs []state.GetRequest, bulkGet func(ctx context.Context, reqs []state.GetRequest) ([]state.BulkGetResponse, error)) ([]state.BulkGetResponse, error) {
+	if c == nil {
+		return bulkGet(ctx, reqs)
+	}
+	for i := range reqs {
+		if !c.cacheable(&reqs[i]) {
+			return bulkGet(ctx, reqs)
+		}
+	}
+
+	var (
+		responses  = make([]state.BulkGetResponse, 0, len(reqs))
+		misses     = make([]state.GetRequest, 0, len(reqs))
+		generation uint64
+	)
+	for _, req := range reqs {
+		res, gen, ok := c.lookup(req.Key)
+		if !ok {
+			diag.DefaultComponentMonitoring.StateCacheMiss(ctx, c.storeName)
+			if len(misses) == 0 {
+				generation = gen
+			}
+			misses = append(misses, req)
+			continue
+		}
+
+		diag.DefaultComponentMonitoring.StateCacheHit(ctx, c.storeName)
+		item := state.BulkGetResponse{Key: req.Key}
+		if res != nil {
+			item.Data = res.Data
+			item.ETag = res.ETag
+			item.Metadata = res.Metadata
+			item.ContentType = res.ContentType
+		}
+		responses = append(responses, item)
+	}
+
+	if len(misses) == 0 {
+		return responses, nil
+	}
+
+	missed, err := bulkGet(ctx, misses)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range missed {
+		if item.Error == "" {
+			c.add(item.Key, &state.GetResponse{
+				Data:        item.Data,
+				ETag:        item.ETag,
+				Metadata:    item.Metadata,
+				ContentType: item.ContentType,
+			}, generation)
+		}
+	}
+
+	return append(responses, missed...), nil
+}
+
+// Invalidate removes the given keys from the cache.
+func (c *Cache) Invalidate(keys ...string) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.generation++
+	for _, key := range keys {
+		c.items.Remove(key)
+	}
+}
+
+// cacheable returns true if the response of the request can be served from
+// the cache. Requests with strong consistency must read from the store, and
+// requests with metadata may not read the same value as other requests for
+// the same key.
+func (c *Cache) cacheable(req *state.GetRequest) bool {
+	return c != nil && req.Options.Consistency != state.Strong && len(req.Metadata) == 0
+}
+
+func (c *Cache) lookup(key string) (*state.GetResponse, uint64, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	item, ok := c.items.Get(key)
+	if !ok {
+		return nil, c.generation, false
+	}
+	if !c.clock.Now().Before(item.expires) {
+		c.items.Remove(key)
+		return nil, c.generation, false
+	}
+
+	return copyResponse(item.res), c.generation, true
+}
+
+func (c *Cache) add(key string, res *state.GetResponse, generation uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// The key may have been changed since it was read from the store.
+	if c.generation != generation {
+		return
+	}
+
+	c.items.Add(key, cacheItem{
+		res:     copyResponse(res),
+		expires: c.clock.Now().Add(c.ttl),
+	})
+}
+
+// copyResponse returns a shallow copy of the response, so that callers can
+// replace its fields without changing the cached response.
+func copyResponse(res *state.GetResponse) *state.GetResponse {
+	if res == nil {
+		return nil
+	}
+	cp := *res
+	return &cp
+}
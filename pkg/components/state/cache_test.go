@@ -0,0 +1,205 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+func TestNewCacheFromMetadata(t *testing.T) {
+	c, err := NewCacheFromMetadata("store", map[string]string{"foo": "bar"})
+	require.NoError(t, err)
+	assert.Nil(t, c)
+
+	c, err = NewCacheFromMetadata("store", map[string]string{"readCacheSize": "0"})
+	require.NoError(t, err)
+	assert.Nil(t, c)
+
+	c, err = NewCacheFromMetadata("store", map[string]string{"ReadCacheSize": "10"})
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	assert.Equal(t, defaultCacheTTL, c.ttl)
+
+	c, err = NewCacheFromMetadata("store", map[string]string{"readCacheSize": "10", "readCacheTTL": "1m"})
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	assert.Equal(t, time.Minute, c.ttl)
+
+	for _, md := range []map[string]string{
+		{"readCacheSize": "foo"},
+		{"readCacheSize": "10", "readCacheTTL": "foo"},
+		{"readCacheSize": "10", "readCacheTTL": "-1s"},
+	} {
+		_, err = NewCacheFromMetadata("store", md)
+		require.Error(t, err)
+	}
+}
+
+func TestCacheGet(t *testing.T) {
+	newCache := func(t *testing.T) (*Cache, *clocktesting.FakeClock) {
+		t.Helper()
+		c, err := NewCacheFromMetadata("store", map[string]string{"readCacheSize": "2", "readCacheTTL": "10s"})
+		require.NoError(t, err)
+		clock := clocktesting.NewFakeClock(time.Now())
+		c.clock = clock
+		return c, clock
+	}
+
+	var calls int
+	get := func(_ context.Context, req *state.GetRequest) (*state.GetResponse, error) {
+		calls++
+		return &state.GetResponse{Data: []byte(req.Key)}, nil
+	}
+
+	t.Run("nil cache", func(t *testing.T) {
+		calls = 0
+		var c *Cache
+		for range 2 {
+			res, err := c.Get(t.Context(), &state.GetRequest{Key: "key1"}, get)
+			require.NoError(t, err)
+			assert.Equal(t, []byte("key1"), res.Data)
+		}
+		assert.Equal(t, 2, calls)
+		c.Invalidate("key1")
+	})
+
+	t.Run("values expire after the TTL", func(t *testing.T) {
+		calls = 0
+		c, clock := newCache(t)
+
+		res, err := c.Get(t.Context(), &state.GetRequest{Key: "key1"}, get)
+		require.NoError(t, err)
+		res.Data = nil
+
+		res, err = c.Get(t.Context(), &state.GetRequest{Key: "key1"}, get)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("key1"), res.Data)
+		assert.Equal(t, 1, calls)
+
+		clock.Step(10 * time.Second)
+		_, err = c.Get(t.Context(), &state.GetRequest{Key: "key1"}, get)
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("writes invalidate keys", func(t *testing.T) {
+		calls = 0
+		c, _ := newCache(t)
+
+		_, err := c.Get(t.Context(), &state.GetRequest{Key: "key1"}, get)
+		require.NoError(t, err)
+		_, err = c.Get(t.Context(), &state.GetRequest{Key: "key2"}, get)
+		require.NoError(t, err)
+		c.Invalidate("key1")
+
+		_, err = c.Get(t.Context(), &state.GetRequest{Key: "key1"}, get)
+		require.NoError(t, err)
+		_, err = c.Get(t.Context(), &state.GetRequest{Key: "key2"}, get)
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("strong consistency and metadata bypass the cache", func(t *testing.T) {
+		calls = 0
+		c, _ := newCache(t)
+
+		_, err := c.Get(t.Context(), &state.GetRequest{Key: "key1"}, get)
+		require.NoError(t, err)
+		_, err = c.Get(t.Context(), &state.GetRequest{
+			Key:     "key1",
+			Options: state.GetStateOption{Consistency: state.Strong},
+		}, get)
+		require.NoError(t, err)
+		_, err = c.Get(t.Context(), &state.GetRequest{
+			Key:      "key1",
+			Metadata: map[string]string{"partitionKey": "p1"},
+		}, get)
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		c, _ := newCache(t)
+
+		_, err := c.Get(t.Context(), &state.GetRequest{Key: "key1"}, func(context.Context, *state.GetRequest) (*state.GetResponse, error) {
+			return nil, errors.New("get error")
+		})
+		require.Error(t, err)
+
+		calls = 0
+		_, err = c.Get(t.Context(), &state.GetRequest{Key: "key1"}, get)
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("values read during a write are not cached", func(t *testing.T) {
+		calls = 0
+		c, _ := newCache(t)
+
+		_, err := c.Get(t.Context(), &state.GetRequest{Key: "key1"}, func(ctx context.Context, req *state.GetRequest) (*state.GetResponse, error) {
+			c.Invalidate(req.Key)
+			return get(ctx, req)
+		})
+		require.NoError(t, err)
+
+		_, err = c.Get(t.Context(), &state.GetRequest{Key: "key1"}, get)
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestCacheBulkGet(t *testing.T) {
+	c, err := NewCacheFromMetadata("store", map[string]string{"readCacheSize": "10"})
+	require.NoError(t, err)
+
+	var requested [][]string
+	bulkGet := func(_ context.Context, reqs []state.GetRequest) ([]state.BulkGetResponse, error) {
+		keys := make([]string, len(reqs))
+		res := make([]state.BulkGetResponse, len(reqs))
+		for i, req := range reqs {
+			keys[i] = req.Key
+			res[i] = state.BulkGetResponse{Key: req.Key, Data: []byte(req.Key)}
+			if req.Key == "key3" {
+				res[i] = state.BulkGetResponse{Key: req.Key, Error: "not found"}
+			}
+		}
+		requested = append(requested, keys)
+		return res, nil
+	}
+
+	res, err := c.BulkGet(t.Context(), []state.GetRequest{{Key: "key1"}, {Key: "key3"}}, bulkGet)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	res, err = c.BulkGet(t.Context(), []state.GetRequest{{Key: "key1"}, {Key: "key2"}, {Key: "key3"}}, bulkGet)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	assert.Equal(t, "key1", res[0].Key)
+	assert.Equal(t, []byte("key1"), res[0].Data)
+	assert.Equal(t, [][]string{{"key1", "key3"}, {"key2", "key3"}}, requested)
+
+	requested = nil
+	_, err = c.BulkGet(t.Context(), []state.GetRequest{{Key: "key1"}, {Key: "key2", Metadata: map[string]string{"foo": "bar"}}}, bulkGet)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"key1", "key2"}}, requested)
+}
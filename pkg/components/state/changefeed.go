@@ -0,0 +1,40 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// ChangeFeedHandler is called for every change read from the change feed of a
+// state store.
+type ChangeFeedHandler func(ctx context.Context, op state.TransactionalStateOperation) error
+
+// ChangeFeeder is implemented by state stores which expose a native feed of
+// the changes made to their keys, including the changes which are not made
+// through the state API of the sidecar.
+// When a state store implements ChangeFeeder, its feed is the only source of
+// the changes sent to the subscribers of the store. Otherwise, subscribers
+// are only notified of the writes made through the state API of the sidecar
+// they are connected to, excluding actor and workflow state.
+type ChangeFeeder interface {
+	// SubscribeChanges calls the handler for every change made to the store,
+	// in order, until the context is canceled or an error occurs.
+	// Changes are reported as a state.SetRequest or a state.DeleteRequest with
+	// the key as persisted in the store, including its key prefix. The ETag of
+	// a state.SetRequest, if set, is the new ETag of the key.
+	SubscribeChanges(ctx context.Context, handler ChangeFeedHandler) error
+}
@@ -111,6 +111,8 @@ type AccessControlListOperationAction struct {
 type ConfigurationSpec struct {
 	HTTPPipelineSpec    *PipelineSpec       `json:"httpPipeline,omitempty"    yaml:"httpPipeline,omitempty"`
 	AppHTTPPipelineSpec *PipelineSpec       `json:"appHttpPipeline,omitempty" yaml:"appHttpPipeline,omitempty"`
+	GRPCPipelineSpec    *PipelineSpec       `json:"grpcPipeline,omitempty"    yaml:"grpcPipeline,omitempty"`
+	AppGRPCPipelineSpec *PipelineSpec       `json:"appGrpcPipeline,omitempty" yaml:"appGrpcPipeline,omitempty"`
 	TracingSpec         *TracingSpec        `json:"tracing,omitempty"         yaml:"tracing,omitempty"`
 	MTLSSpec            *MTLSSpec           `json:"mtls,omitempty"            yaml:"mtls,omitempty"`
 	MetricSpec          *MetricSpec         `json:"metric,omitempty"          yaml:"metric,omitempty"`
@@ -124,6 +126,8 @@ type ConfigurationSpec struct {
 	LoggingSpec         *LoggingSpec        `json:"logging,omitempty"         yaml:"logging,omitempty"`
 	WasmSpec            *WasmSpec           `json:"wasm,omitempty"            yaml:"wasm,omitempty"`
 	WorkflowSpec        *WorkflowSpec       `json:"workflow,omitempty"        yaml:"workflow,omitempty"`
+
+	ServiceInvocationSpec *ServiceInvocationSpec `json:"serviceInvocation,omitempty" yaml:"serviceInvocation,omitempty"`
 }
 
 // WorkflowSpec defines the configuration for Dapr workflows.
@@ -413,6 +417,102 @@ type AccessControlSpec struct {
 	AppPolicies   []AppPolicySpec `json:"policies,omitempty"      yaml:"policies,omitempty"`
 }
 
+// ServiceInvocationSpec configures the invocation of other apps.
+type ServiceInvocationSpec struct {
+	// Targets configures the invocation of specific target apps.
+	Targets []ServiceInvocationTargetSpec `json:"targets,omitempty" yaml:"targets,omitempty"`
+}
+
+// ServiceInvocationTargetSpec configures the invocation of a target app.
+type ServiceInvocationTargetSpec struct {
+	// AppID is the ID of the target app, as used by callers: either "<app-id>"
+	// for an app in the same namespace, or "<app-id>.<namespace>".
+	AppID         string             `json:"appId"                   yaml:"appId"`
+	LoadBalancing *LoadBalancingSpec `json:"loadBalancing,omitempty" yaml:"loadBalancing,omitempty"`
+	Mirror        *MirrorSpec        `json:"mirror,omitempty"        yaml:"mirror,omitempty"`
+	TrafficSplit  *TrafficSplitSpec  `json:"trafficSplit,omitempty"  yaml:"trafficSplit,omitempty"`
+}
+
+// TrafficSplitSpec splits the invocations of a target app, used as a logical
+// app ID, between weighted backend apps.
+type TrafficSplitSpec struct {
+	// Backends are the apps the invocations are split between.
+	Backends []TrafficSplitBackendSpec `json:"backends" yaml:"backends"`
+	// Overrides route the invocations with a matching header to a backend,
+	// regardless of the weights. The first matching override wins.
+	Overrides []TrafficSplitOverrideSpec `json:"overrides,omitempty" yaml:"overrides,omitempty"`
+}
+
+// TrafficSplitBackendSpec is a backend app of a traffic split.
+type TrafficSplitBackendSpec struct {
+	// AppID is the ID of the backend app, either "<app-id>" or
+	// "<app-id>.<namespace>"; with no namespace, the backend is in the namespace
+	// of the target app. It can be the ID of the target app itself.
+	AppID string `json:"appId" yaml:"appId"`
+	// Weight is the relative weight of the backend app.
+	Weight int `json:"weight" yaml:"weight"`
+}
+
+// TrafficSplitOverrideSpec routes the invocations with a header to a backend
+// app.
+type TrafficSplitOverrideSpec struct {
+	// Header is the name of the header, case-insensitive.
+	Header string `json:"header" yaml:"header"`
+	// Value is the value the header must have. If empty, any value matches.
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+	// AppID is the ID of the app to route the invocations to, in the same form
+	// as the app ID of a backend.
+	AppID string `json:"appId" yaml:"appId"`
+}
+
+// MirrorSpec configures the mirroring of the invocations of a target app to a
+// shadow app. The responses of the shadow app are discarded.
+type MirrorSpec struct {
+	// AppID is the ID of the shadow app, either "<app-id>" or
+	// "<app-id>.<namespace>".
+	AppID string `json:"appId" yaml:"appId"`
+	// Percentage is the percentage of the invocations to mirror, between 0 and
+	// 100.
+	Percentage int `json:"percentage" yaml:"percentage"`
+}
+
+// Load balancing strategies over the instances of a target app.
+const (
+	// LoadBalancingRandom picks an instance at random.
+	LoadBalancingRandom = "random"
+	// LoadBalancingRoundRobin picks the instances in turn.
+	LoadBalancingRoundRobin = "roundRobin"
+	// LoadBalancingLeastRequest picks the instance with the fewest requests in
+	// flight.
+	LoadBalancingLeastRequest = "leastRequest"
+	// LoadBalancingPowerOfTwoChoices picks two instances at random, and the one
+	// of them with the fewest requests in flight.
+	LoadBalancingPowerOfTwoChoices = "powerOfTwoChoices"
+)
+
+// LoadBalancingSpec configures how the sidecar balances the invocations of a
+// target app over its instances returned by the name resolver.
+type LoadBalancingSpec struct {
+	// Strategy is one of the LoadBalancing* strategies. Defaults to "random".
+	Strategy string `json:"strategy,omitempty" yaml:"strategy,omitempty"`
+	// OutlierDetection ejects the instances failing invocations, if set.
+	OutlierDetection *OutlierDetectionSpec `json:"outlierDetection,omitempty" yaml:"outlierDetection,omitempty"`
+}
+
+// OutlierDetectionSpec configures the passive ejection of the instances of a
+// target app after consecutive invocation errors.
+type OutlierDetectionSpec struct {
+	// ConsecutiveErrors is the number of consecutive errors after which an
+	// instance is ejected. Defaults to 5.
+	ConsecutiveErrors int `json:"consecutiveErrors,omitempty" yaml:"consecutiveErrors,omitempty"`
+	// BaseEjectionTime is the duration an instance is ejected for, multiplied
+	// by the number of times in a row it was ejected. Defaults to "30s".
+	BaseEjectionTime string `json:"baseEjectionTime,omitempty" yaml:"baseEjectionTime,omitempty"`
+	// MaxEjectionPercent is the maximum percentage of the instances which can
+	// be ejected at the same time. Defaults to 50.
+	MaxEjectionPercent int `json:"maxEjectionPercent,omitempty" yaml:"maxEjectionPercent,omitempty"`
+}
+
 type NameResolutionSpec struct {
 	Component     string `json:"component,omitempty"     yaml:"component,omitempty"`
 	Version       string `json:"version,omitempty"       yaml:"version,omitempty"`
@@ -430,6 +530,15 @@ type MTLSSpec struct {
 	// When Dapr is running in Kubernetes mode, this is in addition to the built-in "kubernetes" validator.
 	// In self-hosted mode, enabling a custom validator will disable the built-in "insecure" validator.
 	TokenValidators []ValidatorSpec `json:"tokenValidators,omitempty" yaml:"tokenValidators,omitempty"`
+	// SPIFFE IDs and namespaces Sentry refuses to sign certificates for.
+	DenyList *MTLSDenyListSpec `json:"denyList,omitempty" yaml:"denyList,omitempty"`
+}
+
+// MTLSDenyListSpec is the list of SPIFFE IDs and namespaces Sentry refuses to
+// sign certificates for.
+type MTLSDenyListSpec struct {
+	SPIFFEIDs  []string `json:"spiffeIds,omitempty"  yaml:"spiffeIds,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
 }
 
 // ValidatorSpec contains additional token validators to use.
@@ -564,8 +673,14 @@ func LoadKubernetesConfiguration(config string, namespace string, podName string
 	if len(b) == 0 {
 		return nil, fmt.Errorf("configuration %s not found", config)
 	}
+	return ParseConfiguration(b)
+}
+
+// ParseConfiguration parses a JSON encoded Configuration resource on top of
+// the default configuration.
+func ParseConfiguration(b []byte) (*Configuration, error) {
 	conf := LoadDefaultConfiguration()
-	err = json.Unmarshal(b, conf)
+	err := json.Unmarshal(b, conf)
 	if err != nil {
 		return nil, err
 	}
@@ -769,6 +884,15 @@ func (c Configuration) GetAPISpec() APISpec {
 	return *c.Spec.APISpec
 }
 
+// GetServiceInvocationSpec returns the service invocation spec.
+// It's a short-hand that includes nil-checks for safety.
+func (c Configuration) GetServiceInvocationSpec() ServiceInvocationSpec {
+	if c.Spec.ServiceInvocationSpec == nil {
+		return ServiceInvocationSpec{}
+	}
+	return *c.Spec.ServiceInvocationSpec
+}
+
 // GetLoggingSpec returns the Logging spec.
 // It's a short-hand that includes nil-checks for safety.
 func (c Configuration) GetLoggingSpec() LoggingSpec {
@@ -35,6 +35,7 @@ const (
 type componentMetrics struct {
 	pubsubIngressCount          *stats.Int64Measure
 	pubsubIngressLatency        *stats.Float64Measure
+	pubsubIngressDuplicateCount *stats.Int64Measure
 	bulkPubsubIngressCount      *stats.Int64Measure
 	bulkPubsubEventIngressCount *stats.Int64Measure
 	bulkPubsubIngressLatency    *stats.Float64Measure
@@ -49,8 +50,10 @@ type componentMetrics struct {
 	outputBindingCount   *stats.Int64Measure
 	outputBindingLatency *stats.Float64Measure
 
-	stateCount   *stats.Int64Measure
-	stateLatency *stats.Float64Measure
+	stateCount          *stats.Int64Measure
+	stateLatency        *stats.Float64Measure
+	stateCacheHitCount  *stats.Int64Measure
+	stateCacheMissCount *stats.Int64Measure
 
 	configurationCount   *stats.Int64Measure
 	configurationLatency *stats.Float64Measure
@@ -81,6 +84,10 @@ func newComponentMetrics() *componentMetrics {
 			"component/pubsub_ingress/latencies",
 			"The consuming app event processing latency.",
 			stats.UnitMilliseconds),
+		pubsubIngressDuplicateCount: stats.Int64(
+			"component/pubsub_ingress/duplicate/count",
+			"The number of incoming messages acknowledged without being delivered to the app because they were already processed.",
+			stats.UnitDimensionless),
 		bulkPubsubIngressCount: stats.Int64(
 			"component/pubsub_ingress/bulk/count",
 			"The number of incoming bulk subscribe calls arriving from the bulk pub/sub component.",
@@ -137,6 +144,14 @@ func newComponentMetrics() *componentMetrics {
 			"component/state/latencies",
 			"The latency of the response from the state component.",
 			stats.UnitMilliseconds),
+		stateCacheHitCount: stats.Int64(
+			"component/state/cache/hits",
+			"The number of state reads served from the read cache of the state component.",
+			stats.UnitDimensionless),
+		stateCacheMissCount: stats.Int64(
+			"component/state/cache/misses",
+			"The number of state reads not found in the read cache of the state component.",
+			stats.UnitDimensionless),
 		configurationCount: stats.Int64(
 			"component/configuration/count",
 			"The number of operations performed on the configuration component.",
@@ -182,6 +197,7 @@ func (c *componentMetrics) Init(meter view.Meter, appID, namespace string, laten
 	return meter.Register(
 		diagUtils.NewMeasureView(c.pubsubIngressLatency, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey, statusKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.pubsubIngressCount, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey, statusKey}, view.Count()),
+		diagUtils.NewMeasureView(c.pubsubIngressDuplicateCount, []tag.Key{appIDKey, componentKey, namespaceKey, topicKey}, view.Count()),
 		diagUtils.NewMeasureView(c.bulkPubsubIngressLatency, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.bulkPubsubIngressCount, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey}, view.Count()),
 		diagUtils.NewMeasureView(c.bulkPubsubEventIngressCount, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey}, view.Count()),
@@ -195,6 +211,8 @@ func (c *componentMetrics) Init(meter view.Meter, appID, namespace string, laten
 		diagUtils.NewMeasureView(c.outputBindingCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
 		diagUtils.NewMeasureView(c.stateLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.stateCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
+		diagUtils.NewMeasureView(c.stateCacheHitCount, []tag.Key{appIDKey, componentKey, namespaceKey}, view.Count()),
+		diagUtils.NewMeasureView(c.stateCacheMissCount, []tag.Key{appIDKey, componentKey, namespaceKey}, view.Count()),
 		diagUtils.NewMeasureView(c.configurationLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.configurationCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
 		diagUtils.NewMeasureView(c.secretLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, latencyDistribution),
@@ -228,6 +246,18 @@ func (c *componentMetrics) PubsubIngressEvent(ctx context.Context, component, pr
 	}
 }
 
+// PubsubIngressDuplicateEvent records the metrics for a pub/sub ingress event
+// which was acknowledged as a duplicate of an already processed event.
+func (c *componentMetrics) PubsubIngressDuplicateEvent(ctx context.Context, component, topic string) {
+	if c.enabled {
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.pubsubIngressDuplicateCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, topicKey, topic)...),
+			stats.WithMeasurements(c.pubsubIngressDuplicateCount.M(1)))
+	}
+}
+
 // BulkPubsubIngressEvent records the metrics for a bulk pub/sub ingress event.
 func (c *componentMetrics) BulkPubsubIngressEvent(ctx context.Context, component, topic string, elapsed float64) {
 	if c.enabled {
@@ -361,6 +391,30 @@ func (c *componentMetrics) StateInvoked(ctx context.Context, component, operatio
 	}
 }
 
+// StateCacheHit records the metrics for a state read served from the read
+// cache of a state component.
+func (c *componentMetrics) StateCacheHit(ctx context.Context, component string) {
+	if c.enabled {
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.stateCacheHitCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace)...),
+			stats.WithMeasurements(c.stateCacheHitCount.M(1)))
+	}
+}
+
+// StateCacheMiss records the metrics for a state read which was not found in
+// the read cache of a state component.
+func (c *componentMetrics) StateCacheMiss(ctx context.Context, component string) {
+	if c.enabled {
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.stateCacheMissCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace)...),
+			stats.WithMeasurements(c.stateCacheMissCount.M(1)))
+	}
+}
+
 // ConfigurationInvoked records the metrics for a configuration event.
 func (c *componentMetrics) ConfigurationInvoked(ctx context.Context, component, operation string, success bool, elapsed float64) {
 	if c.enabled {
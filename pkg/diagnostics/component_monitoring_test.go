@@ -80,6 +80,23 @@ func TestPubSub(t *testing.T) {
 		assert.InEpsilon(t, 1, viewData[0].Data.(*view.DistributionData).Min, 0)
 	})
 
+	t.Run("record ingress duplicate count", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+
+		c.PubsubIngressDuplicateEvent(t.Context(), componentName, "A")
+		c.PubsubIngressDuplicateEvent(t.Context(), componentName, "A")
+
+		viewData, _ := meter.RetrieveData("component/pubsub_ingress/duplicate/count")
+		v := meter.Find("component/pubsub_ingress/duplicate/count")
+
+		allTagsPresent(t, v, viewData[0].Tags)
+
+		assert.Equal(t, int64(2), viewData[0].Data.(*view.CountData).Value)
+	})
+
 	t.Run("record egress latency", func(t *testing.T) {
 		c, meter := componentsMetrics()
 		t.Cleanup(func() {
@@ -188,6 +205,29 @@ func TestState(t *testing.T) {
 		allTagsPresent(t, v, viewData[0].Tags)
 		assert.InEpsilon(t, 1, viewData[0].Data.(*view.DistributionData).Min, 0)
 	})
+
+	t.Run("record state cache hits and misses", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+
+		c.StateCacheHit(t.Context(), componentName)
+		c.StateCacheHit(t.Context(), componentName)
+		c.StateCacheMiss(t.Context(), componentName)
+
+		viewData, _ := meter.RetrieveData("component/state/cache/hits")
+		v := meter.Find("component/state/cache/hits")
+
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(2), viewData[0].Data.(*view.CountData).Value)
+
+		viewData, _ = meter.RetrieveData("component/state/cache/misses")
+		v = meter.Find("component/state/cache/misses")
+
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(1), viewData[0].Data.(*view.CountData).Value)
+	})
 }
 
 func TestConfiguration(t *testing.T) {
@@ -33,6 +33,9 @@ var (
 	targetKey           = tag.MustNewKey("target")
 	typeKey             = tag.MustNewKey("type")
 	categoryKey         = tag.MustNewKey("category")
+	shadowAppIDKey      = tag.MustNewKey("shadow_app_id")
+	primaryStatusKey    = tag.MustNewKey("primary_status")
+	shadowStatusKey     = tag.MustNewKey("shadow_status")
 )
 
 const (
@@ -78,6 +81,7 @@ type serviceMetrics struct {
 	serviceInvocationResponseSentTotal       *stats.Int64Measure
 	serviceInvocationResponseReceivedTotal   *stats.Int64Measure
 	serviceInvocationResponseReceivedLatency *stats.Float64Measure
+	serviceInvocationMirrorResponseTotal     *stats.Int64Measure
 
 	appID                 string
 	ctx                   context.Context
@@ -207,6 +211,10 @@ func newServiceMetrics() *serviceMetrics {
 			"runtime/service_invocation/res_recv_latency_ms",
 			"The latency of service invocation response.",
 			stats.UnitMilliseconds),
+		serviceInvocationMirrorResponseTotal: stats.Int64(
+			"runtime/service_invocation/mirror_res_total",
+			"The number of responses received from the shadow apps of mirrored service invocations, by status of the primary and shadow responses.",
+			stats.UnitDimensionless),
 
 		// TODO: use the correct context for each request
 		ctx:               context.Background(),
@@ -253,6 +261,7 @@ func (s *serviceMetrics) Init(meter view.Meter, appID string, latencyDistributio
 		diagUtils.NewMeasureView(s.serviceInvocationResponseSentTotal, []tag.Key{appIDKey, destinationAppIDKey, statusKey}, view.Count()),
 		diagUtils.NewMeasureView(s.serviceInvocationResponseReceivedTotal, []tag.Key{appIDKey, sourceAppIDKey, statusKey, typeKey}, view.Count()),
 		diagUtils.NewMeasureView(s.serviceInvocationResponseReceivedLatency, []tag.Key{appIDKey, sourceAppIDKey, statusKey}, latencyDistribution),
+		diagUtils.NewMeasureView(s.serviceInvocationMirrorResponseTotal, []tag.Key{appIDKey, destinationAppIDKey, shadowAppIDKey, primaryStatusKey, shadowStatusKey}, view.Count()),
 	)
 }
 
@@ -611,3 +620,21 @@ func (s *serviceMetrics) ServiceInvocationStreamingResponseReceived(sourceAppID
 			stats.WithMeasurements(s.serviceInvocationResponseReceivedTotal.M(1)))
 	}
 }
+
+// ServiceInvocationMirrorResponseReceived records the statuses of the primary
+// and shadow responses of a mirrored service invocation.
+func (s *serviceMetrics) ServiceInvocationMirrorResponseReceived(destinationAppID, shadowAppID, primaryStatus, shadowStatus string) {
+	if s.enabled {
+		stats.RecordWithOptions(
+			s.ctx,
+			stats.WithRecorder(s.meter),
+			stats.WithTags(diagUtils.WithTags(
+				s.serviceInvocationMirrorResponseTotal.Name(),
+				appIDKey, s.appID,
+				destinationAppIDKey, destinationAppID,
+				shadowAppIDKey, shadowAppID,
+				primaryStatusKey, primaryStatus,
+				shadowStatusKey, shadowStatus)...),
+			stats.WithMeasurements(s.serviceInvocationMirrorResponseTotal.M(1)))
+	}
+}
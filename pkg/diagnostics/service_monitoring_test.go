@@ -86,6 +86,20 @@ func TestServiceInvocation(t *testing.T) {
 
 		allTagsPresent(t, v2, viewData2[0].Tags)
 	})
+
+	t.Run("record service invocation mirror response received", func(t *testing.T) {
+		s, meter := servicesMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		s.ServiceInvocationMirrorResponseReceived("testAppId2", "testAppId3", "200", "500")
+
+		viewData, _ := meter.RetrieveData("runtime/service_invocation/mirror_res_total")
+		v := meter.Find("runtime/service_invocation/mirror_res_total")
+
+		allTagsPresent(t, v, viewData[0].Tags)
+		RequireTagExist(t, viewData, NewTag(primaryStatusKey.Name(), "200"))
+		RequireTagExist(t, viewData, NewTag(shadowStatusKey.Name(), "500"))
+	})
 }
 
 func TestSerivceMonitoringInit(t *testing.T) {
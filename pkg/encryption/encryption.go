@@ -15,7 +15,6 @@ package encryption
 
 import (
 	"context"
-	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
 	b64 "encoding/base64"
@@ -42,6 +41,10 @@ const (
 type ComponentEncryptionKeys struct {
 	Primary   Key
 	Secondary Key
+	// Envelope is the key of a crypto component which wraps the data keys of
+	// new values. When set, Primary and Secondary are only used to decrypt
+	// values written before envelope encryption was enabled.
+	Envelope *EnvelopeKey
 }
 
 // Key holds the key to encrypt an arbitrary object.
@@ -191,11 +194,7 @@ func createCipher(key Key, algorithm Algorithm) (cipher.AEAD, error) {
 	switch algorithm {
 	// Other authenticated ciphers can be added if needed, e.g. golang.org/x/crypto/chacha20poly1305
 	case AESGCMAlgorithm:
-		block, err := aes.NewCipher(keyBytes)
-		if err != nil {
-			return nil, err
-		}
-		return cipher.NewGCM(block)
+		return newAESGCM(keyBytes)
 	}
 
 	return nil, errors.New("unsupported algorithm")
@@ -0,0 +1,234 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	b64 "encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+
+	contribCrypto "github.com/dapr/components-contrib/crypto"
+	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+)
+
+const (
+	cryptoComponentKey        = "encryptionCryptoComponent"
+	cryptoKeyNameKey          = "encryptionKeyName"
+	cryptoKeyWrapAlgorithmKey = "encryptionKeyWrapAlgorithm"
+	defaultKeyWrapAlgorithm   = "A256KW"
+
+	// envelopePrefix is the prefix of values encrypted with a data key. It is
+	// followed by the fields of the envelope, separated by envelopeSeparator.
+	envelopePrefix    = "dapr.env1."
+	envelopeSeparator = "."
+	dataKeySize       = 32
+	dataKeyCacheSize  = 1024
+)
+
+// Fields of an envelope, each encoded as unpadded base64url.
+const (
+	envelopeComponent = iota
+	envelopeKeyName
+	envelopeAlgorithm
+	envelopeWrappedKey
+	envelopeTag
+	envelopeCiphertext
+	envelopeFields
+)
+
+// CryptoProviderFn returns the crypto component with the given name, if it is
+// loaded.
+type CryptoProviderFn func(name string) (contribCrypto.SubtleCrypto, bool)
+
+// EnvelopeKey is a key of a crypto component that wraps the data keys used to
+// encrypt the values of a component.
+// Every value is encrypted with its own data key, and stored together with
+// the wrapped data key and the names of the crypto component and the key that
+// wrapped it. Values can therefore still be decrypted after the component is
+// updated to wrap new data keys with another key, as long as the previous key
+// remains available.
+type EnvelopeKey struct {
+	Component string
+	KeyName   string
+	Algorithm string
+
+	getProvider CryptoProviderFn
+	// dataKeys caches the unwrapped data keys, so values which are read
+	// repeatedly do not call the crypto component every time.
+	dataKeys *lru.Cache[string, cipher.AEAD]
+}
+
+// ComponentEnvelopeKey returns the key of a crypto component set in a
+// component definition to wrap the data keys of its values, or nil if the
+// component does not use envelope encryption.
+func ComponentEnvelopeKey(component v1alpha1.Component, getProvider CryptoProviderFn) (*EnvelopeKey, error) {
+	var key EnvelopeKey
+	for _, m := range component.Spec.Metadata {
+		switch m.Name {
+		case cryptoComponentKey:
+			key.Component = m.Value.String()
+		case cryptoKeyNameKey:
+			key.KeyName = m.Value.String()
+		case cryptoKeyWrapAlgorithmKey:
+			key.Algorithm = m.Value.String()
+		}
+	}
+
+	if key.Component == "" && key.KeyName == "" {
+		return nil, nil
+	}
+	if key.Component == "" || key.KeyName == "" {
+		return nil, fmt.Errorf("%s: both %s and %s are required", errPrefix, cryptoComponentKey, cryptoKeyNameKey)
+	}
+	if key.Algorithm == "" {
+		key.Algorithm = defaultKeyWrapAlgorithm
+	}
+
+	key.getProvider = getProvider
+	var err error
+	key.dataKeys, err = lru.New[string, cipher.AEAD](dataKeyCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// IsEnvelopeEncrypted returns true if the value was encrypted with a data key
+// wrapped by a crypto component.
+func IsEnvelopeEncrypted(value []byte) bool {
+	return bytes.HasPrefix(value, []byte(envelopePrefix))
+}
+
+// encryptEnvelope encrypts the value with a new data key, which is wrapped with
+// the key of the crypto component.
+func encryptEnvelope(ctx context.Context, key *EnvelopeKey, value []byte) ([]byte, error) {
+	provider, ok := key.getProvider(key.Component)
+	if !ok {
+		return value, fmt.Errorf("crypto component %s not found", key.Component)
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return value, err
+	}
+	plaintextKey, err := jwk.FromRaw(dataKey)
+	if err != nil {
+		return value, err
+	}
+
+	// The wrapping algorithm is expected not to require a nonce, such as AES-KW
+	// or RSA-OAEP.
+	wrappedKey, tag, err := provider.WrapKey(ctx, plaintextKey, key.Algorithm, key.KeyName, nil, nil)
+	if err != nil {
+		return value, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	aead, err := newAESGCM(dataKey)
+	if err != nil {
+		return value, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return value, err
+	}
+
+	fields := make([]string, envelopeFields)
+	fields[envelopeComponent] = b64.RawURLEncoding.EncodeToString([]byte(key.Component))
+	fields[envelopeKeyName] = b64.RawURLEncoding.EncodeToString([]byte(key.KeyName))
+	fields[envelopeAlgorithm] = b64.RawURLEncoding.EncodeToString([]byte(key.Algorithm))
+	fields[envelopeWrappedKey] = b64.RawURLEncoding.EncodeToString(wrappedKey)
+	fields[envelopeTag] = b64.RawURLEncoding.EncodeToString(tag)
+	fields[envelopeCiphertext] = b64.RawURLEncoding.EncodeToString(aead.Seal(nonce, nonce, value, nil))
+
+	key.dataKeys.Add(strings.Join(fields[:envelopeCiphertext], envelopeSeparator), aead)
+
+	return []byte(envelopePrefix + strings.Join(fields, envelopeSeparator)), nil
+}
+
+// decryptEnvelope decrypts a value encrypted by encryptEnvelope, unwrapping its
+// data key with the crypto component and key recorded in the value.
+func decryptEnvelope(ctx context.Context, key *EnvelopeKey, value []byte) ([]byte, error) {
+	fields := strings.Split(string(value[len(envelopePrefix):]), envelopeSeparator)
+	if len(fields) != envelopeFields {
+		return value, errors.New("invalid encrypted value")
+	}
+
+	decoded := make([][]byte, envelopeFields)
+	for i, field := range fields {
+		var err error
+		decoded[i], err = b64.RawURLEncoding.DecodeString(field)
+		if err != nil {
+			return value, fmt.Errorf("invalid encrypted value: %w", err)
+		}
+	}
+
+	cacheKey := strings.Join(fields[:envelopeCiphertext], envelopeSeparator)
+	aead, ok := key.dataKeys.Get(cacheKey)
+	if !ok {
+		component := string(decoded[envelopeComponent])
+		provider, ok := key.getProvider(component)
+		if !ok {
+			return value, fmt.Errorf("crypto component %s not found", component)
+		}
+
+		plaintextKey, err := provider.UnwrapKey(ctx,
+			decoded[envelopeWrappedKey],
+			string(decoded[envelopeAlgorithm]),
+			string(decoded[envelopeKeyName]),
+			nil,
+			decoded[envelopeTag],
+			nil,
+		)
+		if err != nil {
+			return value, fmt.Errorf("failed to unwrap data key: %w", err)
+		}
+
+		var dataKey []byte
+		if err = plaintextKey.Raw(&dataKey); err != nil {
+			return value, fmt.Errorf("failed to unwrap data key: %w", err)
+		}
+		aead, err = newAESGCM(dataKey)
+		if err != nil {
+			return value, err
+		}
+		key.dataKeys.Add(cacheKey, aead)
+	}
+
+	ciphertext := decoded[envelopeCiphertext]
+	if len(ciphertext) < aead.NonceSize() {
+		return value, errors.New("invalid encrypted value")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
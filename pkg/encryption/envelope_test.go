@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/hex"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	contribCrypto "github.com/dapr/components-contrib/crypto"
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// countingSubtleCrypto counts the keys unwrapped by the fake crypto provider,
+// whose "aes-passthrough" key wraps keys as is.
+type countingSubtleCrypto struct {
+	daprt.FakeSubtleCrypto
+	unwrapped atomic.Int32
+}
+
+func (c *countingSubtleCrypto) UnwrapKey(ctx context.Context, wrappedKey []byte, algorithm string, keyName string, nonce []byte, tag []byte, associatedData []byte) (jwk.Key, error) {
+	c.unwrapped.Add(1)
+	return c.FakeSubtleCrypto.UnwrapKey(ctx, wrappedKey, algorithm, keyName, nonce, tag, associatedData)
+}
+
+func componentWithMetadata(md map[string]string) v1alpha1.Component {
+	var component v1alpha1.Component
+	for k, v := range md {
+		component.Spec.Metadata = append(component.Spec.Metadata, commonapi.NameValuePair{
+			Name: k,
+			Value: commonapi.DynamicValue{
+				JSON: apiextv1.JSON{Raw: []byte(v)},
+			},
+		})
+	}
+	return component
+}
+
+func TestComponentEnvelopeKey(t *testing.T) {
+	getProvider := func(string) (contribCrypto.SubtleCrypto, bool) { return nil, false }
+
+	key, err := ComponentEnvelopeKey(componentWithMetadata(nil), getProvider)
+	require.NoError(t, err)
+	assert.Nil(t, key)
+
+	key, err = ComponentEnvelopeKey(componentWithMetadata(map[string]string{
+		cryptoComponentKey: "mycrypto",
+		cryptoKeyNameKey:   "mykey",
+	}), getProvider)
+	require.NoError(t, err)
+	require.NotNil(t, key)
+	assert.Equal(t, "mycrypto", key.Component)
+	assert.Equal(t, "mykey", key.KeyName)
+	assert.Equal(t, defaultKeyWrapAlgorithm, key.Algorithm)
+
+	key, err = ComponentEnvelopeKey(componentWithMetadata(map[string]string{
+		cryptoComponentKey:        "mycrypto",
+		cryptoKeyNameKey:          "mykey",
+		cryptoKeyWrapAlgorithmKey: "RSA-OAEP-256",
+	}), getProvider)
+	require.NoError(t, err)
+	require.NotNil(t, key)
+	assert.Equal(t, "RSA-OAEP-256", key.Algorithm)
+
+	_, err = ComponentEnvelopeKey(componentWithMetadata(map[string]string{
+		cryptoComponentKey: "mycrypto",
+	}), getProvider)
+	require.Error(t, err)
+}
+
+func TestEnvelopeEncryption(t *testing.T) {
+	providers := map[string]*countingSubtleCrypto{
+		"crypto1": {},
+		"crypto2": {},
+	}
+	getProvider := func(name string) (contribCrypto.SubtleCrypto, bool) {
+		p, ok := providers[name]
+		return p, ok
+	}
+
+	addStore := func(t *testing.T, keys ComponentEncryptionKeys, component string) {
+		t.Helper()
+		var err error
+		keys.Envelope, err = ComponentEnvelopeKey(componentWithMetadata(map[string]string{
+			cryptoComponentKey: component,
+			cryptoKeyNameKey:   "aes-passthrough",
+		}), getProvider)
+		require.NoError(t, err)
+		RemoveEncryptedStateStore("test")
+		require.True(t, AddEncryptedStateStore("test", keys))
+	}
+
+	t.Run("encrypt and decrypt", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		addStore(t, ComponentEncryptionKeys{}, "crypto1")
+		assert.True(t, EncryptedStateStore("test"))
+		assert.True(t, EnvelopeEncryptedStateStore("test"))
+
+		v := []byte("hello")
+		r1, err := TryEncryptValue(t.Context(), "test", v)
+		require.NoError(t, err)
+		assert.True(t, IsEnvelopeEncrypted(r1))
+		assert.NotContains(t, string(r1), "hello")
+
+		// Every value has its own data key.
+		r2, err := TryEncryptValue(t.Context(), "test", v)
+		require.NoError(t, err)
+		assert.NotEqual(t, r1, r2)
+
+		dr, err := TryDecryptValue(t.Context(), "test", r1)
+		require.NoError(t, err)
+		assert.Equal(t, v, dr)
+		dr, err = TryDecryptValue(t.Context(), "test", r2)
+		require.NoError(t, err)
+		assert.Equal(t, v, dr)
+	})
+
+	t.Run("unwrapped data keys are cached", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		addStore(t, ComponentEncryptionKeys{}, "crypto1")
+		r, err := TryEncryptValue(t.Context(), "test", []byte("hello"))
+		require.NoError(t, err)
+
+		// Drop the data keys cached when encrypting.
+		addStore(t, ComponentEncryptionKeys{}, "crypto1")
+		providers["crypto1"].unwrapped.Store(0)
+		for range 3 {
+			_, err = TryDecryptValue(t.Context(), "test", r)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(1), providers["crypto1"].unwrapped.Load())
+	})
+
+	t.Run("key rotation", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		addStore(t, ComponentEncryptionKeys{}, "crypto1")
+		r1, err := TryEncryptValue(t.Context(), "test", []byte("v1"))
+		require.NoError(t, err)
+
+		addStore(t, ComponentEncryptionKeys{}, "crypto2")
+		r2, err := TryEncryptValue(t.Context(), "test", []byte("v2"))
+		require.NoError(t, err)
+		assert.Contains(t, string(r2), b64.RawURLEncoding.EncodeToString([]byte("crypto2")))
+
+		dr, err := TryDecryptValue(t.Context(), "test", r1)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("v1"), dr)
+		dr, err = TryDecryptValue(t.Context(), "test", r2)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("v2"), dr)
+	})
+
+	t.Run("values encrypted with a primary key", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		primaryKey := Key{
+			Name: "primary",
+			Key:  hex.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+		}
+		var err error
+		primaryKey.cipherObj, err = createCipher(primaryKey, AESGCMAlgorithm)
+		require.NoError(t, err)
+
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: primaryKey})
+		r, err := TryEncryptValue(t.Context(), "test", []byte("hello"))
+		require.NoError(t, err)
+		assert.False(t, IsEnvelopeEncrypted(r))
+
+		addStore(t, ComponentEncryptionKeys{Primary: primaryKey}, "crypto1")
+		dr, err := TryDecryptValue(t.Context(), "test", r)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hello"), dr)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		addStore(t, ComponentEncryptionKeys{}, "crypto1")
+		r, err := TryEncryptValue(t.Context(), "test", []byte("hello"))
+		require.NoError(t, err)
+
+		_, err = TryDecryptValue(t.Context(), "test", r[:len(r)-4])
+		require.Error(t, err)
+		_, err = TryDecryptValue(t.Context(), "test", []byte(envelopePrefix+"foo"))
+		require.Error(t, err)
+		_, err = TryDecryptValue(t.Context(), "test", []byte("plaintext"))
+		require.Error(t, err)
+
+		missing := strings.Replace(string(r),
+			b64.RawURLEncoding.EncodeToString([]byte("crypto1")),
+			b64.RawURLEncoding.EncodeToString([]byte("crypto3")), 1)
+		_, err = TryDecryptValue(t.Context(), "test", []byte(missing))
+		require.Error(t, err)
+
+		RemoveEncryptedStateStore("test")
+		_, err = TryDecryptValue(t.Context(), "test", r)
+		require.Error(t, err)
+	})
+}
@@ -15,11 +15,16 @@ package encryption
 
 import (
 	"bytes"
+	"context"
 	b64 "encoding/base64"
 	"fmt"
+	"sync"
 )
 
-var encryptedStateStores = map[string]ComponentEncryptionKeys{}
+var (
+	encryptedStateStores     = map[string]ComponentEncryptionKeys{}
+	encryptedStateStoresLock sync.RWMutex
+)
 
 const (
 	separator = "||"
@@ -27,6 +32,9 @@ const (
 
 // AddEncryptedStateStore adds an encrypted state store and an associated encryption key to a list.
 func AddEncryptedStateStore(storeName string, keys ComponentEncryptionKeys) bool {
+	encryptedStateStoresLock.Lock()
+	defer encryptedStateStoresLock.Unlock()
+
 	if _, ok := encryptedStateStores[storeName]; ok {
 		return false
 	}
@@ -35,17 +43,46 @@ func AddEncryptedStateStore(storeName string, keys ComponentEncryptionKeys) bool
 	return true
 }
 
+// RemoveEncryptedStateStore removes an encrypted state store from the list, so
+// that its keys can be replaced when the component is updated.
+func RemoveEncryptedStateStore(storeName string) {
+	encryptedStateStoresLock.Lock()
+	defer encryptedStateStoresLock.Unlock()
+
+	delete(encryptedStateStores, storeName)
+}
+
 // EncryptedStateStore returns a bool that indicates if a state stores supports encryption.
 func EncryptedStateStore(storeName string) bool {
+	encryptedStateStoresLock.RLock()
+	defer encryptedStateStoresLock.RUnlock()
+
 	_, ok := encryptedStateStores[storeName]
 	return ok
 }
 
+// EnvelopeEncryptedStateStore returns a bool that indicates if a state store
+// encrypts its values with data keys wrapped by a crypto component.
+func EnvelopeEncryptedStateStore(storeName string) bool {
+	encryptedStateStoresLock.RLock()
+	defer encryptedStateStoresLock.RUnlock()
+
+	return encryptedStateStores[storeName].Envelope != nil
+}
+
 // TryEncryptValue will try to encrypt a byte array if the state store has associated encryption keys.
-// The function will append the name of the key to the value for later extraction.
+// With envelope encryption, the value is encrypted with a new data key wrapped by the crypto component.
+// Otherwise, the function will append the name of the key to the value for later extraction.
 // If no encryption keys exist, the function will return the bytes unmodified.
-func TryEncryptValue(storeName string, value []byte) ([]byte, error) {
+func TryEncryptValue(ctx context.Context, storeName string, value []byte) ([]byte, error) {
+	encryptedStateStoresLock.RLock()
 	keys := encryptedStateStores[storeName]
+	encryptedStateStoresLock.RUnlock()
+
+	if keys.Envelope != nil {
+		return encryptEnvelope(ctx, keys.Envelope, value)
+	}
+
 	enc, err := encrypt(value, keys.Primary)
 	if err != nil {
 		return value, err
@@ -57,12 +94,22 @@ func TryEncryptValue(storeName string, value []byte) ([]byte, error) {
 
 // TryDecryptValue will try to decrypt a byte array if the state store has associated encryption keys.
 // If no encryption keys exist, the function will return the bytes unmodified.
-func TryDecryptValue(storeName string, value []byte) ([]byte, error) {
+func TryDecryptValue(ctx context.Context, storeName string, value []byte) ([]byte, error) {
 	if len(value) == 0 {
 		return []byte(""), nil
 	}
 
+	encryptedStateStoresLock.RLock()
 	keys := encryptedStateStores[storeName]
+	encryptedStateStoresLock.RUnlock()
+
+	if IsEnvelopeEncrypted(value) {
+		if keys.Envelope == nil {
+			return value, fmt.Errorf("could not decrypt data for state store %s: envelope encryption is not enabled", storeName)
+		}
+		return decryptEnvelope(ctx, keys.Envelope, value)
+	}
+
 	// extract the decryption key that should be appended to the value
 	ind := bytes.LastIndex(value, []byte(separator))
 	keyName := string(value[ind+len(separator):])
@@ -78,6 +125,9 @@ func TryDecryptValue(storeName string, value []byte) ([]byte, error) {
 	} else if keys.Secondary.Name == keyName {
 		key = keys.Secondary
 	}
+	if key.cipherObj == nil {
+		return value, fmt.Errorf("could not decrypt data for state store %s: encryption key %s not found", storeName, keyName)
+	}
 
 	return decrypt(value[:ind], key)
 }
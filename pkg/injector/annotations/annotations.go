@@ -70,6 +70,7 @@ const (
 	KeyAppHealthProbeTimeout            = "dapr.io/app-health-probe-timeout"
 	KeyAppHealthThreshold               = "dapr.io/app-health-threshold"
 	KeyPlacementHostAddresses           = "dapr.io/placement-host-address"
+	KeyPlacementWeight                  = "dapr.io/placement-weight"
 	KeySchedulerHostAddresses           = "dapr.io/scheduler-host-address"
 	KeyPluggableComponents              = "dapr.io/pluggable-components"
 	KeyPluggableComponentsSocketsFolder = "dapr.io/pluggable-components-sockets-folder"
@@ -77,4 +78,5 @@ const (
 	KeyPluggableComponentsInjection     = "dapr.io/inject-pluggable-components"
 	KeyAppChannel                       = "dapr.io/app-channel-address"
 	KeySentryRequestJwtAudiences        = "dapr.io/sentry-request-jwt-audiences"
+	KeyNativeSidecar                    = "dapr.io/native-sidecar"
 )
@@ -26,6 +26,8 @@ import (
 const (
 	// Path for patching containers.
 	PatchPathContainers = "/spec/containers"
+	// Path for patching init containers.
+	PatchPathInitContainers = "/spec/initContainers"
 	// Path for patching volumes.
 	PatchPathVolumes = "/spec/volumes"
 	// Path for patching labels.
@@ -53,7 +55,13 @@ func NewPatchOperation(op string, path string, value any) jsonpatch.Operation {
 // GetEnvPatchOperations adds new environment variables only if they do not exist.
 // It does not override existing values for those variables if they have been defined already.
 func GetEnvPatchOperations(envs []corev1.EnvVar, addEnv []corev1.EnvVar, containerIdx int) jsonpatch.Patch {
-	path := fmt.Sprintf("%s/%d/env", PatchPathContainers, containerIdx)
+	return getEnvPatchOperations(PatchPathContainers, envs, addEnv, containerIdx)
+}
+
+// getEnvPatchOperations is GetEnvPatchOperations for the container at
+// containerIdx in the list of containers at containersPath.
+func getEnvPatchOperations(containersPath string, envs []corev1.EnvVar, addEnv []corev1.EnvVar, containerIdx int) jsonpatch.Patch {
+	path := fmt.Sprintf("%s/%d/env", containersPath, containerIdx)
 	if len(envs) == 0 {
 		// If there are no environment variables defined in the container, we initialize a slice of environment vars.
 		return jsonpatch.Patch{
@@ -87,7 +95,13 @@ func GetEnvPatchOperations(envs []corev1.EnvVar, addEnv []corev1.EnvVar, contain
 
 // GetVolumeMountPatchOperations gets the patch operations for volume mounts
 func GetVolumeMountPatchOperations(volumeMounts []corev1.VolumeMount, addMounts []corev1.VolumeMount, containerIdx int) jsonpatch.Patch {
-	path := fmt.Sprintf("%s/%d/volumeMounts", PatchPathContainers, containerIdx)
+	return getVolumeMountPatchOperations(PatchPathContainers, volumeMounts, addMounts, containerIdx)
+}
+
+// getVolumeMountPatchOperations is GetVolumeMountPatchOperations for the
+// container at containerIdx in the list of containers at containersPath.
+func getVolumeMountPatchOperations(containersPath string, volumeMounts []corev1.VolumeMount, addMounts []corev1.VolumeMount, containerIdx int) jsonpatch.Patch {
+	path := fmt.Sprintf("%s/%d/volumeMounts", containersPath, containerIdx)
 	if len(volumeMounts) == 0 {
 		// If there are no volume mounts defined in the container, we initialize a slice of volume mounts.
 		return jsonpatch.Patch{
@@ -112,6 +112,7 @@ type SidecarConfig struct {
 	AppHealthProbeTimeout               int32   `annotation:"dapr.io/app-health-probe-timeout" default:"500"` // In milliseconds
 	AppHealthThreshold                  int32   `annotation:"dapr.io/app-health-threshold" default:"3"`
 	PlacementAddress                    string  `annotation:"dapr.io/placement-host-address"`
+	PlacementWeight                     *int    `annotation:"dapr.io/placement-weight"`
 	SchedulerAddress                    *string `annotation:"dapr.io/scheduler-host-address"`
 	SchedulerEnabled                    bool
 	PluggableComponents                 string `annotation:"dapr.io/pluggable-components"`
@@ -120,6 +121,7 @@ type SidecarConfig struct {
 	InjectPluggableComponents           bool   `annotation:"dapr.io/inject-pluggable-components"`
 	AppChannelAddress                   string `annotation:"dapr.io/app-channel-address"`
 	SentryRequestJwtAudiences           string `annotation:"dapr.io/sentry-request-jwt-audiences"`
+	NativeSidecar                       bool   `annotation:"dapr.io/native-sidecar"`
 
 	pod *corev1.Pod
 }
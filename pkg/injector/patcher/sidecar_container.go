@@ -26,12 +26,20 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/dapr/dapr/pkg/config/protocol"
+	"github.com/dapr/dapr/pkg/injector/annotations"
 	injectorConsts "github.com/dapr/dapr/pkg/injector/consts"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
 	"github.com/dapr/dapr/utils"
 	"github.com/dapr/kit/ptr"
 )
 
+const (
+	// Period and failure threshold of the startup probe of native sidecars,
+	// allowing up to 5 minutes for daprd to start.
+	sidecarStartupProbePeriodSeconds = 1
+	sidecarStartupProbeThreshold     = 300
+)
+
 type getSidecarContainerOpts struct {
 	VolumeMounts                 []corev1.VolumeMount
 	ComponentsSocketsVolumeMount *corev1.VolumeMount
@@ -118,6 +126,9 @@ func (c *SidecarConfig) getSidecarContainer(opts getSidecarContainerOpts) (*core
 	if c.RemindersService != "" {
 		args = append(args, "--reminders-service", c.RemindersService)
 	}
+	if c.PlacementWeight != nil {
+		args = append(args, "--placement-weight", strconv.Itoa(*c.PlacementWeight))
+	}
 
 	if c.SentryRequestJwtAudiences != "" {
 		args = append(args, "--sentry-request-jwt-audiences", c.SentryRequestJwtAudiences)
@@ -188,8 +199,14 @@ func (c *SidecarConfig) getSidecarContainer(opts getSidecarContainerOpts) (*core
 		args = append(args, "--unix-domain-socket", injectorConsts.UnixDomainSocketDaprdPath)
 	}
 
+	// Native sidecars are only stopped once the app containers have exited, so
+	// there's no app to block the shutdown for.
 	if c.BlockShutdownDuration != nil {
-		args = append(args, "--dapr-block-shutdown-duration", *c.BlockShutdownDuration)
+		if c.NativeSidecar {
+			log.Warnf("Ignoring %s annotation on pod %s: not supported with native sidecars", annotations.KeyBlockShutdownDuration, c.pod.GetName())
+		} else {
+			args = append(args, "--dapr-block-shutdown-duration", *c.BlockShutdownDuration)
+		}
 	}
 
 	if c.SchedulerAddress != nil {
@@ -399,6 +416,20 @@ func (c *SidecarConfig) getSidecarContainer(opts getSidecarContainerOpts) (*core
 		})
 	}
 
+	if c.NativeSidecar {
+		container.RestartPolicy = ptr.Of(corev1.ContainerRestartPolicyAlways)
+
+		// The app containers are started only once the startup probe of the
+		// native sidecar succeeds. Probe the outbound healthz endpoint, which
+		// doesn't depend on the app being reachable.
+		container.StartupProbe = &corev1.Probe{
+			ProbeHandler:     getReadinessProbeHandler(c.SidecarPublicPort, injectorConsts.APIVersionV1, injectorConsts.SidecarHealthzPath, "outbound"),
+			TimeoutSeconds:   c.SidecarReadinessProbeTimeoutSeconds,
+			PeriodSeconds:    sidecarStartupProbePeriodSeconds,
+			FailureThreshold: sidecarStartupProbeThreshold,
+		}
+	}
+
 	// Resources for the container
 	resources, err := c.getResourceRequirements()
 	if err != nil {
@@ -879,6 +879,42 @@ func TestGetSidecarContainer(t *testing.T) {
 				assert.Contains(t, args, "--dapr-block-shutdown-duration 3s")
 			},
 		},
+		{
+			name: "ignored for native sidecars",
+			annotations: map[string]string{
+				annotations.KeyBlockShutdownDuration: "3s",
+				annotations.KeyNativeSidecar:         "true",
+			},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				args := strings.Join(container.Args, " ")
+				assert.NotContains(t, args, "--dapr-block-shutdown-duration")
+			},
+		},
+	}))
+
+	t.Run("native sidecar", testSuiteGenerator([]testCase{
+		{
+			name: "regular container by default",
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				assert.Nil(t, container.RestartPolicy)
+				assert.Nil(t, container.StartupProbe)
+			},
+		},
+		{
+			name: "restart policy and startup probe",
+			annotations: map[string]string{
+				annotations.KeyNativeSidecar: "true",
+			},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				assert.Equal(t, ptr.Of(corev1.ContainerRestartPolicyAlways), container.RestartPolicy)
+				require.NotNil(t, container.StartupProbe)
+				assert.Equal(t, "/v1.0/healthz/outbound", container.StartupProbe.HTTPGet.Path)
+				assert.Equal(t, 3501, container.StartupProbe.HTTPGet.Port.IntValue())
+				assert.Equal(t, int32(sidecarStartupProbeThreshold), container.StartupProbe.FailureThreshold)
+				assert.NotNil(t, container.ReadinessProbe)
+				assert.NotNil(t, container.LivenessProbe)
+			},
+		},
 	}))
 
 	t.Run("sidecar image", testSuiteGenerator([]testCase{
@@ -1415,6 +1451,27 @@ func TestGetSidecarContainer(t *testing.T) {
 		},
 	}))
 
+	t.Run("placement-weight", testSuiteGenerator([]testCase{
+		{
+			name:        "not present by default",
+			annotations: map[string]string{},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				args := strings.Join(container.Args, " ")
+				assert.NotContains(t, args, "--placement-weight")
+			},
+		},
+		{
+			name: "set value",
+			annotations: map[string]string{
+				annotations.KeyPlacementWeight: "3",
+			},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				args := strings.Join(container.Args, " ")
+				assert.Contains(t, args, "--placement-weight 3")
+			},
+		},
+	}))
+
 	t.Run("dapr-http-max-request-size", testSuiteGenerator([]testCase{
 		{
 			name:        "not present by default",
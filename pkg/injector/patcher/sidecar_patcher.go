@@ -119,9 +119,28 @@ func (c *SidecarConfig) GetPatch() (patchOps jsonpatch.Patch, err error) {
 		patchOps = append(patchOps, c.getVolumesPatchOperations(volumes, PatchPathVolumes)...)
 	}
 
+	// Add the sidecar container
+	if c.NativeSidecar {
+		// Native sidecars are init containers which keep running alongside the
+		// app containers. They are started before, and stopped after, the app
+		// containers, and don't keep Jobs from completing. The sidecar is the
+		// first init container, so the other init containers can use Dapr too.
+		if len(c.pod.Spec.InitContainers) == 0 {
+			patchOps = append(patchOps,
+				NewPatchOperation("add", PatchPathInitContainers, []corev1.Container{}),
+			)
+		}
+		patchOps = append(patchOps,
+			NewPatchOperation("add", PatchPathInitContainers+"/0", sidecarContainer),
+		)
+	} else {
+		patchOps = append(patchOps,
+			NewPatchOperation("add", PatchPathContainers+"/-", sidecarContainer),
+		)
+	}
+
 	// Other patch operations
 	patchOps = append(patchOps,
-		NewPatchOperation("add", PatchPathContainers+"/-", sidecarContainer),
 		NewPatchOperation("add", PatchPathLabels+"/dapr.io~1sidecar-injected", "true"),
 		NewPatchOperation("add", PatchPathLabels+"/dapr.io~1app-id", c.GetAppID()),
 		NewPatchOperation("add", PatchPathLabels+"/dapr.io~1metrics-enabled", strconv.FormatBool(c.EnableMetrics)),
@@ -134,24 +153,44 @@ func (c *SidecarConfig) GetPatch() (patchOps jsonpatch.Patch, err error) {
 			addVolumeMountToContainers(appContainers, vm)...,
 		)
 	}
+	if c.NativeSidecar {
+		patchOps = append(patchOps,
+			c.addDaprEnvVarsToContainersAt(PatchPathInitContainers, shiftContainers(c.pod.Spec.InitContainers), c.GetAppProtocol())...,
+		)
+		for _, vm := range containerVolumeMounts {
+			patchOps = append(patchOps,
+				addVolumeMountToInitContainers(c.pod.Spec.InitContainers, vm)...,
+			)
+		}
+	}
 	patchOps = append(patchOps, componentPatchOps...)
 
 	return patchOps, nil
 }
 
-// podContainsSidecarContainer returns true if the pod contains a sidecar container (i.e. a container named "daprd").
+// podContainsSidecarContainer returns true if the pod contains a sidecar container (i.e. a container named "daprd"),
+// either as a regular container or as a native sidecar init container.
 func (c *SidecarConfig) podContainsSidecarContainer() bool {
 	for _, c := range c.pod.Spec.Containers {
 		if c.Name == injectorConsts.SidecarContainerName {
 			return true
 		}
 	}
+	for _, c := range c.pod.Spec.InitContainers {
+		if c.Name == injectorConsts.SidecarContainerName {
+			return true
+		}
+	}
 	return false
 }
 
 // addDaprEnvVarsToContainers adds Dapr environment variables to all the containers in any Dapr-enabled pod.
 // The containers can be injected or user-defined.
 func (c *SidecarConfig) addDaprEnvVarsToContainers(containers map[int]corev1.Container, appProtocol string) jsonpatch.Patch {
+	return c.addDaprEnvVarsToContainersAt(PatchPathContainers, containers, appProtocol)
+}
+
+func (c *SidecarConfig) addDaprEnvVarsToContainersAt(containersPath string, containers map[int]corev1.Container, appProtocol string) jsonpatch.Patch {
 	envPatchOps := make(jsonpatch.Patch, 0, len(containers)*2)
 	envVars := []corev1.EnvVar{
 		{
@@ -170,7 +209,7 @@ func (c *SidecarConfig) addDaprEnvVarsToContainers(containers map[int]corev1.Con
 		})
 	}
 	for i, container := range containers {
-		patchOps := GetEnvPatchOperations(container.Env, envVars, i)
+		patchOps := getEnvPatchOperations(containersPath, container.Env, envVars, i)
 		envPatchOps = append(envPatchOps, patchOps...)
 	}
 	return envPatchOps
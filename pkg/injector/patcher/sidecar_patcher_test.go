@@ -25,6 +25,7 @@ import (
 
 	"github.com/dapr/dapr/pkg/injector/annotations"
 	injectorConsts "github.com/dapr/dapr/pkg/injector/consts"
+	"github.com/dapr/kit/ptr"
 )
 
 func TestAddDaprEnvVarsToContainers(t *testing.T) {
@@ -207,6 +208,22 @@ func TestPodNeedsPatching(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "false if daprd native sidecar already exists",
+			want: false,
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						annotations.KeyEnabled: "yes",
+					},
+				},
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{
+						{Name: "daprd"},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -372,6 +389,89 @@ func TestPatching(t *testing.T) {
 				assert.Contains(t, args, "--unix-domain-socket /var/run/dapr-sockets")
 			},
 		},
+		{
+			name: "native sidecar",
+			podModifierFn: func(pod *corev1.Pod) {
+				pod.Annotations[annotations.KeyNativeSidecar] = "true"
+				pod.Annotations[annotations.KeyUnixDomainSocketPath] = "/tmp/socket"
+			},
+			assertFn: func(t *testing.T, pod *corev1.Pod) {
+				require.Len(t, pod.Spec.Containers, 1)
+				assert.Equal(t, "appcontainer", pod.Spec.Containers[0].Name)
+
+				require.Len(t, pod.Spec.InitContainers, 1)
+				daprdContainer := pod.Spec.InitContainers[0]
+				assert.Equal(t, "daprd", daprdContainer.Name)
+				assert.Equal(t, ptr.Of(corev1.ContainerRestartPolicyAlways), daprdContainer.RestartPolicy)
+				require.NotNil(t, daprdContainer.StartupProbe)
+				assert.Equal(t, "/v1.0/healthz/outbound", daprdContainer.StartupProbe.HTTPGet.Path)
+
+				// The app container still gets the env vars and the socket mount
+				appEnvVars := map[string]string{}
+				for _, env := range pod.Spec.Containers[0].Env {
+					appEnvVars[env.Name] = env.Value
+				}
+				assert.Equal(t, "3500", appEnvVars["DAPR_HTTP_PORT"])
+				require.Len(t, pod.Spec.Containers[0].VolumeMounts, 1)
+				assert.Equal(t, "dapr-unix-domain-socket", pod.Spec.Containers[0].VolumeMounts[0].Name)
+
+				assert.Equal(t, "true", pod.Labels[injectorConsts.SidecarInjectedLabel])
+			},
+		},
+		{
+			name: "native sidecar before existing init containers",
+			podModifierFn: func(pod *corev1.Pod) {
+				pod.Annotations[annotations.KeyUnixDomainSocketPath] = "/tmp/socket"
+				pod.Spec.InitContainers = []corev1.Container{
+					{Name: "init1", Image: "init:1.0"},
+					{
+						Name:         "init2",
+						Image:        "init:1.0",
+						Env:          []corev1.EnvVar{{Name: "DAPR_HTTP_PORT", Value: "1234"}},
+						VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/data"}},
+					},
+				}
+			},
+			sidecarConfigModifierFn: func(c *SidecarConfig) {
+				c.NativeSidecar = true
+			},
+			assertFn: func(t *testing.T, pod *corev1.Pod) {
+				require.Len(t, pod.Spec.Containers, 1)
+				require.Len(t, pod.Spec.InitContainers, 3)
+				assert.Equal(t, "daprd", pod.Spec.InitContainers[0].Name)
+				assert.Equal(t, "init1", pod.Spec.InitContainers[1].Name)
+				assert.Equal(t, "init2", pod.Spec.InitContainers[2].Name)
+
+				// The init containers run after daprd started, so they get the
+				// env vars and the socket mount too.
+				for _, container := range pod.Spec.InitContainers[1:] {
+					envVars := map[string]string{}
+					for _, env := range container.Env {
+						envVars[env.Name] = env.Value
+					}
+					assert.Equal(t, "50001", envVars["DAPR_GRPC_PORT"], container.Name)
+					require.NotEmpty(t, container.VolumeMounts, container.Name)
+					last := container.VolumeMounts[len(container.VolumeMounts)-1]
+					assert.Equal(t, "dapr-unix-domain-socket", last.Name, container.Name)
+					assert.Equal(t, "/tmp/socket", last.MountPath, container.Name)
+				}
+				assert.Equal(t, "1234", pod.Spec.InitContainers[2].Env[0].Value)
+				assert.Equal(t, "data", pod.Spec.InitContainers[2].VolumeMounts[0].Name)
+			},
+		},
+		{
+			name: "native sidecar disabled by annotation",
+			podModifierFn: func(pod *corev1.Pod) {
+				pod.Annotations[annotations.KeyNativeSidecar] = "false"
+			},
+			sidecarConfigModifierFn: func(c *SidecarConfig) {
+				c.NativeSidecar = true
+			},
+			assertFn: func(t *testing.T, pod *corev1.Pod) {
+				assertDaprdContainerFn(t, pod)
+				assert.Empty(t, pod.Spec.InitContainers)
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, testCaseFn(tc))
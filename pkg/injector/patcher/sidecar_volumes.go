@@ -88,15 +88,36 @@ func (c *SidecarConfig) getTokenVolume() corev1.Volume {
 }
 
 func addVolumeMountToContainers(containers map[int]corev1.Container, addMounts corev1.VolumeMount) jsonpatch.Patch {
+	return addVolumeMountToContainersAt(PatchPathContainers, containers, addMounts)
+}
+
+// addVolumeMountToInitContainers adds a volume mount to the init containers of
+// the pod, which run after the native sidecar. The native sidecar is inserted
+// before them, so their indexes are shifted by one.
+func addVolumeMountToInitContainers(initContainers []corev1.Container, addMounts corev1.VolumeMount) jsonpatch.Patch {
+	return addVolumeMountToContainersAt(PatchPathInitContainers, shiftContainers(initContainers), addMounts)
+}
+
+func addVolumeMountToContainersAt(containersPath string, containers map[int]corev1.Container, addMounts corev1.VolumeMount) jsonpatch.Patch {
 	volumeMount := []corev1.VolumeMount{addMounts}
 	volumeMountPatchOps := make(jsonpatch.Patch, 0, len(containers))
 	for i, container := range containers {
-		patchOps := GetVolumeMountPatchOperations(container.VolumeMounts, volumeMount, i)
+		patchOps := getVolumeMountPatchOperations(containersPath, container.VolumeMounts, volumeMount, i)
 		volumeMountPatchOps = append(volumeMountPatchOps, patchOps...)
 	}
 	return volumeMountPatchOps
 }
 
+// shiftContainers returns the containers by their index once the native
+// sidecar is inserted before them.
+func shiftContainers(containers []corev1.Container) map[int]corev1.Container {
+	res := make(map[int]corev1.Container, len(containers))
+	for i, container := range containers {
+		res[i+1] = container
+	}
+	return res
+}
+
 func (c *SidecarConfig) getVolumesPatchOperations(addVolumes []corev1.Volume, path string) jsonpatch.Patch {
 	if len(c.pod.Spec.Volumes) == 0 {
 		// If there are no volumes defined in the container, we initialize a slice of volumes.
@@ -114,8 +114,9 @@ func (i *injector) getPodPatchOperations(ctx context.Context, ar *admissionv1.Ad
 		sidecar.RemindersService = remindersSvcName + ":" + remindersSvc.Address(i.config.Namespace, i.config.KubeClusterDomain)
 	}
 
-	// Default value for the sidecar image, which can be overridden by annotations
+	// Default values for the sidecar image and injection mode, which can be overridden by annotations
 	sidecar.SidecarImage = i.config.SidecarImage
+	sidecar.NativeSidecar = i.config.GetNativeSidecar()
 
 	// Set the configuration from annotations
 	sidecar.SetFromPodAnnotations()
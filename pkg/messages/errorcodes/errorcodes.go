@@ -97,6 +97,7 @@ var (
 	StateStoreQueryNotSupported        = ErrorCode{"ERR_STATE_STORE_NOT_SUPPORTED", "DAPR_STATE_QUERYING_NOT_SUPPORTED", CategoryState}        // State store does not support querying
 	StateStoreTooManyTransactions      = ErrorCode{"ERR_STATE_STORE_TOO_MANY_TRANSACTIONS", "DAPR_STATE_TOO_MANY_TRANSACTIONS", CategoryState} // Too many operations per transaction
 	StateMalformedRequest              = ErrorCode{"ERR_MALFORMED_REQUEST", "DAPR_STATE_ILLEGAL_KEY", CategoryState}                           // Invalid key
+	StateSubscribeChanges              = ErrorCode{"ERR_STATE_SUBSCRIBE_CHANGES", "DAPR_STATE_SUBSCRIBE_CHANGES_FAILED", CategoryState}        // Error subscribing to state changes
 
 	// ### Configuration API
 	ConfigurationGet                = ErrorCode{"ERR_CONFIGURATION_GET", "", CategoryConfiguration}                  // Error getting configuration
@@ -118,19 +119,28 @@ var (
 	SecretPermissionDenied   = ErrorCode{"ERR_PERMISSION_DENIED", "", CategorySecret}            // Permission denied by policy
 
 	// ### Pub/Sub and messaging errors
-	PubSubEmpty                 = ErrorCode{"ERR_PUBSUB_EMPTY", "DAPR_PUBSUB_NAME_EMPTY", CategoryPubsub}                          // Pubsub name is empty
-	PubSubNotFound              = ErrorCode{"ERR_PUBSUB_NOT_FOUND", "DAPR_PUBSUB_NOT_FOUND", CategoryPubsub}                       // Pubsub not found
-	PubSubTestNotFound          = ErrorCode{"ERR_PUBSUB_NOT_FOUND", "DAPR_PUBSUB_TEST_NOT_FOUND", CategoryPubsub}                  // Pubsub not found
-	PubSubNotConfigured         = ErrorCode{"ERR_PUBSUB_NOT_CONFIGURED", "DAPR_PUBSUB_NOT_CONFIGURED", CategoryPubsub}             // Pubsub not configured
-	PubSubTopicNameEmpty        = ErrorCode{"ERR_TOPIC_NAME_EMPTY", "DAPR_PUBSUB_TOPIC_NAME_EMPTY", CategoryPubsub}                // Topic name is empty
-	PubsubForbidden             = ErrorCode{"ERR_PUBSUB_FORBIDDEN", "DAPR_PUBSUB_FORBIDDEN", CategoryPubsub}                       // Access to topic forbidden for APP ID
-	PubsubPublishMessage        = ErrorCode{"ERR_PUBSUB_PUBLISH_MESSAGE", "DAPR_PUBSUB_PUBLISH_MESSAGE", CategoryPubsub}           // Error publishing message
-	PubSubRequestMetadata       = ErrorCode{"ERR_PUBSUB_REQUEST_METADATA", "DAPR_PUBSUB_METADATA_DESERIALIZATION", CategoryPubsub} // Error deserializing metadata
-	PubSubCloudEventsSer        = ErrorCode{"ERR_PUBSUB_CLOUD_EVENTS_SER", "DAPR_PUBSUB_CLOUD_EVENT_CREATION", CategoryPubsub}     // Error creating CloudEvent
-	PubSubEventsSerEnvelope     = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_ENVELOPE", CategoryPubsub}               // Error marshalling Cloud Event envelope
-	PubSubEventsMarshalEvents   = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_EVENTS", CategoryPubsub}                 // Error marshalling events to bytes
-	PubSubEventsUnmarshalEvents = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_UNMARSHAL_EVENTS", CategoryPubsub}               // Error unmarshalling events
-	PubsubPublishOutbox         = ErrorCode{"ERR_PUBLISH_OUTBOX", "", CategoryPubsub}                                              // Error publishing message to outbox
+	PubSubEmpty                 = ErrorCode{"ERR_PUBSUB_EMPTY", "DAPR_PUBSUB_NAME_EMPTY", CategoryPubsub}                              // Pubsub name is empty
+	PubSubNotFound              = ErrorCode{"ERR_PUBSUB_NOT_FOUND", "DAPR_PUBSUB_NOT_FOUND", CategoryPubsub}                           // Pubsub not found
+	PubSubTestNotFound          = ErrorCode{"ERR_PUBSUB_NOT_FOUND", "DAPR_PUBSUB_TEST_NOT_FOUND", CategoryPubsub}                      // Pubsub not found
+	PubSubNotConfigured         = ErrorCode{"ERR_PUBSUB_NOT_CONFIGURED", "DAPR_PUBSUB_NOT_CONFIGURED", CategoryPubsub}                 // Pubsub not configured
+	PubSubTopicNameEmpty        = ErrorCode{"ERR_TOPIC_NAME_EMPTY", "DAPR_PUBSUB_TOPIC_NAME_EMPTY", CategoryPubsub}                    // Topic name is empty
+	PubsubForbidden             = ErrorCode{"ERR_PUBSUB_FORBIDDEN", "DAPR_PUBSUB_FORBIDDEN", CategoryPubsub}                           // Access to topic forbidden for APP ID
+	PubsubSubscribeForbidden    = ErrorCode{"ERR_PUBSUB_FORBIDDEN", "DAPR_PUBSUB_SUBSCRIBE_FORBIDDEN", CategoryPubsub}                 // Subscribing to topic forbidden for APP ID
+	PubsubPublishMessage        = ErrorCode{"ERR_PUBSUB_PUBLISH_MESSAGE", "DAPR_PUBSUB_PUBLISH_MESSAGE", CategoryPubsub}               // Error publishing message
+	PubSubRequestMetadata       = ErrorCode{"ERR_PUBSUB_REQUEST_METADATA", "DAPR_PUBSUB_METADATA_DESERIALIZATION", CategoryPubsub}     // Error deserializing metadata
+	PubSubCloudEventsSer        = ErrorCode{"ERR_PUBSUB_CLOUD_EVENTS_SER", "DAPR_PUBSUB_CLOUD_EVENT_CREATION", CategoryPubsub}         // Error creating CloudEvent
+	PubSubEventsSerEnvelope     = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_ENVELOPE", CategoryPubsub}                   // Error marshalling Cloud Event envelope
+	PubSubEventsMarshalEvents   = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_EVENTS", CategoryPubsub}                     // Error marshalling events to bytes
+	PubSubEventsUnmarshalEvents = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_UNMARSHAL_EVENTS", CategoryPubsub}                   // Error unmarshalling events
+	PubsubPublishOutbox         = ErrorCode{"ERR_PUBLISH_OUTBOX", "", CategoryPubsub}                                                  // Error publishing message to outbox
+	PubsubSchedulePublish       = ErrorCode{"ERR_PUBSUB_SCHEDULE_PUBLISH", "DAPR_PUBSUB_SCHEDULE_PUBLISH", CategoryPubsub}             // Error scheduling delayed message
+	PubsubCancelScheduled       = ErrorCode{"ERR_PUBSUB_CANCEL_SCHEDULED", "DAPR_PUBSUB_CANCEL_SCHEDULED", CategoryPubsub}             // Error cancelling delayed message
+	PubsubScheduledInvalid      = ErrorCode{"ERR_PUBSUB_SCHEDULED_INVALID", "DAPR_PUBSUB_SCHEDULED_INVALID", CategoryPubsub}           // Invalid delayed message ID
+	PubsubScheduledNotFound     = ErrorCode{"ERR_PUBSUB_SCHEDULED_NOT_FOUND", "DAPR_PUBSUB_SCHEDULED_NOT_FOUND", CategoryPubsub}       // Delayed message not found
+	PubsubReplayInvalid         = ErrorCode{"ERR_PUBSUB_REPLAY_INVALID", "DAPR_PUBSUB_REPLAY_INVALID", CategoryPubsub}                 // Invalid dead letter replay request
+	PubsubReplayNotFound        = ErrorCode{"ERR_PUBSUB_REPLAY_NOT_FOUND", "DAPR_PUBSUB_REPLAY_NOT_FOUND", CategoryPubsub}             // Dead letter replay job not found
+	PubsubSubscriptionNotFound  = ErrorCode{"ERR_PUBSUB_SUBSCRIPTION_NOT_FOUND", "DAPR_PUBSUB_SUBSCRIPTION_NOT_FOUND", CategoryPubsub} // Subscription not found
+	PubsubSchemaValidation      = ErrorCode{"ERR_PUBSUB_SCHEMA_VALIDATION", "DAPR_PUBSUB_SCHEMA_VALIDATION", CategoryPubsub}           // Message does not match the topic schema
 
 	// ### Conversation API
 	ConversationInvalidParms  = ErrorCode{"ERR_CONVERSATION_INVALID_PARMS", "", CategoryConversation}  // Invalid parameters for conversation component
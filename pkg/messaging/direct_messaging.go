@@ -14,11 +14,15 @@ limitations under the License.
 package messaging
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
+	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -26,10 +30,15 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/utils/clock"
 
 	nr "github.com/dapr/components-contrib/nameresolution"
 	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
@@ -52,6 +61,13 @@ const streamingUnsupportedErr = "target app '%s' is running a version of Dapr th
 // Maximum TTL in seconds for the nameresolution cache
 const resolverCacheTTL = 20
 
+// Timeout of the invocations of the shadow apps of mirrored invocations
+const mirrorTimeout = 30 * time.Second
+
+// Maximum number of mirrored invocations in progress. Invocations are not
+// mirrored while the limit is reached.
+const maxConcurrentMirrors = 64
+
 // messageClientConnection is the function type to connect to the other
 // applications to send the message using service invocation.
 type messageClientConnection func(ctx context.Context, address string, id string, namespace string, customOpts ...grpc.DialOption) (*grpc.ClientConn, func(destroy bool), error)
@@ -73,6 +89,10 @@ type directMessaging struct {
 	resiliency          resiliency.Provider
 	compStore           *compstore.ComponentStore
 	resolverCache       *ttlcache.Cache[nr.AddressList]
+	loadBalancers       map[string]*loadBalancer
+	mirrors             map[string]config.MirrorSpec
+	mirrorSlots         chan struct{}
+	trafficSplits       map[string]*trafficSplit
 	closed              atomic.Bool
 }
 
@@ -81,6 +101,39 @@ type remoteApp struct {
 	namespace string
 	address   string
 	cacheKey  string
+	balancer  *loadBalancer
+	// resolved is true if the address of the app was resolved with the name
+	// resolver, in which case another instance can be picked to retry.
+	resolved bool
+}
+
+// pick returns the address of the instance of the app to invoke.
+func (a remoteApp) pick(addresses nr.AddressList) string {
+	if a.balancer == nil {
+		// Pick a random one
+		return addresses.Pick()
+	}
+	return a.balancer.pick(addresses)
+}
+
+// startRequest records the start of an invocation of the app, and returns the
+// function to call with the result of the invocation when it completes.
+func (a remoteApp) startRequest() func(err error) {
+	if a.balancer == nil {
+		return func(error) {}
+	}
+	return a.balancer.start(a.address)
+}
+
+// finishRequest calls done with the result of an invocation. If the body of
+// the response is streamed, done is only called once the body has been read
+// or closed, so that the invocation counts as in flight until then.
+func finishRequest(done func(err error), resp *invokev1.InvokeMethodResponse, err error) {
+	rErr := invocationError(resp, err)
+	if err == nil && resp != nil && resp.WithDataCloseFn(func() { done(rErr) }) {
+		return
+	}
+	done(rErr)
 }
 
 // NewDirectMessaging contains the options for NewDirectMessaging.
@@ -98,6 +151,7 @@ type NewDirectMessagingOpts struct {
 	Proxy              Proxy
 	ReadBufferSize     int
 	Resiliency         resiliency.Provider
+	ServiceInvocation  config.ServiceInvocationSpec
 }
 
 // NewDirectMessaging returns a new direct messaging api.
@@ -120,6 +174,46 @@ func NewDirectMessaging(opts NewDirectMessagingOpts) invokev1.DirectMessaging {
 		hostAddress:         hAddr,
 		hostName:            hName,
 		compStore:           opts.CompStore,
+		loadBalancers:       make(map[string]*loadBalancer),
+		mirrors:             make(map[string]config.MirrorSpec),
+		mirrorSlots:         make(chan struct{}, maxConcurrentMirrors),
+		trafficSplits:       make(map[string]*trafficSplit),
+	}
+
+	for _, target := range opts.ServiceInvocation.Targets {
+		id, namespace, err := dm.requestAppIDAndNamespace(target.AppID)
+		if err != nil {
+			log.Errorf("Invalid service invocation target: %v", err)
+			continue
+		}
+
+		if target.LoadBalancing != nil {
+			b, err := newLoadBalancer(id, *target.LoadBalancing, clock.RealClock{})
+			if err != nil {
+				log.Errorf("Invalid service invocation target: %v", err)
+			} else {
+				dm.loadBalancers[id+"."+namespace] = b
+			}
+		}
+
+		if target.Mirror != nil {
+			if _, _, err := dm.requestAppIDAndNamespace(target.Mirror.AppID); err != nil {
+				log.Errorf("Invalid mirror of service invocation target %s: %v", target.AppID, err)
+			} else if target.Mirror.Percentage < 0 || target.Mirror.Percentage > 100 {
+				log.Errorf("Invalid mirror of service invocation target %s: percentage %d is not between 0 and 100", target.AppID, target.Mirror.Percentage)
+			} else if target.Mirror.Percentage > 0 {
+				dm.mirrors[id+"."+namespace] = *target.Mirror
+			}
+		}
+
+		if target.TrafficSplit != nil {
+			split, err := newTrafficSplit(target.AppID, *target.TrafficSplit)
+			if err != nil {
+				log.Errorf("Invalid service invocation target: %v", err)
+			} else {
+				dm.trafficSplits[id+"."+namespace] = split
+			}
+		}
 	}
 
 	// Set resolverMulti if the resolver implements the ResolverMulti interface
@@ -133,6 +227,9 @@ func NewDirectMessaging(opts NewDirectMessagingOpts) invokev1.DirectMessaging {
 	if dm.proxy != nil {
 		dm.proxy.SetRemoteAppFn(dm.getRemoteApp)
 		dm.proxy.SetTelemetryFn(dm.setContextSpan)
+		dm.proxy.SetTrafficSplitFn(func(appID string, md metadata.MD) string {
+			return dm.resolveTrafficSplit(appID, grpcMetadataHeaderFn(md))
+		})
 	}
 
 	return dm
@@ -151,7 +248,7 @@ func (d *directMessaging) Close() error {
 
 // Invoke takes a message requests and invokes an app, either local or remote.
 func (d *directMessaging) Invoke(ctx context.Context, targetAppID string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
-	app, err := d.getRemoteApp(targetAppID)
+	app, err := d.getRemoteApp(d.resolveTrafficSplit(targetAppID, internalMetadataHeaderFn(req.Metadata())))
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +262,158 @@ func (d *directMessaging) Invoke(ctx context.Context, targetAppID string, req *i
 		return d.invokeLocal(ctx, req)
 	}
 
-	return d.invokeWithRetry(ctx, retry.DefaultLinearRetryCount, retry.DefaultLinearBackoffInterval, app, d.invokeRemote, req)
+	mirrorFn := d.mirror(ctx, app, req)
+	resp, err := d.invokeWithRetry(ctx, retry.DefaultLinearRetryCount, retry.DefaultLinearBackoffInterval, app, d.invokeRemote, req)
+	if mirrorFn != nil {
+		mirrorFn(resp, err)
+	}
+	return resp, err
+}
+
+// mirror returns the function to call with the result of the invocation of
+// app to mirror it to the shadow app, or nil if the invocation isn't mirrored.
+// Mirroring doesn't affect the result of the invocation: the shadow app is
+// invoked in background and its response is discarded. Invocations are not
+// mirrored while maxConcurrentMirrors mirrored invocations are in progress.
+func (d *directMessaging) mirror(ctx context.Context, app remoteApp, req *invokev1.InvokeMethodRequest) func(*invokev1.InvokeMethodResponse, error) {
+	m, ok := d.mirrors[app.id+"."+app.namespace]
+	if !ok || req.Proto().GetMessage() == nil || rand.IntN(100) >= m.Percentage || d.closed.Load() {
+		return nil
+	}
+
+	select {
+	case d.mirrorSlots <- struct{}{}:
+	default:
+		log.Debugf("Not mirroring invocation of app %s to app %s: too many mirrored invocations in progress", app.id, m.AppID)
+		return nil
+	}
+	release := func() { <-d.mirrorSlots }
+
+	// The metadata is copied before the invocation adds its own. The data of
+	// the message, if any, is not modified, so it is shared with the request.
+	pb := req.Proto()
+	data := pb.GetMessage().GetData()
+	pb.Message.Data = nil
+	shadowPb := proto.Clone(pb).(*internalv1pb.InternalInvokeRequest)
+	pb.Message.Data = data
+	if data != nil {
+		shadowPb.Message.Data = &anypb.Any{TypeUrl: data.GetTypeUrl(), Value: data.GetValue()}
+	}
+
+	// The data stream of the request is copied as it is sent to the app. The
+	// data stream of a replayable request can't be replaced, so it is read
+	// from the replay buffer once the invocation completes instead.
+	var body *mirrorBody
+	replay := false
+	if !req.HasMessageData() {
+		if req.CanReplay() {
+			replay = true
+		} else {
+			body = &mirrorBody{r: req.RawData(), limit: d.maxRequestBodySize}
+			req.WithRawData(body)
+		}
+	}
+
+	return func(resp *invokev1.InvokeMethodResponse, err error) {
+		if replay {
+			body = &mirrorBody{r: req.RawData(), limit: d.maxRequestBodySize}
+			_, _ = io.Copy(io.Discard, body)
+		}
+		if body != nil {
+			if !body.complete() {
+				release()
+				log.Debugf("Not mirroring invocation of app %s to app %s: the request body was not sent in full", app.id, m.AppID)
+				return
+			}
+			shadowPb.Message.Data = &anypb.Any{Value: body.buf.Bytes()}
+		}
+		shadowReq, rErr := invokev1.FromInternalInvokeRequest(shadowPb)
+		if rErr != nil {
+			release()
+			log.Debugf("Failed to mirror invocation of app %s to app %s: %v", app.id, m.AppID, rErr)
+			return
+		}
+
+		primaryStatus := mirrorStatus(resp, err)
+		go func() {
+			defer release()
+			d.invokeMirror(context.WithoutCancel(ctx), app.id, m.AppID, shadowReq, primaryStatus)
+		}()
+	}
+}
+
+// mirrorBody copies the data stream of a mirrored request as it's read, up to
+// a limit.
+type mirrorBody struct {
+	r     io.Reader
+	buf   bytes.Buffer
+	limit int
+	eof   bool
+	over  bool
+}
+
+func (b *mirrorBody) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	if n > 0 && !b.over {
+		if b.limit > 0 && b.buf.Len()+n > b.limit {
+			b.over = true
+			b.buf = bytes.Buffer{}
+		} else {
+			b.buf.Write(p[:n])
+		}
+	}
+	if errors.Is(err, io.EOF) {
+		b.eof = true
+	}
+	return n, err
+}
+
+func (b *mirrorBody) Close() error {
+	if rc, ok := b.r.(io.Closer); ok {
+		return rc.Close()
+	}
+	return nil
+}
+
+// complete returns true if the data stream was read in full, within the limit.
+func (b *mirrorBody) complete() bool {
+	return b.eof && !b.over
+}
+
+// invokeMirror invokes the shadow app with a mirrored request, and records the
+// statuses of the primary and shadow responses.
+func (d *directMessaging) invokeMirror(ctx context.Context, primaryAppID, shadowAppID string, req *invokev1.InvokeMethodRequest, primaryStatus string) {
+	defer req.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, mirrorTimeout)
+	defer cancel()
+
+	var resp *invokev1.InvokeMethodResponse
+	app, err := d.getRemoteApp(shadowAppID)
+	if err == nil {
+		var teardown func(destroy bool)
+		done := app.startRequest()
+		resp, teardown, err = d.invokeRemote(ctx, app.id, app.namespace, app.address, req)
+		finishRequest(done, resp, err)
+		teardown(false)
+	}
+	if resp != nil {
+		defer resp.Close()
+	}
+	if err != nil {
+		log.Debugf("Failed to mirror invocation of app %s to app %s: %v", primaryAppID, shadowAppID, err)
+	}
+
+	diag.DefaultMonitoring.ServiceInvocationMirrorResponseReceived(primaryAppID, shadowAppID, primaryStatus, mirrorStatus(resp, err))
+}
+
+// mirrorStatus returns the status of an invocation recorded in the mirroring
+// metrics.
+func mirrorStatus(resp *invokev1.InvokeMethodResponse, err error) string {
+	if err != nil || resp == nil {
+		return "error"
+	}
+	return strconv.Itoa(int(resp.Status().GetCode()))
 }
 
 // requestAppIDAndNamespace takes an app id and returns the app id, namespace and error.
@@ -188,6 +436,35 @@ func (d *directMessaging) requestAppIDAndNamespace(targetAppID string) (string,
 	}
 }
 
+// resolveTrafficSplit returns the app id to invoke for an invocation of the
+// target app id: if the invocations of the target app are split, the app id of
+// the backend picked for the invocation, otherwise the target app id itself.
+// Backends with no namespace are in the namespace of the target app.
+// Invocations of this app, such as calls forwarded by the sidecar of the
+// caller, are never split.
+func (d *directMessaging) resolveTrafficSplit(targetAppID string, header headerFn) string {
+	if len(d.trafficSplits) == 0 {
+		return targetAppID
+	}
+	id, namespace, err := d.requestAppIDAndNamespace(targetAppID)
+	if err != nil || (id == d.appID && namespace == d.namespace) {
+		return targetAppID
+	}
+	split, ok := d.trafficSplits[id+"."+namespace]
+	if !ok {
+		return targetAppID
+	}
+	backend := split.pick(header)
+	if backend == "" {
+		return targetAppID
+	}
+	if namespace != d.namespace && !strings.Contains(backend, ".") {
+		backend += "." + namespace
+	}
+	log.Debugf("Invocation of app %s is split to app %s", targetAppID, backend)
+	return backend
+}
+
 // checkHTTPEndpoints takes an app id and checks if the app id is associated with the http endpoint CRDs,
 // and returns the baseURL if an http endpoint is found.
 func (d *directMessaging) checkHTTPEndpoints(targetAppID string) string {
@@ -223,7 +500,16 @@ func (d *directMessaging) invokeWithRetry(
 		)
 		return policyRunner(func(ctx context.Context) (*invokev1.InvokeMethodResponse, error) {
 			attempt := resiliency.GetAttempt(ctx)
+			// Retries pick the instance to invoke again, so that they can go to
+			// another instance than the one which failed.
+			if attempt > 1 && app.resolved {
+				if err := d.resolveInstance(&app); err != nil {
+					return nil, err
+				}
+			}
+			done := app.startRequest()
 			rResp, teardown, rErr := fn(ctx, app.id, app.namespace, app.address, req)
+			finishRequest(done, rResp, rErr)
 			if rErr == nil {
 				teardown(false)
 				return rResp, nil
@@ -245,11 +531,26 @@ func (d *directMessaging) invokeWithRetry(
 		})
 	}
 
+	done := app.startRequest()
 	resp, teardown, err := fn(ctx, app.id, app.namespace, app.address, req)
+	finishRequest(done, resp, err)
 	teardown(false)
 	return resp, err
 }
 
+// invocationError returns the error of an invocation for the outlier
+// detection: either the error of the call, or a server error returned by the
+// target app.
+func invocationError(resp *invokev1.InvokeMethodResponse, err error) error {
+	if err != nil {
+		return err
+	}
+	if resp != nil && resp.Status().GetCode() >= http.StatusInternalServerError {
+		return fmt.Errorf("target app returned status %d", resp.Status().GetCode())
+	}
+	return nil
+}
+
 func (d *directMessaging) invokeLocal(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
 	appChannel := d.channels.AppChannel()
 	if appChannel == nil {
@@ -599,50 +900,60 @@ func (d *directMessaging) getRemoteApp(appID string) (res remoteApp, err error)
 	case d.isHTTPEndpoint(res.id):
 		res.address = d.checkHTTPEndpoints(res.id)
 	default:
-		request := nr.ResolveRequest{
-			ID:        res.id,
-			Namespace: res.namespace,
-			Port:      d.grpcPort,
-		}
-
-		// If the component implements ResolverMulti, we can use caching
-		if d.resolverMulti != nil {
-			var addresses nr.AddressList
-			if d.resolverCache != nil {
-				// Check if the value is in the cache
-				res.cacheKey = request.CacheKey()
-				addresses, _ = d.resolverCache.Get(res.cacheKey)
-				if len(addresses) > 0 {
-					// Pick a random one
-					res.address = addresses.Pick()
-				}
-			}
+		res.balancer = d.loadBalancers[res.id+"."+res.namespace]
+		res.resolved = true
+		err = d.resolveInstance(&res)
+	}
 
-			// If there was nothing in the cache (including the case of the cache disabled)
-			if res.address == "" {
-				// Resolve
-				addresses, err = d.resolverMulti.ResolveIDMulti(context.TODO(), request)
-				if err != nil {
-					return res, err
-				}
-				res.address = addresses.Pick()
+	return res, err
+}
 
-				if len(addresses) > 0 && res.cacheKey != "" {
-					// Store the result in cache
-					// Note that we may have a race condition here if another goroutine was resolving the same address
-					// This is acceptable, as the waste caused by an extra DNS resolution is very small
-					d.resolverCache.Set(res.cacheKey, addresses, resolverCacheTTL)
-				}
+// resolveInstance resolves the addresses of the instances of an app with the
+// name resolver, and picks the instance to invoke.
+func (d *directMessaging) resolveInstance(res *remoteApp) (err error) {
+	res.address = ""
+	request := nr.ResolveRequest{
+		ID:        res.id,
+		Namespace: res.namespace,
+		Port:      d.grpcPort,
+	}
+
+	// If the component implements ResolverMulti, we can use caching
+	if d.resolverMulti != nil {
+		var addresses nr.AddressList
+		if d.resolverCache != nil {
+			// Check if the value is in the cache
+			res.cacheKey = request.CacheKey()
+			addresses, _ = d.resolverCache.Get(res.cacheKey)
+			if len(addresses) > 0 {
+				res.address = res.pick(addresses)
 			}
-		} else {
-			res.address, err = d.resolver.ResolveID(context.TODO(), request)
+		}
+
+		// If there was nothing in the cache (including the case of the cache disabled)
+		if res.address == "" {
+			// Resolve
+			addresses, err = d.resolverMulti.ResolveIDMulti(context.TODO(), request)
 			if err != nil {
-				return res, err
+				return err
 			}
+			res.address = res.pick(addresses)
+
+			if len(addresses) > 0 && res.cacheKey != "" {
+				// Store the result in cache
+				// Note that we may have a race condition here if another goroutine was resolving the same address
+				// This is acceptable, as the waste caused by an extra DNS resolution is very small
+				d.resolverCache.Set(res.cacheKey, addresses, resolverCacheTTL)
+			}
+		}
+	} else {
+		res.address, err = d.resolver.ResolveID(context.TODO(), request)
+		if err != nil {
+			return err
 		}
 	}
 
-	return res, nil
+	return nil
 }
 
 // ReadChunk reads a chunk of data from a StreamPayload object.
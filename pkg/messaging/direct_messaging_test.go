@@ -21,20 +21,28 @@ import (
 	"io"
 	"net"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/config"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
 	"github.com/dapr/kit/logger"
 )
 
@@ -310,6 +318,243 @@ func TestInvokeRemote(t *testing.T) {
 	})
 }
 
+func TestInvokeMirror(t *testing.T) {
+	log.SetOutputLevel(logger.FatalLevel)
+	defer log.SetOutputLevel(logger.InfoLevel)
+
+	socket := filepath.Join(t.TempDir(), "mirror")
+	server := startInternalServer(socket, true, []string{"🐱"})
+	defer server.Stop()
+	clientConn := createTestClient(socket)
+	defer clientConn.Close()
+
+	resolver := new(daprt.MockResolver)
+	resolver.On("ResolveID", mock.Anything).Return("addr", nil)
+
+	connected := make(chan string, 10)
+	messaging := NewDirectMessaging(NewDirectMessagingOpts{
+		AppID:              "caller",
+		Namespace:          "ns",
+		MaxRequestBodySize: 10 << 20,
+		Resolver:           resolver,
+		Resiliency:         resiliency.New(nil),
+		CompStore:          compstore.New(),
+		ClientConnFn: func(ctx context.Context, address string, id string, namespace string, customOpts ...grpc.DialOption) (*grpc.ClientConn, func(destroy bool), error) {
+			connected <- id
+			return clientConn, func(_ bool) {}, nil
+		},
+		ServiceInvocation: config.ServiceInvocationSpec{
+			Targets: []config.ServiceInvocationTargetSpec{
+				{AppID: "app1", Mirror: &config.MirrorSpec{AppID: "shadow", Percentage: 100}},
+				{AppID: "app2", Mirror: &config.MirrorSpec{AppID: "shadow", Percentage: 0}},
+				{AppID: "app3", Mirror: &config.MirrorSpec{AppID: "shadow", Percentage: 101}},
+			},
+		},
+	}).(*directMessaging)
+	defer messaging.Close()
+
+	assert.Equal(t, map[string]config.MirrorSpec{
+		"app1.ns": {AppID: "shadow", Percentage: 100},
+	}, messaging.mirrors)
+
+	invoke := func(t *testing.T, appID string) {
+		t.Helper()
+		request := invokev1.NewInvokeMethodRequest("method").
+			WithMetadata(map[string][]string{invokev1.DestinationIDHeader: {appID}}).
+			WithRawDataString("hello")
+		defer request.Close()
+		res, err := messaging.Invoke(t.Context(), appID, request)
+		require.NoError(t, err)
+		defer res.Close()
+		data, err := res.RawDataFull()
+		require.NoError(t, err)
+		assert.Equal(t, "🐱", string(data))
+	}
+
+	t.Run("invocation is mirrored", func(t *testing.T) {
+		invoke(t, "app1")
+		assert.Equal(t, "app1", <-connected)
+		select {
+		case id := <-connected:
+			assert.Equal(t, "shadow", id)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "shadow app was not invoked")
+		}
+	})
+
+	t.Run("invocation is not mirrored", func(t *testing.T) {
+		invoke(t, "app2")
+		assert.Equal(t, "app2", <-connected)
+		select {
+		case id := <-connected:
+			assert.Fail(t, "unexpected invocation of app "+id)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("streamed invocation is mirrored", func(t *testing.T) {
+		request := invokev1.NewInvokeMethodRequest("method").
+			WithMetadata(map[string][]string{invokev1.DestinationIDHeader: {"app1"}}).
+			WithRawData(strings.NewReader("hello"))
+		defer request.Close()
+		res, err := messaging.Invoke(t.Context(), "app1", request)
+		require.NoError(t, err)
+		defer res.Close()
+		assert.Equal(t, "app1", <-connected)
+		select {
+		case id := <-connected:
+			assert.Equal(t, "shadow", id)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "shadow app was not invoked")
+		}
+	})
+
+	t.Run("replayable invocation is mirrored", func(t *testing.T) {
+		request := invokev1.NewInvokeMethodRequest("method").
+			WithMetadata(map[string][]string{invokev1.DestinationIDHeader: {"app1"}}).
+			WithRawData(strings.NewReader("hello")).
+			WithReplay(true)
+		defer request.Close()
+		res, err := messaging.Invoke(t.Context(), "app1", request)
+		require.NoError(t, err)
+		defer res.Close()
+		assert.Equal(t, "app1", <-connected)
+		select {
+		case id := <-connected:
+			assert.Equal(t, "shadow", id)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "shadow app was not invoked")
+		}
+
+		// The request can still be replayed after it was mirrored.
+		data, err := request.RawDataFull()
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	})
+
+	t.Run("invocation is not mirrored when too many mirrors are in progress", func(t *testing.T) {
+		for range maxConcurrentMirrors {
+			messaging.mirrorSlots <- struct{}{}
+		}
+		defer func() {
+			for range maxConcurrentMirrors {
+				<-messaging.mirrorSlots
+			}
+		}()
+
+		invoke(t, "app1")
+		assert.Equal(t, "app1", <-connected)
+		select {
+		case id := <-connected:
+			assert.Fail(t, "unexpected invocation of app "+id)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
+
+func TestMirrorBody(t *testing.T) {
+	t.Run("body is copied", func(t *testing.T) {
+		body := &mirrorBody{r: strings.NewReader("hello"), limit: 10}
+		assert.False(t, body.complete())
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+		assert.True(t, body.complete())
+		assert.Equal(t, "hello", body.buf.String())
+	})
+
+	t.Run("body over the limit is not copied", func(t *testing.T) {
+		body := &mirrorBody{r: strings.NewReader("hello world"), limit: 5}
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(data))
+		assert.False(t, body.complete())
+		assert.Zero(t, body.buf.Len())
+	})
+}
+
+func TestInvokeLoadBalanced(t *testing.T) {
+	log.SetOutputLevel(logger.FatalLevel)
+	defer log.SetOutputLevel(logger.InfoLevel)
+
+	socket := filepath.Join(t.TempDir(), "lb")
+	server := startInternalServer(socket, true, []string{"🐱"})
+	defer server.Stop()
+	clientConn := createTestClient(socket)
+	defer clientConn.Close()
+
+	newMessaging := func(resolver *daprt.MockResolver, connected chan<- string) *directMessaging {
+		return NewDirectMessaging(NewDirectMessagingOpts{
+			AppID:              "caller",
+			Namespace:          "ns",
+			MaxRequestBodySize: 10 << 20,
+			Resolver:           resolver,
+			Resiliency:         resiliency.FromConfigurations(log),
+			CompStore:          compstore.New(),
+			ClientConnFn: func(ctx context.Context, address string, id string, namespace string, customOpts ...grpc.DialOption) (*grpc.ClientConn, func(destroy bool), error) {
+				connected <- address
+				if address == "unavailable" {
+					return nil, nil, status.Error(codes.Unavailable, "unavailable")
+				}
+				return clientConn, func(_ bool) {}, nil
+			},
+			ServiceInvocation: config.ServiceInvocationSpec{
+				Targets: []config.ServiceInvocationTargetSpec{
+					{AppID: "app1", LoadBalancing: &config.LoadBalancingSpec{Strategy: config.LoadBalancingLeastRequest}},
+				},
+			},
+		}).(*directMessaging)
+	}
+
+	invoke := func(t *testing.T, messaging *directMessaging) *invokev1.InvokeMethodResponse {
+		t.Helper()
+		request := invokev1.NewInvokeMethodRequest("method").
+			WithMetadata(map[string][]string{invokev1.DestinationIDHeader: {"app1"}}).
+			WithRawDataString("hello")
+		defer request.Close()
+		res, err := messaging.Invoke(t.Context(), "app1", request)
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("retries pick the instance again", func(t *testing.T) {
+		resolver := new(daprt.MockResolver)
+		resolver.On("ResolveID", mock.Anything).Return("unavailable", nil).Once()
+		resolver.On("ResolveID", mock.Anything).Return("addr", nil)
+
+		connected := make(chan string, 10)
+		messaging := newMessaging(resolver, connected)
+		defer messaging.Close()
+
+		res := invoke(t, messaging)
+		defer res.Close()
+		assert.Equal(t, "unavailable", <-connected)
+		assert.Equal(t, "addr", <-connected)
+	})
+
+	t.Run("streamed response counts as in flight until closed", func(t *testing.T) {
+		resolver := new(daprt.MockResolver)
+		resolver.On("ResolveID", mock.Anything).Return("addr", nil)
+
+		messaging := newMessaging(resolver, make(chan string, 10))
+		defer messaging.Close()
+		balancer := messaging.loadBalancers["app1.ns"]
+		require.NotNil(t, balancer)
+
+		res := invoke(t, messaging)
+		assert.EqualValues(t, 1, balancer.inFlight("addr"))
+		require.NoError(t, res.Close())
+		assert.EqualValues(t, 0, balancer.inFlight("addr"))
+
+		res = invoke(t, messaging)
+		defer res.Close()
+		data, err := res.RawDataFull()
+		require.NoError(t, err)
+		assert.Equal(t, "🐱", string(data))
+		assert.EqualValues(t, 0, balancer.inFlight("addr"))
+	})
+}
+
 func createTestClient(socket string) *grpc.ClientConn {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -39,6 +39,7 @@ type Proxy interface {
 	Handler() grpc.StreamHandler
 	SetRemoteAppFn(func(string) (remoteApp, error))
 	SetTelemetryFn(func(context.Context) context.Context)
+	SetTrafficSplitFn(func(appID string, md metadata.MD) string)
 }
 
 type proxy struct {
@@ -47,6 +48,7 @@ type proxy struct {
 	connectionFactory  messageClientConnection
 	remoteAppFn        func(appID string) (remoteApp, error)
 	telemetryFn        func(context.Context) context.Context
+	trafficSplitFn     func(appID string, md metadata.MD) string
 	appendAppTokenFn   func(context.Context) context.Context
 	acl                *config.AccessControlList
 	resiliency         resiliency.Provider
@@ -88,6 +90,7 @@ func (p *proxy) Handler() grpc.StreamHandler {
 
 			return resiliency.NoOp{}.EndpointPolicy("", "")
 		},
+		p.resolveTrafficSplit,
 		grpcProxy.DirectorConnectionFactory(p.connectionFactory),
 		p.maxRequestBodySize,
 	)
@@ -147,9 +150,24 @@ func (p *proxy) intercept(ctx context.Context, fullName string) (context.Context
 	outCtx := metadata.NewOutgoingContext(ctx, md.Copy())
 
 	// proxy to a remote daprd
+	done := target.startRequest()
 	conn, teardown, cErr := p.connectionFactory(outCtx, target.address, target.id, target.namespace,
 		grpc.WithDefaultCallOptions(grpc.CallContentSubtype((&codec.Proxy{}).Name())),
 	)
+	if cErr != nil {
+		done(cErr)
+	} else {
+		connTeardown := teardown
+		teardown = func(destroy bool) {
+			// The connection is destroyed when the target is unavailable.
+			if destroy {
+				done(errors.New("connection to the target destroyed"))
+			} else {
+				done(nil)
+			}
+			connTeardown(destroy)
+		}
+	}
 	outCtx = p.telemetryFn(outCtx)
 	outCtx = metadata.AppendToOutgoingContext(outCtx, invokev1.CallerIDHeader, p.appID, invokev1.CalleeIDHeader, target.id)
 
@@ -167,6 +185,18 @@ func (p *proxy) SetRemoteAppFn(remoteAppFn func(appID string) (remoteApp, error)
 	p.remoteAppFn = remoteAppFn
 }
 
+// SetTrafficSplitFn sets a function that resolves the app a call for an app ID is split to.
+func (p *proxy) SetTrafficSplitFn(trafficSplitFn func(appID string, md metadata.MD) string) {
+	p.trafficSplitFn = trafficSplitFn
+}
+
+func (p *proxy) resolveTrafficSplit(appID string, md metadata.MD) string {
+	if p.trafficSplitFn == nil {
+		return appID
+	}
+	return p.trafficSplitFn(appID, md)
+}
+
 // SetTelemetryFn sets a function that enriches the context with telemetry.
 func (p *proxy) SetTelemetryFn(spanFn func(context.Context) context.Context) {
 	p.telemetryFn = spanFn
@@ -108,6 +108,27 @@ func TestSetTelemetryFn(t *testing.T) {
 	assert.Equal(t, "b", md["a"][0])
 }
 
+func TestSetTrafficSplitFn(t *testing.T) {
+	p := NewProxy(ProxyOpts{
+		ConnectionFactory: connectionFn,
+		AppClientFn:       appClientFn,
+		AppID:             "a",
+		ACL:               nil,
+		Resiliency:        resiliency.New(nil),
+	})
+	proxy := p.(*proxy)
+	assert.Equal(t, "b", proxy.resolveTrafficSplit("b", nil))
+
+	p.SetTrafficSplitFn(func(appID string, md metadata.MD) string {
+		if v := md.Get("version"); len(v) > 0 {
+			return appID + "-" + v[0]
+		}
+		return appID
+	})
+	assert.Equal(t, "b", proxy.resolveTrafficSplit("b", metadata.MD{}))
+	assert.Equal(t, "b-v2", proxy.resolveTrafficSplit("b", metadata.Pairs("version", "v2")))
+}
+
 func TestHandler(t *testing.T) {
 	p := NewProxy(ProxyOpts{
 		ConnectionFactory: connectionFn,
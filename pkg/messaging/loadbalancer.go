@@ -0,0 +1,266 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+const (
+	defaultOutlierConsecutiveErrors  = 5
+	defaultOutlierBaseEjectionTime   = 30 * time.Second
+	defaultOutlierMaxEjectionPercent = 50
+)
+
+// loadBalancer picks the instance of a target app to invoke among the
+// addresses returned by the name resolver, and optionally ejects the instances
+// failing invocations.
+type loadBalancer struct {
+	appID    string
+	strategy string
+	outlier  *outlierDetection
+	clock    clock.Clock
+	next     atomic.Uint64
+
+	lock      sync.Mutex
+	instances map[string]*instance
+}
+
+// outlierDetection is the parsed config.OutlierDetectionSpec.
+type outlierDetection struct {
+	consecutiveErrors  int
+	baseEjectionTime   time.Duration
+	maxEjectionPercent int
+}
+
+// instance is the state of an instance of a target app.
+type instance struct {
+	inFlight atomic.Int64
+
+	// Guarded by the lock of the loadBalancer.
+	consecutiveErrors int
+	ejections         int
+	ejectedUntil      time.Time
+}
+
+func newLoadBalancer(appID string, spec config.LoadBalancingSpec, clock clock.Clock) (*loadBalancer, error) {
+	b := &loadBalancer{
+		appID:     appID,
+		strategy:  spec.Strategy,
+		clock:     clock,
+		instances: make(map[string]*instance),
+	}
+
+	switch b.strategy {
+	case "":
+		b.strategy = config.LoadBalancingRandom
+	case config.LoadBalancingRandom, config.LoadBalancingRoundRobin, config.LoadBalancingLeastRequest, config.LoadBalancingPowerOfTwoChoices:
+	default:
+		return nil, fmt.Errorf("unknown load balancing strategy '%s' for app %s", spec.Strategy, appID)
+	}
+
+	if od := spec.OutlierDetection; od != nil {
+		b.outlier = &outlierDetection{
+			consecutiveErrors:  od.ConsecutiveErrors,
+			baseEjectionTime:   defaultOutlierBaseEjectionTime,
+			maxEjectionPercent: od.MaxEjectionPercent,
+		}
+		if b.outlier.consecutiveErrors <= 0 {
+			b.outlier.consecutiveErrors = defaultOutlierConsecutiveErrors
+		}
+		if b.outlier.maxEjectionPercent <= 0 || b.outlier.maxEjectionPercent > 100 {
+			b.outlier.maxEjectionPercent = defaultOutlierMaxEjectionPercent
+		}
+		if od.BaseEjectionTime != "" {
+			d, err := time.ParseDuration(od.BaseEjectionTime)
+			if err != nil || d <= 0 {
+				return nil, fmt.Errorf("invalid outlier detection base ejection time '%s' for app %s", od.BaseEjectionTime, appID)
+			}
+			b.outlier.baseEjectionTime = d
+		}
+	}
+
+	return b, nil
+}
+
+// pick returns the address of the instance to invoke.
+func (b *loadBalancer) pick(addresses []string) string {
+	candidates := b.available(addresses)
+	switch len(candidates) {
+	case 0:
+		return ""
+	case 1:
+		return candidates[0]
+	}
+
+	switch b.strategy {
+	case config.LoadBalancingRoundRobin:
+		return candidates[(b.next.Add(1)-1)%uint64(len(candidates))]
+
+	case config.LoadBalancingLeastRequest:
+		// Start at a random instance, so ties are not always broken in favor of
+		// the same one.
+		start := rand.IntN(len(candidates))
+		picked := candidates[start]
+		least := b.inFlight(picked)
+		for i := 1; i < len(candidates) && least > 0; i++ {
+			address := candidates[(start+i)%len(candidates)]
+			if n := b.inFlight(address); n < least {
+				picked, least = address, n
+			}
+		}
+		return picked
+
+	case config.LoadBalancingPowerOfTwoChoices:
+		i := rand.IntN(len(candidates))
+		j := rand.IntN(len(candidates) - 1)
+		if j >= i {
+			j++
+		}
+		if b.inFlight(candidates[j]) < b.inFlight(candidates[i]) {
+			return candidates[j]
+		}
+		return candidates[i]
+
+	default:
+		return candidates[rand.IntN(len(candidates))]
+	}
+}
+
+// available returns the addresses of the instances which are not ejected.
+// If every instance is ejected, all of them are returned, so invocations keep
+// being attempted.
+func (b *loadBalancer) available(addresses []string) []string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for _, address := range addresses {
+		if _, ok := b.instances[address]; !ok {
+			b.instances[address] = new(instance)
+		}
+	}
+
+	// Forget the instances which are gone and have nothing in flight.
+	if len(b.instances) > len(addresses) {
+		current := make(map[string]struct{}, len(addresses))
+		for _, address := range addresses {
+			current[address] = struct{}{}
+		}
+		for address, inst := range b.instances {
+			if _, ok := current[address]; !ok && inst.inFlight.Load() == 0 {
+				delete(b.instances, address)
+			}
+		}
+	}
+
+	if b.outlier == nil {
+		return addresses
+	}
+
+	now := b.clock.Now()
+	candidates := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		if !now.Before(b.instances[address].ejectedUntil) {
+			candidates = append(candidates, address)
+		}
+	}
+	if len(candidates) == 0 {
+		return addresses
+	}
+	return candidates
+}
+
+func (b *loadBalancer) inFlight(address string) int64 {
+	b.lock.Lock()
+	inst, ok := b.instances[address]
+	b.lock.Unlock()
+	if !ok {
+		return 0
+	}
+	return inst.inFlight.Load()
+}
+
+// start records the start of an invocation of the instance at address, and
+// returns the function to call with the result of the invocation when it
+// completes.
+func (b *loadBalancer) start(address string) func(err error) {
+	b.lock.Lock()
+	inst, ok := b.instances[address]
+	if !ok {
+		inst = new(instance)
+		b.instances[address] = inst
+	}
+	b.lock.Unlock()
+
+	inst.inFlight.Add(1)
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() {
+			inst.inFlight.Add(-1)
+			if b.outlier != nil {
+				b.report(address, inst, err)
+			}
+		})
+	}
+}
+
+// report records the result of an invocation for the outlier detection,
+// ejecting the instance after too many consecutive errors.
+func (b *loadBalancer) report(address string, inst *instance, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if err == nil {
+		inst.consecutiveErrors = 0
+		if !b.clock.Now().Before(inst.ejectedUntil) {
+			inst.ejections = 0
+		}
+		return
+	}
+
+	inst.consecutiveErrors++
+	if inst.consecutiveErrors < b.outlier.consecutiveErrors {
+		return
+	}
+
+	now := b.clock.Now()
+	if now.Before(inst.ejectedUntil) {
+		return
+	}
+
+	var ejected int
+	for _, other := range b.instances {
+		if now.Before(other.ejectedUntil) {
+			ejected++
+		}
+	}
+	if (ejected+1)*100 > b.outlier.maxEjectionPercent*len(b.instances) {
+		log.Debugf("Not ejecting instance %s of app %s, as the maximum number of instances are ejected", address, b.appID)
+		return
+	}
+
+	inst.consecutiveErrors = 0
+	inst.ejections++
+	duration := b.outlier.baseEjectionTime * time.Duration(inst.ejections)
+	inst.ejectedUntil = now.Add(duration)
+	log.Warnf("Ejecting instance %s of app %s for %v after %d consecutive invocation errors", address, b.appID, duration, b.outlier.consecutiveErrors)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func TestNewLoadBalancer(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+
+	b, err := newLoadBalancer("app", config.LoadBalancingSpec{}, clock)
+	require.NoError(t, err)
+	assert.Equal(t, config.LoadBalancingRandom, b.strategy)
+	assert.Nil(t, b.outlier)
+
+	b, err = newLoadBalancer("app", config.LoadBalancingSpec{
+		Strategy:         config.LoadBalancingRoundRobin,
+		OutlierDetection: &config.OutlierDetectionSpec{},
+	}, clock)
+	require.NoError(t, err)
+	require.NotNil(t, b.outlier)
+	assert.Equal(t, defaultOutlierConsecutiveErrors, b.outlier.consecutiveErrors)
+	assert.Equal(t, defaultOutlierBaseEjectionTime, b.outlier.baseEjectionTime)
+	assert.Equal(t, defaultOutlierMaxEjectionPercent, b.outlier.maxEjectionPercent)
+
+	_, err = newLoadBalancer("app", config.LoadBalancingSpec{Strategy: "foo"}, clock)
+	require.Error(t, err)
+
+	_, err = newLoadBalancer("app", config.LoadBalancingSpec{
+		OutlierDetection: &config.OutlierDetectionSpec{BaseEjectionTime: "foo"},
+	}, clock)
+	require.Error(t, err)
+}
+
+func TestLoadBalancerPick(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	addresses := []string{"a", "b", "c"}
+
+	t.Run("round robin", func(t *testing.T) {
+		b, err := newLoadBalancer("app", config.LoadBalancingSpec{Strategy: config.LoadBalancingRoundRobin}, clock)
+		require.NoError(t, err)
+		picked := make([]string, 0, 6)
+		for range 6 {
+			picked = append(picked, b.pick(addresses))
+		}
+		assert.Equal(t, []string{"a", "b", "c", "a", "b", "c"}, picked)
+	})
+
+	t.Run("least request", func(t *testing.T) {
+		b, err := newLoadBalancer("app", config.LoadBalancingSpec{Strategy: config.LoadBalancingLeastRequest}, clock)
+		require.NoError(t, err)
+		doneA := b.start("a")
+		doneC := b.start("c")
+		for range 10 {
+			assert.Equal(t, "b", b.pick(addresses))
+		}
+
+		doneA(nil)
+		doneB := b.start("b")
+		for range 10 {
+			assert.Equal(t, "a", b.pick(addresses))
+		}
+		doneB(nil)
+		doneC(nil)
+	})
+
+	t.Run("power of two choices", func(t *testing.T) {
+		b, err := newLoadBalancer("app", config.LoadBalancingSpec{Strategy: config.LoadBalancingPowerOfTwoChoices}, clock)
+		require.NoError(t, err)
+		done := b.start("a")
+		defer done(nil)
+		for range 20 {
+			// With two instances, both are always compared.
+			assert.Equal(t, "b", b.pick([]string{"a", "b"}))
+		}
+	})
+
+	t.Run("random", func(t *testing.T) {
+		b, err := newLoadBalancer("app", config.LoadBalancingSpec{}, clock)
+		require.NoError(t, err)
+		for range 10 {
+			assert.Contains(t, addresses, b.pick(addresses))
+		}
+		assert.Empty(t, b.pick(nil))
+	})
+
+	t.Run("gone instances are forgotten", func(t *testing.T) {
+		b, err := newLoadBalancer("app", config.LoadBalancingSpec{}, clock)
+		require.NoError(t, err)
+		b.pick(addresses)
+		assert.Len(t, b.instances, 3)
+		b.pick([]string{"a"})
+		assert.Len(t, b.instances, 1)
+	})
+}
+
+func TestLoadBalancerOutlierDetection(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	newBalancer := func(t *testing.T) *loadBalancer {
+		t.Helper()
+		b, err := newLoadBalancer("app", config.LoadBalancingSpec{
+			Strategy: config.LoadBalancingRoundRobin,
+			OutlierDetection: &config.OutlierDetectionSpec{
+				ConsecutiveErrors:  2,
+				BaseEjectionTime:   "10s",
+				MaxEjectionPercent: 50,
+			},
+		}, clock)
+		require.NoError(t, err)
+		return b
+	}
+	fail := func(b *loadBalancer, address string) {
+		b.start(address)(errors.New("failed"))
+	}
+	picks := func(b *loadBalancer, addresses []string) map[string]bool {
+		picked := map[string]bool{}
+		for range 2 * len(addresses) {
+			picked[b.pick(addresses)] = true
+		}
+		return picked
+	}
+
+	t.Run("instance is ejected after consecutive errors", func(t *testing.T) {
+		b := newBalancer(t)
+		addresses := []string{"a", "b"}
+		b.pick(addresses)
+
+		fail(b, "a")
+		b.start("a")(nil)
+		fail(b, "a")
+		assert.Equal(t, map[string]bool{"a": true, "b": true}, picks(b, addresses))
+
+		fail(b, "a")
+		assert.Equal(t, map[string]bool{"b": true}, picks(b, addresses))
+
+		clock.Step(10 * time.Second)
+		assert.Equal(t, map[string]bool{"a": true, "b": true}, picks(b, addresses))
+
+		// The ejection time grows with the number of ejections.
+		fail(b, "a")
+		fail(b, "a")
+		clock.Step(10 * time.Second)
+		assert.Equal(t, map[string]bool{"b": true}, picks(b, addresses))
+		clock.Step(10 * time.Second)
+		assert.Equal(t, map[string]bool{"a": true, "b": true}, picks(b, addresses))
+	})
+
+	t.Run("maximum ejection percent", func(t *testing.T) {
+		b := newBalancer(t)
+		addresses := []string{"a", "b"}
+		b.pick(addresses)
+
+		fail(b, "a")
+		fail(b, "a")
+		fail(b, "b")
+		fail(b, "b")
+		assert.Equal(t, map[string]bool{"b": true}, picks(b, addresses))
+	})
+
+	t.Run("all instances are used when all are ejected", func(t *testing.T) {
+		b := newBalancer(t)
+		b.pick([]string{"a", "b"})
+		fail(b, "a")
+		fail(b, "a")
+
+		assert.Equal(t, map[string]bool{"a": true}, picks(b, []string{"a"}))
+	})
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+)
+
+// trafficSplit splits the invocations of a logical app ID between weighted
+// backend app IDs.
+type trafficSplit struct {
+	backends  []config.TrafficSplitBackendSpec
+	total     int
+	overrides []config.TrafficSplitOverrideSpec
+}
+
+// headerFn returns the values of a header of an invocation.
+type headerFn func(name string) []string
+
+func newTrafficSplit(appID string, spec config.TrafficSplitSpec) (*trafficSplit, error) {
+	s := &trafficSplit{
+		backends:  make([]config.TrafficSplitBackendSpec, 0, len(spec.Backends)),
+		overrides: spec.Overrides,
+	}
+
+	for _, backend := range spec.Backends {
+		if backend.AppID == "" {
+			return nil, fmt.Errorf("traffic split of app %s has a backend with no app id", appID)
+		}
+		if backend.Weight < 0 {
+			return nil, fmt.Errorf("traffic split of app %s has a negative weight for backend %s", appID, backend.AppID)
+		}
+		if backend.Weight > 0 {
+			s.backends = append(s.backends, backend)
+			s.total += backend.Weight
+		}
+	}
+	if s.total == 0 && len(s.overrides) == 0 {
+		return nil, fmt.Errorf("traffic split of app %s has no backend with a positive weight", appID)
+	}
+
+	for _, override := range s.overrides {
+		if override.Header == "" || override.AppID == "" {
+			return nil, errors.New("traffic split override must have a header and an app id")
+		}
+	}
+
+	return s, nil
+}
+
+// pick returns the app ID to send an invocation of the logical app ID to, or
+// an empty string if the invocation isn't split.
+func (s *trafficSplit) pick(header headerFn) string {
+	if header != nil {
+		for _, override := range s.overrides {
+			for _, v := range header(override.Header) {
+				if override.Value == "" || v == override.Value {
+					return override.AppID
+				}
+			}
+		}
+	}
+
+	if s.total == 0 {
+		return ""
+	}
+	n := rand.IntN(s.total)
+	for _, backend := range s.backends {
+		if n < backend.Weight {
+			return backend.AppID
+		}
+		n -= backend.Weight
+	}
+	return ""
+}
+
+// internalMetadataHeaderFn returns the headerFn of an invocation with the
+// internal metadata md.
+func internalMetadataHeaderFn(md invokev1.DaprInternalMetadata) headerFn {
+	return func(name string) []string {
+		for k, v := range md {
+			if strings.EqualFold(k, name) {
+				return v.GetValues()
+			}
+		}
+		return nil
+	}
+}
+
+// grpcMetadataHeaderFn returns the headerFn of a gRPC call with metadata md.
+func grpcMetadataHeaderFn(md metadata.MD) headerFn {
+	return md.Get
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+func TestNewTrafficSplit(t *testing.T) {
+	_, err := newTrafficSplit("orders", config.TrafficSplitSpec{})
+	require.Error(t, err)
+
+	_, err = newTrafficSplit("orders", config.TrafficSplitSpec{
+		Backends: []config.TrafficSplitBackendSpec{{AppID: "orders", Weight: 0}},
+	})
+	require.Error(t, err)
+
+	_, err = newTrafficSplit("orders", config.TrafficSplitSpec{
+		Backends: []config.TrafficSplitBackendSpec{{AppID: "orders", Weight: -1}},
+	})
+	require.Error(t, err)
+
+	_, err = newTrafficSplit("orders", config.TrafficSplitSpec{
+		Backends: []config.TrafficSplitBackendSpec{{Weight: 1}},
+	})
+	require.Error(t, err)
+
+	_, err = newTrafficSplit("orders", config.TrafficSplitSpec{
+		Backends:  []config.TrafficSplitBackendSpec{{AppID: "orders", Weight: 1}},
+		Overrides: []config.TrafficSplitOverrideSpec{{AppID: "orders-v2"}},
+	})
+	require.Error(t, err)
+
+	s, err := newTrafficSplit("orders", config.TrafficSplitSpec{
+		Backends: []config.TrafficSplitBackendSpec{
+			{AppID: "orders", Weight: 9},
+			{AppID: "orders-v2", Weight: 1},
+			{AppID: "orders-v3", Weight: 0},
+		},
+	})
+	require.NoError(t, err)
+	assert.Len(t, s.backends, 2)
+	assert.Equal(t, 10, s.total)
+}
+
+func TestTrafficSplitPick(t *testing.T) {
+	s, err := newTrafficSplit("orders", config.TrafficSplitSpec{
+		Backends: []config.TrafficSplitBackendSpec{
+			{AppID: "orders", Weight: 3},
+			{AppID: "orders-v2", Weight: 1},
+		},
+		Overrides: []config.TrafficSplitOverrideSpec{
+			{Header: "x-version", Value: "v2", AppID: "orders-v2"},
+			{Header: "x-canary", AppID: "orders-v3"},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("weights", func(t *testing.T) {
+		picked := map[string]int{}
+		for range 4000 {
+			picked[s.pick(nil)]++
+		}
+		assert.Len(t, picked, 2)
+		assert.InDelta(t, 3000, picked["orders"], 300)
+		assert.InDelta(t, 1000, picked["orders-v2"], 300)
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		for range 10 {
+			assert.Equal(t, "orders-v2", s.pick(grpcMetadataHeaderFn(metadata.Pairs("x-version", "v2"))))
+			assert.Equal(t, "orders-v3", s.pick(grpcMetadataHeaderFn(metadata.Pairs("X-Canary", "1"))))
+			assert.Equal(t, "orders-v3", s.pick(internalMetadataHeaderFn(invokev1.DaprInternalMetadata{
+				"X-Canary": &internalv1pb.ListStringValue{Values: []string{"1"}},
+			})))
+		}
+		assert.Contains(t, []string{"orders", "orders-v2"}, s.pick(grpcMetadataHeaderFn(metadata.Pairs("x-version", "v1"))))
+	})
+
+	t.Run("only overrides", func(t *testing.T) {
+		s, err := newTrafficSplit("orders", config.TrafficSplitSpec{
+			Overrides: []config.TrafficSplitOverrideSpec{
+				{Header: "x-version", Value: "v2", AppID: "orders-v2"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, s.pick(nil))
+		assert.Equal(t, "orders-v2", s.pick(grpcMetadataHeaderFn(metadata.Pairs("x-version", "v2"))))
+	})
+}
+
+func TestResolveTrafficSplit(t *testing.T) {
+	messaging := NewDirectMessaging(NewDirectMessagingOpts{
+		AppID:     "caller",
+		Namespace: "ns",
+		ServiceInvocation: config.ServiceInvocationSpec{
+			Targets: []config.ServiceInvocationTargetSpec{
+				{
+					AppID: "orders",
+					TrafficSplit: &config.TrafficSplitSpec{
+						Backends: []config.TrafficSplitBackendSpec{{AppID: "orders-v2.other", Weight: 1}},
+					},
+				},
+				{
+					AppID: "payments.other",
+					TrafficSplit: &config.TrafficSplitSpec{
+						Backends: []config.TrafficSplitBackendSpec{{AppID: "payments-v2", Weight: 1}},
+					},
+				},
+				{
+					AppID: "caller",
+					TrafficSplit: &config.TrafficSplitSpec{
+						Backends: []config.TrafficSplitBackendSpec{{AppID: "caller-v2", Weight: 1}},
+					},
+				},
+			},
+		},
+	}).(*directMessaging)
+	defer messaging.Close()
+
+	assert.Equal(t, "orders-v2.other", messaging.resolveTrafficSplit("orders", nil))
+	assert.Equal(t, "orders-v2.other", messaging.resolveTrafficSplit("orders.ns", nil))
+	assert.Equal(t, "orders.other", messaging.resolveTrafficSplit("orders.other", nil))
+	assert.Equal(t, "payments", messaging.resolveTrafficSplit("payments", nil))
+	assert.Equal(t, "payments-v2.other", messaging.resolveTrafficSplit("payments.other", nil))
+	assert.Equal(t, "caller", messaging.resolveTrafficSplit("caller", nil))
+	assert.Equal(t, "caller.ns", messaging.resolveTrafficSplit("caller.ns", nil))
+}
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"io"
 	"strings"
+	"sync"
 
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
@@ -248,6 +249,50 @@ func (imr *InvokeMethodResponse) RawData() (r io.Reader) {
 	return imr.replayableRequest.RawData()
 }
 
+// WithDataCloseFn sets a function which is called once, when the stream body
+// has been read to its end or closed. It returns false, without setting the
+// function, if the response has no stream body.
+func (imr *InvokeMethodResponse) WithDataCloseFn(fn func()) bool {
+	if imr.HasMessageData() {
+		return false
+	}
+
+	imr.replayableRequest.lock.Lock()
+	defer imr.replayableRequest.lock.Unlock()
+
+	if imr.replayableRequest.data == nil || imr.replayableRequest.replay != nil {
+		return false
+	}
+	imr.replayableRequest.data = &closeFnReader{
+		r:  imr.replayableRequest.data,
+		fn: sync.OnceFunc(fn),
+	}
+	return true
+}
+
+// closeFnReader is a reader which calls a function when it's read to its end
+// or closed.
+type closeFnReader struct {
+	r  io.Reader
+	fn func()
+}
+
+func (c *closeFnReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if err != nil {
+		c.fn()
+	}
+	return n, err
+}
+
+func (c *closeFnReader) Close() error {
+	defer c.fn()
+	if rc, ok := c.r.(io.Closer); ok {
+		return rc.Close()
+	}
+	return nil
+}
+
 // RawDataFull returns the entire data read from the stream body.
 func (imr *InvokeMethodResponse) RawDataFull() ([]byte, error) {
 	// If the message has a data property, use that
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"sync"
+
+	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/middleware"
+	"github.com/dapr/dapr/pkg/middleware/store"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.middleware.grpc")
+
+// GRPC returns gRPC middleware pipelines. These pipelines dynamically update
+// the interceptor chain when a component is added or removed from the store.
+// Callers need only build a Pipeline once for a given spec.
+type GRPC struct {
+	lock      sync.RWMutex
+	store     *store.Store[middleware.GRPC]
+	pipelines []*pipeline
+}
+
+// Spec is a specification for a creating a middleware.
+type Spec struct {
+	Component      compapi.Component
+	Implementation middleware.GRPC
+}
+
+// New returns a new gRPC middleware store.
+func New() *GRPC {
+	return &GRPC{
+		store: store.New[middleware.GRPC]("grpc"),
+	}
+}
+
+// Add adds a middleware to the store.
+func (g *GRPC) Add(spec Spec) {
+	g.store.Add(store.Item[middleware.GRPC]{
+		Metadata: store.Metadata{
+			Name:    spec.Component.Name,
+			Type:    spec.Component.Spec.Type,
+			Version: spec.Component.Spec.Version,
+		},
+		Middleware: spec.Implementation,
+	})
+
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	for _, p := range g.pipelines {
+		p.buildChain()
+	}
+}
+
+// Remove removes a middleware from the store.
+func (g *GRPC) Remove(name string) {
+	g.store.Remove(name)
+
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	for _, p := range g.pipelines {
+		p.buildChain()
+	}
+}
+
+// BuildPipelineFromSpec builds a middleware pipeline from a spec. The
+// interceptors of the returned middleware dynamically update when middleware
+// components are added or removed from the store.
+func (g *GRPC) BuildPipelineFromSpec(name string, spec *config.PipelineSpec) middleware.GRPC {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	p := newPipeline(name, g.store, spec)
+	p.buildChain()
+	g.pipelines = append(g.pipelines, p)
+	return p.grpc()
+}
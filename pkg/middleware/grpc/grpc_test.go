@@ -0,0 +1,243 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/middleware"
+)
+
+// testMiddleware records its name in the order the middlewares are called.
+func testMiddleware(name string, calls *[]string) Spec {
+	return Spec{
+		Component: compapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: compapi.ComponentSpec{
+				Type:    "middleware.grpc.fakemw",
+				Version: "v1",
+			},
+		},
+		Implementation: middleware.GRPC{
+			Unary: func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+				*calls = append(*calls, name)
+				return handler(ctx, req)
+			},
+			Stream: func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				*calls = append(*calls, name)
+				return handler(srv, ss)
+			},
+		},
+	}
+}
+
+func TestGRPC(t *testing.T) {
+	var calls []string
+	g := New()
+	mw := g.BuildPipelineFromSpec("test", &config.PipelineSpec{Handlers: []config.HandlerSpec{
+		{Name: "test1", Type: "middleware.grpc.fakemw", Version: "v1"},
+		{Name: "test2", Type: "middleware.grpc.fakemw"},
+	}})
+
+	invoke := func() {
+		t.Helper()
+		calls = nil
+		res, err := mw.Unary(t.Context(), "req", &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+			calls = append(calls, "handler")
+			return req, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "req", res)
+
+		err = mw.Stream(nil, nil, &grpc.StreamServerInfo{}, func(any, grpc.ServerStream) error {
+			calls = append(calls, "handler")
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	invoke()
+	assert.Equal(t, []string{"handler", "handler"}, calls)
+
+	g.Add(testMiddleware("test2", &calls))
+	invoke()
+	assert.Equal(t, []string{"test2", "handler", "test2", "handler"}, calls)
+
+	g.Add(testMiddleware("test1", &calls))
+	g.Add(testMiddleware("test3", &calls))
+	invoke()
+	assert.Equal(t, []string{"test1", "test2", "handler", "test1", "test2", "handler"}, calls)
+
+	g.Remove("test1")
+	invoke()
+	assert.Equal(t, []string{"test2", "handler", "test2", "handler"}, calls)
+
+	t.Run("unary only middleware", func(t *testing.T) {
+		spec := testMiddleware("test1", &calls)
+		spec.Implementation.Stream = nil
+		g.Add(spec)
+		invoke()
+		assert.Equal(t, []string{"test1", "test2", "handler", "test2", "handler"}, calls)
+	})
+}
+
+func TestGRPCWithoutHandlers(t *testing.T) {
+	g := New()
+	mw := g.BuildPipelineFromSpec("test", nil)
+	assert.Nil(t, mw.Unary)
+	assert.Nil(t, mw.Stream)
+
+	mw = g.BuildPipelineFromSpec("test", &config.PipelineSpec{})
+	assert.Nil(t, mw.Unary)
+	assert.Nil(t, mw.Stream)
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	mw := middleware.GRPC{
+		Unary: func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			assert.Equal(t, "/test/Method", info.FullMethod)
+			md, _ := metadata.FromIncomingContext(ctx)
+			assert.Equal(t, []string{"bar"}, md.Get("foo"))
+			ctx = metadata.NewIncomingContext(ctx, metadata.Join(md, metadata.Pairs("authorization", "token")))
+			return handler(ctx, req)
+		},
+	}
+
+	ctx := metadata.AppendToOutgoingContext(t.Context(), "foo", "bar")
+	var invoked bool
+	err := mw.UnaryClientInterceptor()(ctx, "/test/Method", "req", "reply", nil, func(ctx context.Context, method string, req, reply any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		invoked = true
+		md, _ := metadata.FromOutgoingContext(ctx)
+		assert.Equal(t, []string{"bar"}, md.Get("foo"))
+		assert.Equal(t, []string{"token"}, md.Get("authorization"))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, invoked)
+}
+
+// testClientStream is a client stream with the given context.
+type testClientStream struct {
+	grpc.ClientStream
+	ctx context.Context
+}
+
+func (s *testClientStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	desc := &grpc.StreamDesc{ServerStreams: true}
+
+	t.Run("middleware sets metadata", func(t *testing.T) {
+		doneCh := make(chan struct{})
+		mw := middleware.GRPC{
+			Stream: func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				defer close(doneCh)
+				assert.Equal(t, "/test/Stream", info.FullMethod)
+				assert.True(t, info.IsServerStream)
+				assert.False(t, info.IsClientStream)
+				md, _ := metadata.FromIncomingContext(ss.Context())
+				assert.Equal(t, []string{"bar"}, md.Get("foo"))
+				return handler(srv, &wrappedStream{
+					ServerStream: ss,
+					ctx:          metadata.NewIncomingContext(ss.Context(), metadata.Join(md, metadata.Pairs("authorization", "token"))),
+				})
+			},
+		}
+
+		ctx := metadata.AppendToOutgoingContext(t.Context(), "foo", "bar")
+		cs, err := mw.StreamClientInterceptor()(ctx, desc, nil, "/test/Stream", func(ctx context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			assert.Equal(t, []string{"bar"}, md.Get("foo"))
+			assert.Equal(t, []string{"token"}, md.Get("authorization"))
+			return &testClientStream{ctx: ctx}, nil
+		})
+		require.NoError(t, err)
+		require.NotNil(t, cs)
+
+		// The middleware returns once the stream is opened.
+		select {
+		case <-doneCh:
+		default:
+			require.Fail(t, "middleware did not return once the stream was opened")
+		}
+	})
+
+	t.Run("stream is opened once if the handler is called twice", func(t *testing.T) {
+		mw := middleware.GRPC{
+			Stream: func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				if err := handler(srv, ss); err != nil {
+					return err
+				}
+				return handler(srv, ss)
+			},
+		}
+
+		var opened int
+		cs, err := mw.StreamClientInterceptor()(t.Context(), desc, nil, "/test/Stream", func(ctx context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+			opened++
+			return &testClientStream{ctx: ctx}, nil
+		})
+		require.NoError(t, err)
+		require.NotNil(t, cs)
+		assert.Equal(t, 1, opened)
+	})
+
+	t.Run("middleware rejects the stream", func(t *testing.T) {
+		mw := middleware.GRPC{
+			Stream: func(any, grpc.ServerStream, *grpc.StreamServerInfo, grpc.StreamHandler) error {
+				return errors.New("denied")
+			},
+		}
+
+		_, err := mw.StreamClientInterceptor()(t.Context(), desc, nil, "/test/Stream", func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+			require.Fail(t, "stream should not be opened")
+			return nil, nil
+		})
+		require.ErrorContains(t, err, "denied")
+	})
+
+	t.Run("stream fails to open", func(t *testing.T) {
+		mw := middleware.GRPC{
+			Stream: func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				return handler(srv, ss)
+			},
+		}
+
+		_, err := mw.StreamClientInterceptor()(t.Context(), desc, nil, "/test/Stream", func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+			return nil, errors.New("unavailable")
+		})
+		require.ErrorContains(t, err, "unavailable")
+	})
+}
+
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *wrappedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"sync"
+
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/middleware"
+	"github.com/dapr/dapr/pkg/middleware/store"
+)
+
+// pipeline manages a single gRPC middleware pipeline for a single Pipeline
+// Spec.
+type pipeline struct {
+	lock   sync.RWMutex
+	name   string
+	spec   *config.PipelineSpec
+	store  *store.Store[middleware.GRPC]
+	unary  grpc.UnaryServerInterceptor
+	stream grpc.StreamServerInterceptor
+}
+
+// newPipeline creates a new gRPC Middleware Pipeline.
+func newPipeline(
+	name string,
+	store *store.Store[middleware.GRPC],
+	spec *config.PipelineSpec,
+) *pipeline {
+	return &pipeline{
+		name:  name,
+		spec:  spec,
+		store: store,
+	}
+}
+
+// grpc returns a dynamic gRPC middleware. Its interceptors call the chain of
+// the middlewares which are currently loaded. Consumers must call
+// `buildChain` for changes to the store to take effect. A pipeline without
+// handlers returns no interceptors, so that none run on every call.
+func (p *pipeline) grpc() middleware.GRPC {
+	if p.spec == nil || len(p.spec.Handlers) == 0 {
+		return middleware.GRPC{}
+	}
+
+	return middleware.GRPC{
+		Unary: func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			p.lock.RLock()
+			next := p.unary
+			p.lock.RUnlock()
+			if next == nil {
+				return handler(ctx, req)
+			}
+			return next(ctx, req, info, handler)
+		},
+		Stream: func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			p.lock.RLock()
+			next := p.stream
+			p.lock.RUnlock()
+			if next == nil {
+				return handler(srv, ss)
+			}
+			return next(srv, ss, info, handler)
+		},
+	}
+}
+
+// buildChain builds and updates the interceptor chains using the set spec.
+// Any middlewares which are not currently loaded are skipped.
+func (p *pipeline) buildChain() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.unary, p.stream = nil, nil
+
+	// If no spec or no handlers defined, call the handlers directly.
+	if p.spec == nil || len(p.spec.Handlers) == 0 {
+		return
+	}
+
+	log.Infof("Building pipeline %s", p.name)
+
+	var (
+		unary  []grpc.UnaryServerInterceptor
+		stream []grpc.StreamServerInterceptor
+	)
+	for _, h := range p.spec.Handlers {
+		m, ok := p.store.Get(store.Metadata{
+			Name:    h.Name,
+			Type:    h.Type,
+			Version: h.Version,
+		})
+		if !ok {
+			continue
+		}
+		if m.Unary != nil {
+			unary = append(unary, m.Unary)
+		}
+		if m.Stream != nil {
+			stream = append(stream, m.Stream)
+		}
+	}
+
+	if len(unary) > 0 {
+		p.unary = grpcMiddleware.ChainUnaryServer(unary...)
+	}
+	if len(stream) > 0 {
+		p.stream = grpcMiddleware.ChainStreamServer(stream...)
+	}
+}
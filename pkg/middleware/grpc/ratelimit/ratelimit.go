@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit implements the middleware.grpc.ratelimit component, the
+// gRPC counterpart of middleware.http.ratelimit.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	contribmiddleware "github.com/dapr/components-contrib/middleware"
+	"github.com/dapr/dapr/pkg/middleware"
+	kitmd "github.com/dapr/kit/metadata"
+)
+
+const (
+	maxRequestsPerSecondKey = "maxRequestsPerSecond"
+
+	defaultMaxRequestsPerSecond = 100
+
+	// maxClients is the number of clients whose limiter is kept, the least
+	// recently seen being evicted first.
+	maxClients = 10_000
+)
+
+type metadata struct {
+	MaxRequestsPerSecond float64 `json:"maxRequestsPerSecond"`
+}
+
+// New returns a gRPC middleware which limits the number of calls, and of
+// streams opened, per second by remote IP. Calls over the limit fail with
+// ResourceExhausted.
+func New(meta contribmiddleware.Metadata) (middleware.GRPC, error) {
+	md := metadata{
+		MaxRequestsPerSecond: defaultMaxRequestsPerSecond,
+	}
+	if err := kitmd.DecodeMetadata(meta.Properties, &md); err != nil {
+		return middleware.GRPC{}, err
+	}
+	if md.MaxRequestsPerSecond <= 0 {
+		return middleware.GRPC{}, fmt.Errorf("metadata property %s must be a positive value", maxRequestsPerSecondKey)
+	}
+
+	clients, err := lru.New[string, *rate.Limiter](maxClients)
+	if err != nil {
+		return middleware.GRPC{}, err
+	}
+	l := &limiter{
+		limit:   rate.Limit(md.MaxRequestsPerSecond),
+		clients: clients,
+	}
+
+	return middleware.GRPC{
+		Unary: func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if err := l.allow(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		},
+		Stream: func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := l.allow(ss.Context()); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		},
+	}, nil
+}
+
+type limiter struct {
+	limit   rate.Limit
+	lock    sync.Mutex
+	clients *lru.Cache[string, *rate.Limiter]
+}
+
+func (l *limiter) allow(ctx context.Context) error {
+	if !l.get(remoteIP(ctx)).Allow() {
+		return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+	return nil
+}
+
+func (l *limiter) get(ip string) *rate.Limiter {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	lim, ok := l.clients.Get(ip)
+	if !ok {
+		lim = rate.NewLimiter(l.limit, max(1, int(l.limit)))
+		l.clients.Add(ip, lim)
+	}
+	return lim
+}
+
+// remoteIP returns the IP of the peer of the call, or an empty string if it
+// is unknown, in which case all such calls share the same limit.
+func remoteIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	contribmiddleware "github.com/dapr/components-contrib/middleware"
+	contribmetadata "github.com/dapr/components-contrib/metadata"
+)
+
+func withPeer(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234},
+	})
+}
+
+func TestNew(t *testing.T) {
+	newMetadata := func(props map[string]string) contribmiddleware.Metadata {
+		return contribmiddleware.Metadata{Base: contribmetadata.Base{Properties: props}}
+	}
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		_, err := New(newMetadata(map[string]string{maxRequestsPerSecondKey: "0"}))
+		require.ErrorContains(t, err, "must be a positive value")
+		_, err = New(newMetadata(map[string]string{maxRequestsPerSecondKey: "foo"}))
+		require.Error(t, err)
+	})
+
+	t.Run("limits calls per remote IP", func(t *testing.T) {
+		mw, err := New(newMetadata(map[string]string{maxRequestsPerSecondKey: "2"}))
+		require.NoError(t, err)
+
+		invoke := func(ctx context.Context) error {
+			_, err := mw.Unary(ctx, "req", &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+				return "res", nil
+			})
+			return err
+		}
+
+		require.NoError(t, invoke(withPeer("10.0.0.1")))
+		require.NoError(t, invoke(withPeer("10.0.0.1")))
+		err = invoke(withPeer("10.0.0.1"))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		require.NoError(t, invoke(withPeer("10.0.0.2")))
+	})
+}
@@ -14,13 +14,130 @@ limitations under the License.
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // HTTP is a middleware for the middleware.http Component type.
 type HTTP func(http.Handler) http.Handler
 
+// GRPC is a middleware for the middleware.grpc Component type.
+// Stream may be nil if the middleware only intercepts unary calls.
+type GRPC struct {
+	Unary  grpc.UnaryServerInterceptor
+	Stream grpc.StreamServerInterceptor
+}
+
 // Middleware is a generic Middleware type.
 type Middleware interface {
-	HTTP
+	HTTP | GRPC
+}
+
+// UnaryClientInterceptor returns the unary interceptor of the middleware as a
+// client interceptor, to apply it to calls made to the app.
+// The outgoing metadata of the call is presented to the middleware as incoming
+// metadata, and the metadata set by the middleware is sent to the app, so that
+// the same middleware can be used on both sides.
+func (g GRPC) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if g.Unary == nil {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		md, _ := metadata.FromOutgoingContext(ctx)
+		ctx = metadata.NewIncomingContext(ctx, md)
+		_, err := g.Unary(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			ctx = metadata.NewOutgoingContext(ctx, md)
+			return reply, invoker(ctx, method, req, reply, cc, opts...)
+		})
+		return err
+	}
+}
+
+// StreamClientInterceptor returns the stream interceptor of the middleware as
+// a client interceptor, to apply it to streams opened to the app.
+// As for unary calls, the outgoing metadata of the stream is presented to the
+// middleware as incoming metadata. The middleware can reject the stream or
+// change its metadata, but it does not see the messages of the stream, which
+// are exchanged with the app directly. The middleware returns once the stream
+// has been opened, so it does not observe the end of the stream.
+func (g GRPC) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if g.Stream == nil {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		md, _ := metadata.FromOutgoingContext(ctx)
+		ctx, cancel := context.WithCancel(ctx)
+		ss := &clientServerStream{ctx: metadata.NewIncomingContext(ctx, md)}
+		info := &grpc.StreamServerInfo{
+			FullMethod:     method,
+			IsClientStream: desc.ClientStreams,
+			IsServerStream: desc.ServerStreams,
+		}
+
+		// The stream is opened at most once, even if the middleware calls the
+		// handler more than once.
+		var (
+			once      sync.Once
+			cs        grpc.ClientStream
+			streamErr error
+		)
+		err := g.Stream(nil, ss, info, func(_ any, ss grpc.ServerStream) error {
+			once.Do(func() {
+				md, _ := metadata.FromIncomingContext(ss.Context())
+				cs, streamErr = streamer(metadata.NewOutgoingContext(ss.Context(), md), desc, cc, method, opts...)
+			})
+			return streamErr
+		})
+		if err == nil && cs == nil {
+			err = errors.New("gRPC middleware did not open the stream")
+		}
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		return &cancelClientStream{ClientStream: cs, cancel: cancel}, nil
+	}
+}
+
+// cancelClientStream releases the context of a stream opened to the app once
+// the stream has ended.
+type cancelClientStream struct {
+	grpc.ClientStream
+	cancel context.CancelFunc
+}
+
+func (s *cancelClientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.cancel()
+	}
+	return err
+}
+
+// clientServerStream presents a stream opened to the app as a server stream to
+// a middleware. Messages cannot be exchanged through it.
+type clientServerStream struct {
+	ctx context.Context
+}
+
+func (s *clientServerStream) SetHeader(metadata.MD) error  { return nil }
+func (s *clientServerStream) SendHeader(metadata.MD) error { return nil }
+func (s *clientServerStream) SetTrailer(metadata.MD)       {}
+func (s *clientServerStream) Context() context.Context     { return s.ctx }
+
+func (s *clientServerStream) SendMsg(any) error {
+	return errors.New("messages cannot be sent by gRPC middlewares of the app channel")
+}
+
+func (s *clientServerStream) RecvMsg(any) error {
+	return errors.New("messages cannot be received by gRPC middlewares of the app channel")
 }
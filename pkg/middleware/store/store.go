@@ -73,7 +73,8 @@ func (s *Store[T]) Get(m Metadata) (T, bool) {
 	}
 	l, ok := s.loaded[m.Name]
 	if !ok || m.Type != l.Type || version != l.Version {
-		return nil, false
+		var zero T
+		return zero, false
 	}
 	return l.Middleware, ok
 }
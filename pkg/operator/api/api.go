@@ -27,9 +27,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	configurationapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
 	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/operator/api/informer"
+	"github.com/dapr/dapr/pkg/operator/api/status"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/pkg/security"
 	"github.com/dapr/kit/concurrency"
@@ -69,6 +72,9 @@ type apiServer struct {
 	listenAddress string
 
 	compInformer informer.Interface[componentsapi.Component]
+	confInformer informer.Interface[configurationapi.Configuration]
+	resInformer  informer.Interface[resiliencyapi.Resiliency]
+	status       *status.Status
 
 	endpointLock              sync.Mutex
 	allEndpointsUpdateChan    map[string]chan *httpendpointsapi.HTTPEndpoint
@@ -86,6 +92,15 @@ func NewAPIServer(opts Options) Server {
 		compInformer: informer.New[componentsapi.Component](informer.Options{
 			Cache: opts.Cache,
 		}),
+		confInformer: informer.New[configurationapi.Configuration](informer.Options{
+			Cache: opts.Cache,
+		}),
+		resInformer: informer.New[resiliencyapi.Resiliency](informer.Options{
+			Cache: opts.Cache,
+		}),
+		status: status.New(status.Options{
+			Client: opts.Client,
+		}),
 		sec:                       opts.Security,
 		port:                      strconv.Itoa(opts.Port),
 		listenAddress:             opts.ListenAddress,
@@ -119,6 +134,9 @@ func (a *apiServer) Run(ctx context.Context) error {
 
 	return concurrency.NewRunnerManager(
 		a.compInformer.Run,
+		a.confInformer.Run,
+		a.resInformer.Run,
+		a.status.Run,
 		func(ctx context.Context) error {
 			if err := s.Serve(lis); err != nil {
 				return fmt.Errorf("gRPC server error: %w", err)
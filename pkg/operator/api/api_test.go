@@ -35,6 +35,7 @@ import (
 
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	configurationapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
 	httpendpointapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	subscriptionsapiV2alpha1 "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
@@ -193,6 +194,36 @@ func TestProcessComponentSecrets(t *testing.T) {
 	})
 }
 
+type mockConfigurationUpdateServer struct {
+	grpc.ServerStream
+	events []*operatorv1pb.ConfigurationUpdateEvent
+	ctx    context.Context
+}
+
+func (m *mockConfigurationUpdateServer) Send(event *operatorv1pb.ConfigurationUpdateEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *mockConfigurationUpdateServer) Context() context.Context {
+	return m.ctx
+}
+
+type mockResiliencyUpdateServer struct {
+	grpc.ServerStream
+	events []*operatorv1pb.ResiliencyUpdateEvent
+	ctx    context.Context
+}
+
+func (m *mockResiliencyUpdateServer) Send(event *operatorv1pb.ResiliencyUpdateEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *mockResiliencyUpdateServer) Context() context.Context {
+	return m.ctx
+}
+
 func TestComponentUpdate(t *testing.T) {
 	appID := spiffeid.RequireFromString("spiffe://example.org/ns/ns1/app1")
 	serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-operator")
@@ -314,6 +345,118 @@ func TestComponentUpdate(t *testing.T) {
 	})
 }
 
+func TestConfigurationUpdate(t *testing.T) {
+	appID := spiffeid.RequireFromString("spiffe://example.org/ns/ns1/app1")
+	serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-operator")
+	pki := test.GenPKI(t, test.PKIOptions{
+		LeafID:   serverID,
+		ClientID: appID,
+	})
+
+	t.Run("expect error if requesting for different namespace", func(t *testing.T) {
+		mockSidecar := &mockConfigurationUpdateServer{ctx: pki.ClientGRPCCtx(t)}
+		api := NewAPIServer(Options{Client: fake.NewClientBuilder().Build()}).(*apiServer)
+
+		err := api.ConfigurationUpdate(&operatorv1pb.ConfigurationUpdateRequest{
+			Name:      "appconfig",
+			Namespace: "ns2",
+		}, mockSidecar)
+		require.Error(t, err)
+		status, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.PermissionDenied, status.Code())
+		assert.Empty(t, mockSidecar.events)
+	})
+
+	t.Run("sidecar is only updated with the configuration it requested", func(t *testing.T) {
+		fakeInformer := informerfake.New[configurationapi.Configuration]().
+			WithWatchUpdates(func(context.Context, string) (<-chan *informer.Event[configurationapi.Configuration], error) {
+				ch := make(chan *informer.Event[configurationapi.Configuration])
+				go func() {
+					for _, name := range []string{"other", "appconfig"} {
+						ch <- &informer.Event[configurationapi.Configuration]{
+							Manifest: configurationapi.Configuration{
+								ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns1"},
+							},
+							Type: operatorv1pb.ResourceEventType_UPDATED,
+						}
+					}
+					close(ch)
+				}()
+				return ch, nil
+			})
+
+		mockSidecar := &mockConfigurationUpdateServer{ctx: pki.ClientGRPCCtx(t)}
+		api := NewAPIServer(Options{Client: fake.NewClientBuilder().Build()}).(*apiServer)
+		api.confInformer = fakeInformer
+
+		require.NoError(t, api.ConfigurationUpdate(&operatorv1pb.ConfigurationUpdateRequest{
+			Name:      "appconfig",
+			Namespace: "ns1",
+		}, mockSidecar))
+
+		require.Len(t, mockSidecar.events, 1)
+		assert.Equal(t, operatorv1pb.ResourceEventType_UPDATED, mockSidecar.events[0].GetType())
+		var conf configurationapi.Configuration
+		require.NoError(t, json.Unmarshal(mockSidecar.events[0].GetConfiguration(), &conf))
+		assert.Equal(t, "appconfig", conf.Name)
+	})
+}
+
+func TestResiliencyUpdate(t *testing.T) {
+	appID := spiffeid.RequireFromString("spiffe://example.org/ns/ns1/app1")
+	serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-operator")
+	pki := test.GenPKI(t, test.PKIOptions{
+		LeafID:   serverID,
+		ClientID: appID,
+	})
+
+	t.Run("expect error if requesting for different namespace", func(t *testing.T) {
+		mockSidecar := &mockResiliencyUpdateServer{ctx: pki.ClientGRPCCtx(t)}
+		api := NewAPIServer(Options{Client: fake.NewClientBuilder().Build()}).(*apiServer)
+
+		err := api.ResiliencyUpdate(&operatorv1pb.ResiliencyUpdateRequest{
+			Namespace: "ns2",
+		}, mockSidecar)
+		require.Error(t, err)
+		status, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.PermissionDenied, status.Code())
+		assert.Empty(t, mockSidecar.events)
+	})
+
+	t.Run("sidecar is updated with resiliency events", func(t *testing.T) {
+		fakeInformer := informerfake.New[resiliencyapi.Resiliency]().
+			WithWatchUpdates(func(context.Context, string) (<-chan *informer.Event[resiliencyapi.Resiliency], error) {
+				ch := make(chan *informer.Event[resiliencyapi.Resiliency])
+				go func() {
+					ch <- &informer.Event[resiliencyapi.Resiliency]{
+						Manifest: resiliencyapi.Resiliency{
+							ObjectMeta: metav1.ObjectMeta{Name: "res1", Namespace: "ns1"},
+						},
+						Type: operatorv1pb.ResourceEventType_DELETED,
+					}
+					close(ch)
+				}()
+				return ch, nil
+			})
+
+		mockSidecar := &mockResiliencyUpdateServer{ctx: pki.ClientGRPCCtx(t)}
+		api := NewAPIServer(Options{Client: fake.NewClientBuilder().Build()}).(*apiServer)
+		api.resInformer = fakeInformer
+
+		require.NoError(t, api.ResiliencyUpdate(&operatorv1pb.ResiliencyUpdateRequest{
+			Namespace: "ns1",
+		}, mockSidecar))
+
+		require.Len(t, mockSidecar.events, 1)
+		assert.Equal(t, operatorv1pb.ResourceEventType_DELETED, mockSidecar.events[0].GetType())
+		var res resiliencyapi.Resiliency
+		require.NoError(t, json.Unmarshal(mockSidecar.events[0].GetResiliency(), &res))
+		assert.Equal(t, "res1", res.Name)
+	})
+}
+
 func TestHTTPEndpointUpdate(t *testing.T) {
 	appID := spiffeid.RequireFromString("spiffe://example.org/ns/ns1/app1")
 	serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-operator")
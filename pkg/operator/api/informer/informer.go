@@ -16,6 +16,7 @@ package informer
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -149,6 +150,13 @@ func (i *informer[T]) handleEvent(ctx context.Context, oldObj, newObj any, event
 			return
 		}
 
+		// Updates which only change the metadata or status of the resource,
+		// such as the status aggregated from the sidecars, are not sent.
+		if eventType == operatorv1.ResourceEventType_UPDATED &&
+			reflect.DeepEqual(oldT.EmptyMetaDeepCopy(), newT.EmptyMetaDeepCopy()) {
+			return
+		}
+
 		event.oldObj = &oldT
 	}
 
@@ -51,6 +51,26 @@ func Test_WatchUpdates(t *testing.T) {
 		assert.Nil(t, appCh)
 	})
 
+	t.Run("status only updates should not be batched", func(t *testing.T) {
+		i := New[compapi.Component](Options{}).(*informer[compapi.Component])
+		t.Cleanup(func() { close(i.closeCh) })
+
+		i.handleEvent(t.Context(),
+			&compapi.Component{
+				ObjectMeta: metav1.ObjectMeta{Name: "comp1", Namespace: "ns1", ResourceVersion: "1"},
+				Spec:       compapi.ComponentSpec{Type: "bindings.redis"},
+			},
+			&compapi.Component{
+				ObjectMeta: metav1.ObjectMeta{Name: "comp1", Namespace: "ns1", ResourceVersion: "2"},
+				Spec:       compapi.ComponentSpec{Type: "bindings.redis"},
+				Status:     common.ResourceStatus{ReadyCount: 1},
+			},
+			operator.ResourceEventType_UPDATED,
+		)
+
+		assert.Equal(t, 0, int(i.batchID.Load()))
+	})
+
 	t.Run("should receive app events on batch events in order", func(t *testing.T) {
 		appID := spiffeid.RequireFromString("spiffe://example.org/ns/ns1/app1")
 		serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-operator")
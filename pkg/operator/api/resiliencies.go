@@ -74,3 +74,42 @@ func (a *apiServer) ListResiliency(ctx context.Context, in *operatorv1pb.ListRes
 
 	return resp, nil
 }
+
+// ResiliencyUpdate updates Dapr sidecars whenever a resiliency configuration
+// in scope of the sidecar is modified.
+func (a *apiServer) ResiliencyUpdate(in *operatorv1pb.ResiliencyUpdateRequest, srv operatorv1pb.Operator_ResiliencyUpdateServer) error { //nolint:nosnakecase
+	log.Info("sidecar connected for resiliency updates")
+
+	ch, err := a.resInformer.WatchUpdates(srv.Context(), in.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+
+			b, err := json.Marshal(&event.Manifest)
+			if err != nil {
+				log.Warnf("error serializing resiliency %s from pod %s/%s: %s", event.Manifest.Name, in.GetNamespace(), in.GetPodName(), err)
+				continue
+			}
+
+			err = srv.Send(&operatorv1pb.ResiliencyUpdateEvent{
+				Resiliency: b,
+				Type:       event.Type,
+			})
+			if err != nil {
+				log.Warnf("error updating sidecar with resiliency %s from pod %s/%s: %s", event.Manifest.Name, in.GetNamespace(), in.GetPodName(), err)
+				return err
+			}
+
+			log.Debugf("updated sidecar with resiliency %s %s from pod %s/%s", event.Type, event.Manifest.Name, in.GetNamespace(), in.GetPodName())
+		}
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dapr/dapr/pkg/operator/api/authz"
+	"github.com/dapr/dapr/pkg/operator/api/status"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+)
+
+// ReportResourceStatus receives the results of loading resources from a
+// sidecar, which are aggregated into the status of the resources. The results
+// of the sidecar are removed from the status when the stream ends.
+func (a *apiServer) ReportResourceStatus(srv operatorv1pb.Operator_ReportResourceStatusServer) error { //nolint:nosnakecase
+	var (
+		sidecar        *status.Sidecar
+		namespace, pod string
+	)
+	defer func() {
+		if sidecar != nil {
+			sidecar.Close()
+		}
+	}()
+
+	for {
+		req, err := srv.Recv()
+		if errors.Is(err, io.EOF) {
+			return srv.SendAndClose(new(emptypb.Empty))
+		}
+		if err != nil {
+			return err
+		}
+
+		if sidecar == nil {
+			id, err := authz.Request(srv.Context(), req.GetNamespace())
+			if err != nil {
+				return err
+			}
+			if len(req.GetPodName()) == 0 {
+				return grpcstatus.Error(codes.InvalidArgument, "pod name is required")
+			}
+
+			namespace, pod = req.GetNamespace(), req.GetPodName()
+			sidecar = a.status.Connect(namespace, pod, id.AppID())
+			log.Debugf("Sidecar %s/%s connected to report resource status", namespace, pod)
+		} else if req.GetNamespace() != namespace || req.GetPodName() != pod {
+			return grpcstatus.Error(codes.InvalidArgument, "namespace and pod name must not change during the stream")
+		}
+
+		if req.GetResult() != nil {
+			sidecar.Report(req.GetResult())
+		}
+	}
+}
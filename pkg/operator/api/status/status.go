@@ -0,0 +1,449 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/dapr/dapr/pkg/apis/common"
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.operator.api.status")
+
+const (
+	// refreshInterval is the interval at which the results of the connected
+	// sidecars are refreshed in the status of the resources.
+	refreshInterval = time.Minute
+
+	// staleTimeout is the age after which results which are no longer
+	// refreshed are removed from the status of a resource. This happens when
+	// the operator replica a sidecar was connected to went away without
+	// removing its results.
+	staleTimeout = 5 * time.Minute
+
+	// retryInterval is the interval after which failed status updates are
+	// retried.
+	retryInterval = 5 * time.Second
+)
+
+type Options struct {
+	Client client.Client
+	Clock  clock.WithTicker
+}
+
+// Status aggregates the results of loading resources reported by the sidecars
+// connected to this operator replica into the status subresource of the
+// resources. Each replica only adds, refreshes and removes the results of its
+// own sidecars, and leaves the results of the sidecars connected to other
+// replicas untouched, until they become stale.
+type Status struct {
+	client client.Client
+	clock  clock.WithTicker
+
+	lock     sync.Mutex
+	nextConn uint64
+	sidecars map[types.NamespacedName]*sidecar
+	// removed are the pods whose results are to be removed from the status of
+	// each resource.
+	removed map[resource]map[string]struct{}
+	// lastErrors are the latest errors reported for each resource which are
+	// yet to be written to its status.
+	lastErrors map[resource]lastError
+	dirty      map[resource]struct{}
+	notifyCh   chan struct{}
+}
+
+type resource struct {
+	typ       operatorv1pb.ResourceType
+	namespace string
+	name      string
+}
+
+type sidecar struct {
+	conn    uint64
+	appID   string
+	results map[resource]result
+}
+
+type result struct {
+	ready bool
+	err   string
+}
+
+type lastError struct {
+	err  string
+	time time.Time
+}
+
+// Sidecar is the connection of a single sidecar reporting its results.
+type Sidecar struct {
+	s    *Status
+	pod  types.NamespacedName
+	conn uint64
+}
+
+func New(opts Options) *Status {
+	cl := opts.Clock
+	if cl == nil {
+		cl = clock.RealClock{}
+	}
+
+	return &Status{
+		client:     opts.Client,
+		clock:      cl,
+		sidecars:   make(map[types.NamespacedName]*sidecar),
+		removed:    make(map[resource]map[string]struct{}),
+		lastErrors: make(map[resource]lastError),
+		dirty:      make(map[resource]struct{}),
+		notifyCh:   make(chan struct{}, 1),
+	}
+}
+
+// Connect registers a sidecar connection. A new connection from the same pod
+// supersedes the previous one, whose results are discarded.
+func (s *Status) Connect(namespace, podName, appID string) *Sidecar {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	pod := types.NamespacedName{Namespace: namespace, Name: podName}
+	if prev, ok := s.sidecars[pod]; ok {
+		s.removeResultsLocked(pod, prev)
+	}
+
+	s.nextConn++
+	s.sidecars[pod] = &sidecar{
+		conn:    s.nextConn,
+		appID:   appID,
+		results: make(map[resource]result),
+	}
+
+	return &Sidecar{
+		s:    s,
+		pod:  pod,
+		conn: s.nextConn,
+	}
+}
+
+// Report records the result of the sidecar loading, or closing, a resource.
+func (c *Sidecar) Report(res *operatorv1pb.ResourceResult) {
+	if _, _, ok := newObject(res.GetResourceType()); !ok || len(res.GetName()) == 0 {
+		return
+	}
+
+	r := resource{
+		typ:       res.GetResourceType(),
+		namespace: c.pod.Namespace,
+		name:      res.GetName(),
+	}
+
+	c.s.lock.Lock()
+	defer c.s.lock.Unlock()
+
+	sc, ok := c.s.sidecars[c.pod]
+	if !ok || sc.conn != c.conn {
+		return
+	}
+
+	if res.GetEventType() == operatorv1pb.EventType_EVENT_CLOSE {
+		if _, ok := sc.results[r]; !ok {
+			return
+		}
+		delete(sc.results, r)
+		c.s.markRemovedLocked(r, c.pod.Name)
+		return
+	}
+
+	rr := result{ready: res.GetCondition() == operatorv1pb.ResourceConditionStatus_STATUS_SUCCESS}
+	if !rr.ready {
+		rr.err = res.GetMessage()
+		if len(rr.err) == 0 {
+			rr.err = res.GetReason()
+		}
+	}
+
+	// Sidecars periodically report their results again, which are only
+	// written if they changed.
+	if prev, ok := sc.results[r]; ok && prev == rr {
+		return
+	}
+	if !rr.ready {
+		c.s.lastErrors[r] = lastError{err: rr.err, time: c.s.clock.Now()}
+	}
+	sc.results[r] = rr
+	c.s.markDirtyLocked(r)
+}
+
+// Close removes the results of the sidecar from the status of the resources,
+// unless the connection has been superseded.
+func (c *Sidecar) Close() {
+	c.s.lock.Lock()
+	defer c.s.lock.Unlock()
+
+	sc, ok := c.s.sidecars[c.pod]
+	if !ok || sc.conn != c.conn {
+		return
+	}
+
+	delete(c.s.sidecars, c.pod)
+	c.s.removeResultsLocked(c.pod, sc)
+}
+
+func (s *Status) removeResultsLocked(pod types.NamespacedName, sc *sidecar) {
+	for r := range sc.results {
+		s.markRemovedLocked(r, pod.Name)
+	}
+}
+
+func (s *Status) markRemovedLocked(r resource, podName string) {
+	if _, ok := s.removed[r]; !ok {
+		s.removed[r] = make(map[string]struct{})
+	}
+	s.removed[r][podName] = struct{}{}
+	s.markDirtyLocked(r)
+}
+
+func (s *Status) markDirtyLocked(r resource) {
+	s.dirty[r] = struct{}{}
+	select {
+	case s.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// Run writes the status of the resources with changed results, and refreshes
+// the results of the connected sidecars, until the context is cancelled.
+func (s *Status) Run(ctx context.Context) error {
+	ticker := s.clock.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	var retryCh <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.notifyCh:
+		case <-retryCh:
+			retryCh = nil
+		case <-ticker.C():
+			s.lock.Lock()
+			for _, sc := range s.sidecars {
+				for r := range sc.results {
+					s.dirty[r] = struct{}{}
+				}
+			}
+			s.lock.Unlock()
+		}
+
+		s.lock.Lock()
+		dirty := s.dirty
+		s.dirty = make(map[resource]struct{})
+		s.lock.Unlock()
+
+		var failed bool
+		for r := range dirty {
+			if err := s.write(ctx, r); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				log.Warnf("Failed to update status of %s %s/%s: %s", r.typ, r.namespace, r.name, err)
+				s.lock.Lock()
+				s.dirty[r] = struct{}{}
+				s.lock.Unlock()
+				failed = true
+			}
+		}
+
+		if failed && retryCh == nil {
+			retryCh = s.clock.After(retryInterval)
+		}
+	}
+}
+
+// write updates the status of the resource with the results of the connected
+// sidecars, retrying on conflicts with other operator replicas.
+func (s *Status) write(ctx context.Context, r resource) error {
+	var (
+		removed map[string]struct{}
+		lastErr *lastError
+	)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj, status, _ := newObject(r.typ)
+		err := s.client.Get(ctx, types.NamespacedName{Namespace: r.namespace, Name: r.name}, obj)
+		if err != nil {
+			return err
+		}
+
+		s.lock.Lock()
+		var updated common.ResourceStatus
+		updated, removed, lastErr = s.mergeLocked(r, *status)
+		s.lock.Unlock()
+
+		if equality.Semantic.DeepEqual(*status, updated) {
+			return nil
+		}
+
+		*status = updated
+		return s.client.Status().Update(ctx, obj)
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	// Only forget the removals and error which were written, as more may have
+	// been reported in the meantime. If the resource was deleted, there is
+	// nothing left to write them to.
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if apierrors.IsNotFound(err) {
+		delete(s.removed, r)
+		delete(s.lastErrors, r)
+		return nil
+	}
+	for pod := range removed {
+		delete(s.removed[r], pod)
+	}
+	if len(s.removed[r]) == 0 {
+		delete(s.removed, r)
+	}
+	if le, ok := s.lastErrors[r]; ok && lastErr != nil && le == *lastErr {
+		delete(s.lastErrors, r)
+	}
+
+	return nil
+}
+
+// mergeLocked returns the status of the resource with the results of the
+// connected sidecars merged into it, along with the removed pods and last
+// error that were merged.
+func (s *Status) mergeLocked(r resource, status common.ResourceStatus) (common.ResourceStatus, map[string]struct{}, *lastError) {
+	now := s.clock.Now()
+
+	type liveResult struct {
+		appID string
+		result
+	}
+	live := make(map[string]liveResult)
+	for pod, sc := range s.sidecars {
+		if pod.Namespace != r.namespace {
+			continue
+		}
+		if res, ok := sc.results[r]; ok {
+			live[pod.Name] = liveResult{appID: sc.appID, result: res}
+		}
+	}
+
+	removed := make(map[string]struct{}, len(s.removed[r]))
+	for pod := range s.removed[r] {
+		removed[pod] = struct{}{}
+	}
+
+	existing := make(map[string]common.SidecarResourceStatus)
+	sidecars := make([]common.SidecarResourceStatus, 0, len(status.Sidecars)+len(live))
+	for _, sc := range status.Sidecars {
+		if _, ok := live[sc.PodName]; ok {
+			existing[sc.PodName] = sc
+			continue
+		}
+		if _, ok := removed[sc.PodName]; ok {
+			continue
+		}
+		if now.Sub(sc.LastReportTime.Time) > staleTimeout {
+			continue
+		}
+		sidecars = append(sidecars, sc)
+	}
+
+	for pod, res := range live {
+		sc := common.SidecarResourceStatus{
+			PodName:        pod,
+			AppID:          res.appID,
+			Ready:          res.ready,
+			Error:          res.err,
+			LastReportTime: metav1.NewTime(now),
+		}
+		// Keep the report time of unchanged results until they are due to be
+		// refreshed, so unchanged results do not cause an update.
+		if prev, ok := existing[pod]; ok && prev.AppID == sc.AppID && prev.Ready == sc.Ready &&
+			prev.Error == sc.Error && now.Sub(prev.LastReportTime.Time) < refreshInterval {
+			sc.LastReportTime = prev.LastReportTime
+		}
+		sidecars = append(sidecars, sc)
+	}
+
+	slices.SortFunc(sidecars, func(a, b common.SidecarResourceStatus) int {
+		return strings.Compare(a.PodName, b.PodName)
+	})
+
+	updated := common.ResourceStatus{
+		LastError:     status.LastError,
+		LastErrorTime: status.LastErrorTime,
+	}
+	if len(sidecars) > 0 {
+		updated.Sidecars = sidecars
+	}
+
+	var lastErr *lastError
+	if le, ok := s.lastErrors[r]; ok {
+		lastErr = &le
+		updated.LastError = le.err
+		t := metav1.NewTime(le.time)
+		updated.LastErrorTime = &t
+	}
+
+	for _, sc := range sidecars {
+		if sc.Ready {
+			updated.ReadyCount++
+		} else if !slices.Contains(updated.FailingAppIDs, sc.AppID) {
+			updated.FailingAppIDs = append(updated.FailingAppIDs, sc.AppID)
+		}
+	}
+	slices.Sort(updated.FailingAppIDs)
+
+	return updated, removed, lastErr
+}
+
+// newObject returns an empty resource of the given type, along with its status.
+func newObject(typ operatorv1pb.ResourceType) (client.Object, *common.ResourceStatus, bool) {
+	switch typ {
+	case operatorv1pb.ResourceType_RESOURCE_COMPONENT:
+		obj := new(componentsapi.Component)
+		return obj, &obj.Status, true
+	case operatorv1pb.ResourceType_RESOURCE_SUBSCRIPTION:
+		obj := new(subapi.Subscription)
+		return obj, &obj.Status, true
+	case operatorv1pb.ResourceType_RESOURCE_HTTPENDPOINT:
+		obj := new(httpendpointsapi.HTTPEndpoint)
+		return obj, &obj.Status, true
+	default:
+		return nil, nil, false
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/dapr/dapr/pkg/apis/common"
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+)
+
+func newTestStatus(t *testing.T, objs ...client.Object) (*Status, client.Client, *clocktesting.FakeClock) {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, componentsapi.AddToScheme(s))
+	require.NoError(t, subapi.AddToScheme(s))
+	require.NoError(t, httpendpointsapi.AddToScheme(s))
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(new(componentsapi.Component), new(subapi.Subscription), new(httpendpointsapi.HTTPEndpoint)).
+		Build()
+	clock := clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+
+	return New(Options{Client: cl, Clock: clock}), cl, clock
+}
+
+func componentResult(name string, err string) *operatorv1pb.ResourceResult {
+	res := &operatorv1pb.ResourceResult{
+		ResourceType: operatorv1pb.ResourceType_RESOURCE_COMPONENT,
+		EventType:    operatorv1pb.EventType_EVENT_INIT,
+		Name:         name,
+		Condition:    operatorv1pb.ResourceConditionStatus_STATUS_SUCCESS,
+	}
+	if len(err) > 0 {
+		res.Condition = operatorv1pb.ResourceConditionStatus_STATUS_FAILURE
+		res.Message = &err
+	}
+	return res
+}
+
+func getComponentStatus(t *testing.T, cl client.Client) common.ResourceStatus {
+	t.Helper()
+
+	var comp componentsapi.Component
+	require.NoError(t, cl.Get(t.Context(), types.NamespacedName{Namespace: "default", Name: "statestore"}, &comp))
+	return comp.Status
+}
+
+func TestStatus(t *testing.T) {
+	r := resource{
+		typ:       operatorv1pb.ResourceType_RESOURCE_COMPONENT,
+		namespace: "default",
+		name:      "statestore",
+	}
+
+	t.Run("aggregates the results of the connected sidecars", func(t *testing.T) {
+		s, cl, clock := newTestStatus(t, &componentsapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "statestore", Namespace: "default"},
+		})
+
+		s.Connect("default", "app1-pod", "app1").Report(componentResult("statestore", ""))
+		s.Connect("default", "app2-pod", "app2").Report(componentResult("statestore", "connection refused"))
+		s.Connect("other", "app3-pod", "app3").Report(componentResult("statestore", ""))
+
+		require.NoError(t, s.write(t.Context(), r))
+
+		now := metav1.NewTime(clock.Now())
+		assert.Equal(t, common.ResourceStatus{
+			ReadyCount:    1,
+			FailingAppIDs: []string{"app2"},
+			LastError:     "connection refused",
+			LastErrorTime: &now,
+			Sidecars: []common.SidecarResourceStatus{
+				{PodName: "app1-pod", AppID: "app1", Ready: true, LastReportTime: now},
+				{PodName: "app2-pod", AppID: "app2", Ready: false, Error: "connection refused", LastReportTime: now},
+			},
+		}, getComponentStatus(t, cl))
+		assert.Empty(t, s.lastErrors)
+	})
+
+	t.Run("keeps the fresh results of other replicas and prunes stale ones", func(t *testing.T) {
+		s, cl, clock := newTestStatus(t)
+		now := metav1.NewTime(clock.Now())
+		require.NoError(t, cl.Create(t.Context(), &componentsapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "statestore", Namespace: "default"},
+		}))
+		var comp componentsapi.Component
+		require.NoError(t, cl.Get(t.Context(), types.NamespacedName{Namespace: "default", Name: "statestore"}, &comp))
+		comp.Status.Sidecars = []common.SidecarResourceStatus{
+			{PodName: "fresh-pod", AppID: "fresh", Ready: true, LastReportTime: metav1.NewTime(clock.Now().Add(-time.Minute))},
+			{PodName: "stale-pod", AppID: "stale", Ready: true, LastReportTime: metav1.NewTime(clock.Now().Add(-time.Hour))},
+		}
+		require.NoError(t, cl.Status().Update(t.Context(), &comp))
+
+		s.Connect("default", "app1-pod", "app1").Report(componentResult("statestore", ""))
+		require.NoError(t, s.write(t.Context(), r))
+
+		assert.Equal(t, common.ResourceStatus{
+			ReadyCount: 2,
+			Sidecars: []common.SidecarResourceStatus{
+				{PodName: "app1-pod", AppID: "app1", Ready: true, LastReportTime: now},
+				{PodName: "fresh-pod", AppID: "fresh", Ready: true, LastReportTime: metav1.NewTime(clock.Now().Add(-time.Minute))},
+			},
+		}, getComponentStatus(t, cl))
+	})
+
+	t.Run("removes the results of closed and superseded connections", func(t *testing.T) {
+		s, cl, _ := newTestStatus(t, &componentsapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "statestore", Namespace: "default"},
+		})
+
+		old := s.Connect("default", "app1-pod", "app1")
+		old.Report(componentResult("statestore", ""))
+		app2 := s.Connect("default", "app2-pod", "app2")
+		app2.Report(componentResult("statestore", ""))
+		require.NoError(t, s.write(t.Context(), r))
+		assert.Equal(t, int32(2), getComponentStatus(t, cl).ReadyCount)
+
+		// The reconnected sidecar failed to load the component, and the stale
+		// stream of its previous connection closing does not remove it.
+		s.Connect("default", "app1-pod", "app1").Report(componentResult("statestore", "boom"))
+		old.Report(componentResult("statestore", ""))
+		old.Close()
+		app2.Close()
+		require.NoError(t, s.write(t.Context(), r))
+
+		status := getComponentStatus(t, cl)
+		assert.Equal(t, int32(0), status.ReadyCount)
+		assert.Equal(t, []string{"app1"}, status.FailingAppIDs)
+		require.Len(t, status.Sidecars, 1)
+		assert.Equal(t, "app1-pod", status.Sidecars[0].PodName)
+		assert.Empty(t, s.removed)
+	})
+
+	t.Run("closing a resource removes its result", func(t *testing.T) {
+		s, cl, _ := newTestStatus(t, &componentsapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "statestore", Namespace: "default"},
+		})
+
+		sc := s.Connect("default", "app1-pod", "app1")
+		sc.Report(componentResult("statestore", ""))
+		require.NoError(t, s.write(t.Context(), r))
+
+		res := componentResult("statestore", "")
+		res.EventType = operatorv1pb.EventType_EVENT_CLOSE
+		sc.Report(res)
+		require.NoError(t, s.write(t.Context(), r))
+
+		assert.Equal(t, common.ResourceStatus{}, getComponentStatus(t, cl))
+	})
+
+	t.Run("deleted resources are ignored", func(t *testing.T) {
+		s, _, _ := newTestStatus(t)
+
+		s.Connect("default", "app1-pod", "app1").Report(componentResult("statestore", "boom"))
+		require.NoError(t, s.write(t.Context(), r))
+		assert.Empty(t, s.lastErrors)
+	})
+
+	t.Run("run writes reported results", func(t *testing.T) {
+		s, cl, _ := newTestStatus(t, &subapi.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "default"},
+		})
+
+		ctx, cancel := context.WithCancel(t.Context())
+		errCh := make(chan error)
+		go func() { errCh <- s.Run(ctx) }()
+		t.Cleanup(func() {
+			cancel()
+			require.NoError(t, <-errCh)
+		})
+
+		s.Connect("default", "app1-pod", "app1").Report(&operatorv1pb.ResourceResult{
+			ResourceType: operatorv1pb.ResourceType_RESOURCE_SUBSCRIPTION,
+			EventType:    operatorv1pb.EventType_EVENT_INIT,
+			Name:         "orders",
+			Condition:    operatorv1pb.ResourceConditionStatus_STATUS_SUCCESS,
+		})
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			var sub subapi.Subscription
+			if !assert.NoError(c, cl.Get(ctx, types.NamespacedName{Namespace: "default", Name: "orders"}, &sub)) {
+				return
+			}
+			assert.Equal(c, int32(1), sub.Status.ReadyCount)
+		}, time.Second*5, time.Millisecond*10)
+	})
+}
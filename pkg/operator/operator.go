@@ -21,15 +21,18 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"reflect"
 	"strings"
 	"time"
 
 	argov1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/go-logr/logr"
 	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
@@ -48,6 +51,7 @@ import (
 	"github.com/dapr/dapr/pkg/operator/api"
 	operatorcache "github.com/dapr/dapr/pkg/operator/cache"
 	"github.com/dapr/dapr/pkg/operator/handlers"
+	"github.com/dapr/dapr/pkg/operator/validation"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/pkg/security"
 	"github.com/dapr/kit/concurrency"
@@ -56,6 +60,11 @@ import (
 
 var log = logger.NewLogger("dapr.operator")
 
+// validatingWebhookConfigurationName is the name of the
+// ValidatingWebhookConfiguration of the Dapr resources installed by the Helm
+// chart.
+const validatingWebhookConfigurationName = "dapr-operator-validator"
+
 // Operator is an Dapr Kubernetes Operator for managing components and sidecar lifecycle.
 type Operator interface {
 	Start(ctx context.Context) error
@@ -232,6 +241,24 @@ func (o *operator) syncSubscription(ctx context.Context, eventType operatorv1pb.
 	}
 }
 
+// statusOnlyUpdate returns true if the update of a resource only changed its
+// metadata or status, such as the status written from the results reported by
+// the sidecars, which sidecars do not need to be notified of.
+func statusOnlyUpdate(oldObj, newObj any) bool {
+	type emptyMetaDeepCopier interface {
+		EmptyMetaDeepCopy() v1.Object
+	}
+	o, ok := oldObj.(emptyMetaDeepCopier)
+	if !ok {
+		return false
+	}
+	n, ok := newObj.(emptyMetaDeepCopier)
+	if !ok {
+		return false
+	}
+	return reflect.DeepEqual(o.EmptyMetaDeepCopy(), n.EmptyMetaDeepCopy())
+}
+
 func (o *operator) Start(ctx context.Context) error {
 	log.Info("Dapr Operator is starting")
 
@@ -252,6 +279,9 @@ func (o *operator) Start(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("unable to create webhook Subscriptions v2alpha1: %w", err)
 		}
+		if err = validation.Register(o.mgr); err != nil {
+			return err
+		}
 	}
 
 	caBundleCh := make(chan []byte)
@@ -311,6 +341,12 @@ func (o *operator) Start(ctx context.Context) error {
 					return rErr
 				}
 
+				// The validating webhooks are optional, so failing to patch them
+				// must not stop the operator.
+				if rErr = o.patchValidatingWebhooks(ctx, caBundle, o.mgr.GetConfig()); rErr != nil {
+					log.Warnf("Failed to patch validating webhook configuration %q: %v", validatingWebhookConfigurationName, rErr)
+				}
+
 				o.webhookHealthz.Ready()
 
 				select {
@@ -340,8 +376,10 @@ func (o *operator) Start(ctx context.Context) error {
 
 			_, rErr = httpEndpointInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 				AddFunc: o.syncHTTPEndpoint(ctx),
-				UpdateFunc: func(_, newObj interface{}) {
-					o.syncHTTPEndpoint(ctx)(newObj)
+				UpdateFunc: func(oldObj, newObj interface{}) {
+					if !statusOnlyUpdate(oldObj, newObj) {
+						o.syncHTTPEndpoint(ctx)(newObj)
+					}
 				},
 			})
 			if rErr != nil {
@@ -361,8 +399,10 @@ func (o *operator) Start(ctx context.Context) error {
 			}
 			_, rErr = subscriptionInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 				AddFunc: o.syncSubscription(ctx, operatorv1pb.ResourceEventType_CREATED),
-				UpdateFunc: func(_, newObj interface{}) {
-					o.syncSubscription(ctx, operatorv1pb.ResourceEventType_UPDATED)(newObj)
+				UpdateFunc: func(oldObj, newObj interface{}) {
+					if !statusOnlyUpdate(oldObj, newObj) {
+						o.syncSubscription(ctx, operatorv1pb.ResourceEventType_UPDATED)(newObj)
+					}
 				},
 				DeleteFunc: o.syncSubscription(ctx, operatorv1pb.ResourceEventType_DELETED),
 			})
@@ -440,6 +480,58 @@ func (o *operator) patchConversionWebhooksInCRDs(ctx context.Context, caBundle [
 	return nil
 }
 
+// Patches the CA bundle of the validating webhooks of the Dapr resources.
+// Does nothing if the webhook configuration isn't installed.
+func (o *operator) patchValidatingWebhooks(ctx context.Context, caBundle []byte, conf *rest.Config) error {
+	clientSet, err := kubernetes.NewForConfig(conf)
+	if err != nil {
+		return fmt.Errorf("could not get Kubernetes client: %w", err)
+	}
+
+	whClient := clientSet.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+	whConf, err := whClient.Get(ctx, validatingWebhookConfigurationName, v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		log.Infof("Validating webhook configuration %q not found, Dapr resources will not be validated on admission", validatingWebhookConfigurationName)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not get validating webhook configuration: %w", err)
+	}
+
+	type patchValue struct {
+		Op    string      `json:"op"`
+		Path  string      `json:"path"`
+		Value interface{} `json:"value"`
+	}
+	var payload []patchValue
+	for i, wh := range whConf.Webhooks {
+		if bytes.Equal(wh.ClientConfig.CABundle, caBundle) {
+			continue
+		}
+		payload = append(payload, patchValue{
+			Op:    "add",
+			Path:  fmt.Sprintf("/webhooks/%d/clientConfig/caBundle", i),
+			Value: caBundle,
+		})
+	}
+
+	if len(payload) == 0 {
+		log.Infof("Validating webhook configuration %q is up to date", validatingWebhookConfigurationName)
+		return nil
+	}
+
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal webhook patch: %w", err)
+	}
+	if _, err := whClient.Patch(ctx, validatingWebhookConfigurationName, types.JSONPatchType, payloadJSON, v1.PatchOptions{}); err != nil {
+		return err
+	}
+
+	log.Infof("Successfully patched validating webhook configuration %q", validatingWebhookConfigurationName)
+	return nil
+}
+
 func buildScheme(opts Options) (*runtime.Scheme, error) {
 	builders := []func(*runtime.Scheme) error{
 		clientgoscheme.AddToScheme,
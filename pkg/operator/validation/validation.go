@@ -0,0 +1,233 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/dapr/dapr/pkg/acl"
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	configurationapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/resiliency"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.operator.validation")
+
+const group = "dapr.io"
+
+// Register registers the validating admission webhooks of the Dapr resources
+// with the webhook server of the manager. Subscriptions are validated in their
+// storage version, v2alpha1; the API server converts v1alpha1 Subscriptions
+// before calling the webhook.
+func Register(mgr ctrl.Manager) error {
+	for _, wh := range []struct {
+		obj       runtime.Object
+		validator admission.CustomValidator
+	}{
+		{obj: new(componentsapi.Component), validator: Component},
+		{obj: new(configurationapi.Configuration), validator: Configuration},
+		{obj: new(resiliencyapi.Resiliency), validator: Resiliency},
+		{obj: new(subapi.Subscription), validator: Subscription},
+	} {
+		err := ctrl.NewWebhookManagedBy(mgr).
+			For(wh.obj).
+			WithValidator(wh.validator).
+			Complete()
+		if err != nil {
+			return fmt.Errorf("unable to create validating webhook for %T: %w", wh.obj, err)
+		}
+	}
+
+	return nil
+}
+
+// validator is an admission.CustomValidator which validates created and
+// updated resources of type T. Deletes are always allowed.
+type validator[T client.Object] struct {
+	kind     string
+	validate func(T) field.ErrorList
+}
+
+var (
+	// Component validates the metadata and init timeout of Components.
+	Component admission.CustomValidator = validator[*componentsapi.Component]{
+		kind:     componentsapi.Kind,
+		validate: validateComponent,
+	}
+
+	// Configuration validates Configurations using the parsing of the runtime.
+	Configuration admission.CustomValidator = validator[*configurationapi.Configuration]{
+		kind:     "Configuration",
+		validate: validateConfiguration,
+	}
+
+	// Resiliency validates the policies and targets of Resiliencies using the
+	// parsing of the runtime.
+	Resiliency admission.CustomValidator = validator[*resiliencyapi.Resiliency]{
+		kind:     "Resiliency",
+		validate: validateResiliency,
+	}
+
+	// Subscription validates the routing rules, ordering, retry topics and
+	// deduplication of Subscriptions using the parsing of the runtime.
+	Subscription admission.CustomValidator = validator[*subapi.Subscription]{
+		kind:     subapi.Kind,
+		validate: validateSubscription,
+	}
+)
+
+func (v validator[T]) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validateObject(obj)
+}
+
+func (v validator[T]) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validateObject(newObj)
+}
+
+func (v validator[T]) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v validator[T]) validateObject(obj runtime.Object) error {
+	o, ok := obj.(T)
+	if !ok {
+		return fmt.Errorf("expected a %s but got %T", v.kind, obj)
+	}
+
+	errs := v.validate(o)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	log.Debugf("Rejecting %s %s/%s: %v", v.kind, o.GetNamespace(), o.GetName(), errs.ToAggregate())
+	return apierrors.NewInvalid(schema.GroupKind{Group: group, Kind: v.kind}, o.GetName(), errs)
+}
+
+func validateComponent(comp *componentsapi.Component) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if len(comp.Spec.InitTimeout) > 0 {
+		if d, err := time.ParseDuration(comp.Spec.InitTimeout); err != nil {
+			errs = append(errs, field.Invalid(specPath.Child("initTimeout"), comp.Spec.InitTimeout, err.Error()))
+		} else if d <= 0 {
+			errs = append(errs, field.Invalid(specPath.Child("initTimeout"), comp.Spec.InitTimeout, "must be positive"))
+		}
+	}
+
+	// Metadata keys are matched case-insensitively by components, so items
+	// whose names only differ by case conflict with each other.
+	seen := make(map[string]struct{}, len(comp.Spec.Metadata))
+	for i, item := range comp.Spec.Metadata {
+		itemPath := specPath.Child("metadata").Index(i)
+
+		key := strings.ToLower(item.Name)
+		if _, ok := seen[key]; ok {
+			errs = append(errs, field.Duplicate(itemPath.Child("name"), item.Name))
+		}
+		seen[key] = struct{}{}
+
+		var sources []string
+		if item.HasValue() {
+			sources = append(sources, "value")
+		}
+		if len(item.SecretKeyRef.Name) > 0 {
+			sources = append(sources, "secretKeyRef")
+		}
+		if len(item.EnvRef) > 0 {
+			sources = append(sources, "envRef")
+		}
+		if len(sources) > 1 {
+			errs = append(errs, field.Invalid(itemPath, item.Name,
+				"only one of value, secretKeyRef and envRef may be set, found "+strings.Join(sources, ", ")))
+		}
+	}
+
+	return errs
+}
+
+func validateConfiguration(conf *configurationapi.Configuration) field.ErrorList {
+	specPath := field.NewPath("spec")
+
+	b, err := json.Marshal(conf)
+	if err != nil {
+		return field.ErrorList{field.InternalError(specPath, err)}
+	}
+
+	parsed, err := config.ParseConfiguration(b)
+	if err != nil {
+		return field.ErrorList{field.Invalid(specPath, field.OmitValueType{}, err.Error())}
+	}
+
+	if _, err = acl.ParseAccessControlSpec(parsed.Spec.AccessControlSpec, true); err != nil {
+		return field.ErrorList{field.Invalid(specPath.Child("accessControl"), field.OmitValueType{}, err.Error())}
+	}
+
+	return nil
+}
+
+func validateResiliency(res *resiliencyapi.Resiliency) field.ErrorList {
+	if err := resiliency.New(log).DecodeConfiguration(res); err != nil {
+		return field.ErrorList{field.Invalid(field.NewPath("spec"), field.OmitValueType{}, err.Error())}
+	}
+	return nil
+}
+
+func validateSubscription(sub *subapi.Subscription) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	for i, rule := range sub.Spec.Routes.Rules {
+		if _, err := rtpubsub.CreateRoutingRule(rule.Match, rule.Path); err != nil {
+			errs = append(errs, field.Invalid(specPath.Child("routes", "rules").Index(i).Child("match"), rule.Match, err.Error()))
+		}
+	}
+
+	if o := sub.Spec.Ordering; o != nil {
+		if _, err := rtpubsub.CreateOrdering(o.Key, o.Expression, o.MaxConcurrency); err != nil {
+			errs = append(errs, field.Invalid(specPath.Child("ordering"), field.OmitValueType{}, err.Error()))
+		}
+	}
+
+	for i, rt := range sub.Spec.RetryTopics {
+		if _, err := rtpubsub.CreateRetryTopic(rt.Topic, rt.Delay); err != nil {
+			errs = append(errs, field.Invalid(specPath.Child("retryTopics").Index(i), field.OmitValueType{}, err.Error()))
+		}
+	}
+
+	if d := sub.Spec.Deduplication; d != nil {
+		if _, err := rtpubsub.CreateDeduplication(d.StateStore, d.TTL); err != nil {
+			errs = append(errs, field.Invalid(specPath.Child("deduplication"), field.OmitValueType{}, err.Error()))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/dapr/dapr/pkg/apis/common"
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	configurationapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+)
+
+func metadataItem(name, value string) common.NameValuePair {
+	var item common.NameValuePair
+	item.Name = name
+	if len(value) > 0 {
+		item.SetValue([]byte(`"` + value + `"`))
+	}
+	return item
+}
+
+func TestValidators(t *testing.T) {
+	meta := metav1.ObjectMeta{Name: "test", Namespace: "default"}
+
+	tests := map[string]struct {
+		validator admission.CustomValidator
+		obj       runtime.Object
+		expErr    []string
+	}{
+		"valid component": {
+			validator: Component,
+			obj: &componentsapi.Component{ObjectMeta: meta, Spec: componentsapi.ComponentSpec{
+				Type:        "state.redis",
+				Version:     "v1",
+				InitTimeout: "10s",
+				Metadata: []common.NameValuePair{
+					metadataItem("redisHost", "localhost:6379"),
+					{Name: "redisPassword", SecretKeyRef: common.SecretKeyRef{Name: "redis", Key: "password"}},
+				},
+			}},
+		},
+		"component with conflicting metadata": {
+			validator: Component,
+			obj: &componentsapi.Component{ObjectMeta: meta, Spec: componentsapi.ComponentSpec{
+				Type:        "state.redis",
+				Version:     "v1",
+				InitTimeout: "ten seconds",
+				Metadata: []common.NameValuePair{
+					metadataItem("redisHost", "localhost:6379"),
+					metadataItem("RedisHost", "localhost:6380"),
+					func() common.NameValuePair {
+						item := metadataItem("redisPassword", "foo")
+						item.EnvRef = "REDIS_PASSWORD"
+						return item
+					}(),
+				},
+			}},
+			expErr: []string{
+				`spec.initTimeout: Invalid value: "ten seconds"`,
+				`spec.metadata[1].name: Duplicate value: "RedisHost"`,
+				`spec.metadata[2]: Invalid value: "redisPassword": only one of value, secretKeyRef and envRef may be set, found value, envRef`,
+			},
+		},
+		"valid configuration": {
+			validator: Configuration,
+			obj: &configurationapi.Configuration{ObjectMeta: meta, Spec: configurationapi.ConfigurationSpec{
+				AccessControlSpec: &configurationapi.AccessControlSpec{
+					DefaultAction: "deny",
+					AppPolicies: []configurationapi.AppPolicySpec{
+						{AppName: "app1", Namespace: "default", TrustDomain: "public"},
+					},
+				},
+			}},
+		},
+		"configuration with repeated secret store": {
+			validator: Configuration,
+			obj: &configurationapi.Configuration{ObjectMeta: meta, Spec: configurationapi.ConfigurationSpec{
+				Secrets: &configurationapi.SecretsSpec{
+					Scopes: []configurationapi.SecretsScope{{StoreName: "store"}, {StoreName: "store"}},
+				},
+			}},
+			expErr: []string{"spec: Invalid value: store storeName is repeated in secrets configuration"},
+		},
+		"configuration with invalid access control": {
+			validator: Configuration,
+			obj: &configurationapi.Configuration{ObjectMeta: meta, Spec: configurationapi.ConfigurationSpec{
+				AccessControlSpec: &configurationapi.AccessControlSpec{
+					DefaultAction: "deny",
+					AppPolicies: []configurationapi.AppPolicySpec{
+						{AppName: "app1", TrustDomain: "public"},
+					},
+				},
+			}},
+			expErr: []string{"spec.accessControl: Invalid value: invalid access control spec. missing trustdomain for apps: [], missing namespace for apps: [app1]"},
+		},
+		"valid resiliency": {
+			validator: Resiliency,
+			obj: &resiliencyapi.Resiliency{ObjectMeta: meta, Spec: resiliencyapi.ResiliencySpec{
+				Policies: resiliencyapi.Policies{
+					Timeouts: map[string]string{"general": "5s"},
+				},
+			}},
+		},
+		"resiliency with invalid duration": {
+			validator: Resiliency,
+			obj: &resiliencyapi.Resiliency{ObjectMeta: meta, Spec: resiliencyapi.ResiliencySpec{
+				Policies: resiliencyapi.Policies{
+					Timeouts: map[string]string{"general": "5 seconds"},
+				},
+			}},
+			expErr: []string{`spec: Invalid value: invalid duration "general", 5 seconds`},
+		},
+		"valid subscription": {
+			validator: Subscription,
+			obj: &subapi.Subscription{ObjectMeta: meta, Spec: subapi.SubscriptionSpec{
+				Pubsubname: "pubsub",
+				Topic:      "orders",
+				Routes: subapi.Routes{
+					Rules:   []subapi.Rule{{Match: `event.type == "order"`, Path: "/orders"}},
+					Default: "/default",
+				},
+				RetryTopics: []subapi.RetryTopic{{Topic: "orders-retry", Delay: "1m"}},
+			}},
+		},
+		"subscription with bad CEL rule and retry delay": {
+			validator: Subscription,
+			obj: &subapi.Subscription{ObjectMeta: meta, Spec: subapi.SubscriptionSpec{
+				Pubsubname: "pubsub",
+				Topic:      "orders",
+				Routes: subapi.Routes{
+					Rules: []subapi.Rule{
+						{Match: `event.type == "order"`, Path: "/orders"},
+						{Match: `event.type ==`, Path: "/broken"},
+					},
+				},
+				RetryTopics:   []subapi.RetryTopic{{Topic: "orders-retry", Delay: "soon"}},
+				Deduplication: &subapi.Deduplication{StateStore: "statestore", TTL: "1ms"},
+			}},
+			expErr: []string{
+				`spec.routes.rules[1].match: Invalid value: "event.type =="`,
+				"spec.retryTopics[0]: Invalid value: invalid delay for retry topic orders-retry",
+				"spec.deduplication: Invalid value: deduplication ttl must be at least 1s: 1ms",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, createErr := test.validator.ValidateCreate(t.Context(), test.obj)
+			_, updateErr := test.validator.ValidateUpdate(t.Context(), test.obj, test.obj)
+
+			for _, err := range []error{createErr, updateErr} {
+				if len(test.expErr) == 0 {
+					require.NoError(t, err)
+					continue
+				}
+
+				require.Error(t, err)
+				assert.True(t, apierrors.IsInvalid(err), err)
+				for _, exp := range test.expErr {
+					assert.Contains(t, err.Error(), exp)
+				}
+			}
+
+			_, err := test.validator.ValidateDelete(t.Context(), test.obj)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("unexpected object type", func(t *testing.T) {
+		_, err := Component.ValidateCreate(t.Context(), new(subapi.Subscription))
+		require.ErrorContains(t, err, "expected a Component")
+	})
+}
@@ -3,6 +3,7 @@ package operator
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"go.uber.org/ratelimit"
@@ -210,14 +211,10 @@ func (dw *DaprWatchdog) listPods(ctx context.Context) bool {
 			continue
 		}
 
-		// Check if the sidecar container is running
-		hasSidecar := false
-		for _, c := range pod.Spec.Containers {
-			if c.Name == sidecarContainerName {
-				hasSidecar = true
-				break
-			}
-		}
+		// Check if the sidecar container is running, either as a regular container
+		// or as a native sidecar init container
+		hasSidecar := slices.ContainsFunc(pod.Spec.Containers, isSidecarContainer) ||
+			slices.ContainsFunc(pod.Spec.InitContainers, isSidecarContainer)
 		if hasSidecar {
 			if dw.canPatchPodLabels {
 				log.Debugf("Found Dapr sidecar in pod %s, will patch the pod labels", logName)
@@ -252,6 +249,10 @@ func (dw *DaprWatchdog) listPods(ctx context.Context) bool {
 	return true
 }
 
+func isSidecarContainer(c corev1.Container) bool {
+	return c.Name == sidecarContainerName
+}
+
 func patchPodLabel(ctx context.Context, cl client.Client, pod *corev1.Pod) error {
 	// in case this has been already patched just return
 	if _, ok := pod.GetLabels()[operatorConsts.WatchdogPatchedLabel]; ok {
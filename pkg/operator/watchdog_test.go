@@ -143,6 +143,25 @@ func TestDaprWatchdog_listPods(t *testing.T) {
 		t.Log("daprized pods should be deleted except those running")
 		assertExpectedPodsDeleted(t, pods, ctlClient, ctx, daprized, running, injected)
 	})
+	t.Run("noInjectedPodsSomeRunningNativeSidecar", func(t *testing.T) {
+		ctlClient := fake.NewClientBuilder().WithObjects(createMockInjectorDeployment(1)).Build()
+		dw := &DaprWatchdog{client: ctlClient, restartLimiter: rl, podSelector: getSideCarInjectedNotExistsSelector()}
+		daprized := 5
+		running := 2
+		var injected int
+		pods := createMockPods(10, daprized, injected, running)
+		for _, pod := range pods[:running] {
+			// Move daprd to a native sidecar init container
+			pod.Spec.InitContainers = pod.Spec.Containers[1:]
+			pod.Spec.Containers = pod.Spec.Containers[:1]
+		}
+		for _, pod := range pods {
+			require.NoError(t, ctlClient.Create(ctx, pod))
+		}
+		require.True(t, dw.listPods(ctx))
+		t.Log("daprized pods should be deleted except those running a native sidecar")
+		assertExpectedPodsDeleted(t, pods, ctlClient, ctx, daprized, running, injected)
+	})
 	t.Run("someInjectedPodsWatchdogCannotPatch", func(t *testing.T) {
 		ctlClient := fake.NewClientBuilder().WithObjects(createMockInjectorDeployment(1)).Build()
 		dw := &DaprWatchdog{client: ctlClient, restartLimiter: rl, podSelector: getSideCarInjectedNotExistsSelector()}
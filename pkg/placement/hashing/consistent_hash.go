@@ -37,6 +37,18 @@ import (
 // ErrNoHosts is an error for no hosts.
 var ErrNoHosts = errors.New("no hosts added")
 
+const (
+	// MaxWeight is the maximum weight of a host, which caps its virtual nodes at
+	// MaxWeight times the replication factor.
+	MaxWeight = 10
+
+	// WeightedAPILevel is the actor API level of a cluster from which the
+	// weights of hosts are applied to the hash rings. Below it, some hosts may
+	// not support weights, which would have them resolve actors to different
+	// hosts.
+	WeightedAPILevel = 30
+)
+
 // ConsistentHashTables is a table holding a map of consistent hashes with a given version.
 type ConsistentHashTables struct {
 	Version string
@@ -49,6 +61,10 @@ type Host struct {
 	Port  int64
 	Load  int64
 	AppID string
+	// Weight is the capacity of the host relative to the other hosts, which
+	// gets it a proportional number of virtual nodes. Zero is the default
+	// weight of 1.
+	Weight uint32
 }
 
 // Consistent represents a data structure for consistent hashing.
@@ -91,8 +107,8 @@ func NewFromExisting(loadMap map[string]*Host, replicationFactor int64, virtualN
 		replicationFactor: replicationFactor,
 	}
 
-	for hostName := range loadMap {
-		hashes := virtualNodesCache.GetHashes(replicationFactor, hostName)
+	for hostName, host := range loadMap {
+		hashes := virtualNodesCache.GetHashes(host.virtualNodes(replicationFactor), hostName)
 		for _, h := range hashes {
 			newHash.hosts[h] = hostName
 		}
@@ -188,6 +204,12 @@ func (c *Consistent) ReadInternals(reader func(map[uint64]string, []uint64, map[
 // Add adds a host with port to the table.
 // Used on the Placement side to add hosts to the ring. It doesn't calculate vnodes.
 func (c *Consistent) Add(host, id string, port int64) bool {
+	return c.AddWeighted(host, id, port, 0)
+}
+
+// AddWeighted adds a host with port and weight to the table.
+// Used on the Placement side to add hosts to the ring. It doesn't calculate vnodes.
+func (c *Consistent) AddWeighted(host, id string, port int64, weight uint32) bool {
 	c.Lock()
 	defer c.Unlock()
 
@@ -195,7 +217,7 @@ func (c *Consistent) Add(host, id string, port int64) bool {
 		return true
 	}
 
-	c.loadMap[host] = &Host{Name: host, AppID: id, Load: 0, Port: port}
+	c.loadMap[host] = &Host{Name: host, AppID: id, Load: 0, Port: port, Weight: weight}
 
 	return false
 }
@@ -420,6 +442,33 @@ func (c *Consistent) VirtualNodes() map[uint64]string {
 	return virtualNodes
 }
 
+// VirtualNodeShares returns the fraction of the hash ring owned by the virtual
+// nodes of every host, which is the share of keys Get assigns to it.
+func (c *Consistent) VirtualNodeShares() map[string]float64 {
+	c.RLock()
+	defer c.RUnlock()
+
+	shares := make(map[string]float64, len(c.loadMap))
+	n := len(c.sortedSet)
+	switch n {
+	case 0:
+		return shares
+	case 1:
+		shares[c.hosts[c.sortedSet[0]]] = 1
+		return shares
+	}
+
+	// A virtual node owns the keys hashing after the previous virtual node, up
+	// to and including its own hash. The first virtual node also owns the keys
+	// wrapping around the end of the ring.
+	for i, h := range c.sortedSet {
+		arc := h - c.sortedSet[(i+n-1)%n]
+		shares[c.hosts[h]] += float64(arc) / math.Exp2(64)
+	}
+
+	return shares
+}
+
 func (c *Consistent) SortedSet() (sortedSet []uint64) {
 	c.RLock()
 	defer c.RUnlock()
@@ -427,6 +476,12 @@ func (c *Consistent) SortedSet() (sortedSet []uint64) {
 	return c.sortedSet
 }
 
+// virtualNodes returns the number of virtual nodes of the host, which is the
+// replication factor scaled by its weight.
+func (h *Host) virtualNodes(replicationFactor int64) int64 {
+	return replicationFactor * int64(min(max(h.Weight, 1), MaxWeight))
+}
+
 func hash(key string) uint64 {
 	out := blake2b.Sum512([]byte(key))
 	return binary.LittleEndian.Uint64(out[:])
@@ -65,6 +65,99 @@ func TestReplicationFactor(t *testing.T) {
 	})
 }
 
+func TestVirtualNodeShares(t *testing.T) {
+	t.Run("no hosts", func(t *testing.T) {
+		h := NewFromExisting(map[string]*Host{}, 100, NewVirtualNodesCache())
+		assert.Empty(t, h.VirtualNodeShares())
+	})
+
+	t.Run("single virtual node owns the whole ring", func(t *testing.T) {
+		h := NewFromExisting(map[string]*Host{"node1": NewHost("node1", "node1", 0, 1)}, 1, NewVirtualNodesCache())
+		assert.Equal(t, map[string]float64{"node1": 1}, h.VirtualNodeShares())
+	})
+
+	t.Run("shares match the keys assigned to the hosts", func(t *testing.T) {
+		loadMap := make(map[string]*Host, len(nodes))
+		for _, node := range nodes {
+			loadMap[node] = NewHost(node, node, 0, 1)
+		}
+		h := NewFromExisting(loadMap, 100, NewVirtualNodesCache())
+
+		shares := h.VirtualNodeShares()
+		require.Len(t, shares, len(nodes))
+		var total float64
+		for _, share := range shares {
+			total += share
+		}
+		assert.InDelta(t, 1, total, 1e-9)
+
+		const keys = 100000
+		counts := make(map[string]int, len(nodes))
+		for i := range keys {
+			host, err := h.Get(strconv.Itoa(i))
+			require.NoError(t, err)
+			counts[host]++
+		}
+		for _, node := range nodes {
+			assert.InDelta(t, shares[node], float64(counts[node])/keys, 0.01, node)
+		}
+	})
+}
+
+func TestWeightedHosts(t *testing.T) {
+	countVirtualNodes := func(h *Consistent) map[string]int {
+		counts := make(map[string]int)
+		for _, host := range h.VirtualNodes() {
+			counts[host]++
+		}
+		return counts
+	}
+
+	t.Run("virtual nodes are proportional to the weight", func(t *testing.T) {
+		h := NewFromExisting(map[string]*Host{
+			"node1": {Name: "node1", AppID: "node1", Port: 1, Weight: 3},
+			"node2": NewHost("node2", "node2", 0, 1),
+		}, 100, NewVirtualNodesCache())
+
+		assert.Equal(t, map[string]int{"node1": 300, "node2": 100}, countVirtualNodes(h))
+		shares := h.VirtualNodeShares()
+		assert.InDelta(t, 0.75, shares["node1"], 0.05)
+		assert.InDelta(t, 0.25, shares["node2"], 0.05)
+	})
+
+	t.Run("weight is capped", func(t *testing.T) {
+		h := NewFromExisting(map[string]*Host{
+			"node1": {Name: "node1", AppID: "node1", Port: 1, Weight: MaxWeight + 5},
+		}, 10, NewVirtualNodesCache())
+
+		assert.Equal(t, map[string]int{"node1": 10 * MaxWeight}, countVirtualNodes(h))
+	})
+
+	t.Run("increasing a weight only moves keys to that host", func(t *testing.T) {
+		cache := NewVirtualNodesCache()
+		unweighted := NewFromExisting(map[string]*Host{
+			"node1": NewHost("node1", "node1", 0, 1),
+			"node2": NewHost("node2", "node2", 0, 1),
+			"node3": NewHost("node3", "node3", 0, 1),
+		}, 100, cache)
+		weighted := NewFromExisting(map[string]*Host{
+			"node1": NewHost("node1", "node1", 0, 1),
+			"node2": {Name: "node2", AppID: "node2", Port: 1, Weight: 2},
+			"node3": NewHost("node3", "node3", 0, 1),
+		}, 100, cache)
+
+		for i := range 1000 {
+			before, err := unweighted.Get(strconv.Itoa(i))
+			require.NoError(t, err)
+			after, err := weighted.Get(strconv.Itoa(i))
+			require.NoError(t, err)
+			if before != after {
+				assert.Equal(t, "node2", after)
+			}
+		}
+	})
+}
+
 func TestGetAndSetVirtualNodeCacheHashes(t *testing.T) {
 	cache := NewVirtualNodesCache()
 
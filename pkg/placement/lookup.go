@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/pkg/placement/hashing"
+	placementv1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+)
+
+// LookupActor returns the host which the placement tables assign an actor to.
+func (p *Service) LookupActor(ctx context.Context, req *placementv1pb.LookupActorRequest) (*placementv1pb.LookupActorResponse, error) {
+	namespace, err := p.authorizeNamespace(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveActor(p.placementTables(namespace), p.virtualNodesCache, namespace, req.GetActorType(), req.GetActorId())
+}
+
+// GetPlacementLoads returns how the actor types of a namespace are spread
+// across their hosts.
+func (p *Service) GetPlacementLoads(ctx context.Context, req *placementv1pb.GetPlacementLoadsRequest) (*placementv1pb.GetPlacementLoadsResponse, error) {
+	namespace, err := p.authorizeNamespace(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	return namespaceLoads(p.placementTables(namespace), p.virtualNodesCache), nil
+}
+
+// placementTables returns the placement tables of a namespace with the API
+// level they are disseminated with, which determines whether the weights of
+// the hosts are applied.
+func (p *Service) placementTables(namespace string) *placementv1pb.PlacementTables {
+	req := &tablesUpdateRequest{tables: p.raftNode.FSM().PlacementState(namespace)}
+	req.SetAPILevel(p.minAPILevel, p.maxAPILevel)
+	return req.tables
+}
+
+// authorizeNamespace ensures that clients only inspect the placement tables of
+// their own namespace when mTLS is enabled, defaulting to it if no namespace
+// is requested.
+func (p *Service) authorizeNamespace(ctx context.Context, namespace string) (string, error) {
+	clientID, err := p.validateClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if clientID == nil {
+		return namespace, nil
+	}
+
+	if len(namespace) == 0 {
+		return clientID.Namespace(), nil
+	}
+
+	if namespace != clientID.Namespace() {
+		return "", status.Errorf(codes.PermissionDenied, "requested namespace %s doesn't match the one in the Spiffe ID (%s)", namespace, clientID.Namespace())
+	}
+
+	return namespace, nil
+}
+
+func resolveActor(tables *placementv1pb.PlacementTables, cache *hashing.VirtualNodesCache, namespace, actorType, actorID string) (*placementv1pb.LookupActorResponse, error) {
+	if len(actorType) == 0 || len(actorID) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "actor type and actor ID are required")
+	}
+
+	table, ok := tables.GetEntries()[actorType]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "actor type %s is not hosted in namespace %q", actorType, namespace)
+	}
+
+	host, err := hashRing(tables, table, cache).GetHost(actorID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to resolve actor %s/%s: %s", actorType, actorID, err)
+	}
+
+	return &placementv1pb.LookupActorResponse{
+		Host:    table.GetLoadMap()[host.Name],
+		Version: tables.GetVersion(),
+	}, nil
+}
+
+func namespaceLoads(tables *placementv1pb.PlacementTables, cache *hashing.VirtualNodesCache) *placementv1pb.GetPlacementLoadsResponse {
+	res := &placementv1pb.GetPlacementLoadsResponse{
+		ActorTypes:        make([]*placementv1pb.ActorTypeLoads, 0, len(tables.GetEntries())),
+		Version:           tables.GetVersion(),
+		ReplicationFactor: tables.GetReplicationFactor(),
+	}
+
+	for actorType, table := range tables.GetEntries() {
+		ring := hashRing(tables, table, cache)
+		loads := ring.GetLoads()
+		shares := ring.VirtualNodeShares()
+		virtualNodes := make(map[string]int64, len(table.GetLoadMap()))
+		for _, host := range ring.VirtualNodes() {
+			virtualNodes[host]++
+		}
+
+		typeLoads := &placementv1pb.ActorTypeLoads{
+			ActorType: actorType,
+			Hosts:     make([]*placementv1pb.HostLoad, 0, len(table.GetLoadMap())),
+			TotalLoad: table.GetTotalLoad(),
+		}
+		ring.ReadInternals(func(_ map[uint64]string, _ []uint64, loadMap map[string]*hashing.Host, _ int64) {
+			for name, host := range loadMap {
+				typeLoads.Hosts = append(typeLoads.Hosts, &placementv1pb.HostLoad{
+					Name:             host.Name,
+					Id:               host.AppID,
+					Port:             host.Port,
+					Load:             loads[name],
+					VirtualNodes:     virtualNodes[name],
+					VirtualNodeShare: shares[name],
+					Weight:           host.Weight,
+				})
+			}
+		})
+		slices.SortFunc(typeLoads.Hosts, func(a, b *placementv1pb.HostLoad) int {
+			return strings.Compare(a.GetName(), b.GetName())
+		})
+
+		res.ActorTypes = append(res.ActorTypes, typeLoads)
+	}
+
+	slices.SortFunc(res.ActorTypes, func(a, b *placementv1pb.ActorTypeLoads) int {
+		return strings.Compare(a.GetActorType(), b.GetActorType())
+	})
+
+	return res
+}
+
+// hashRing builds the consistent hash ring of an actor type the same way
+// daprd does from the disseminated placement tables, as the tables of the
+// placement service don't hold the virtual nodes of the hosts.
+func hashRing(tables *placementv1pb.PlacementTables, table *placementv1pb.PlacementTable, cache *hashing.VirtualNodesCache) *hashing.Consistent {
+	weighted := tables.GetApiLevel() >= hashing.WeightedAPILevel
+	loadMap := make(map[string]*hashing.Host, len(table.GetLoadMap()))
+	for name, host := range table.GetLoadMap() {
+		loadMap[name] = hashing.NewHost(host.GetName(), host.GetId(), host.GetLoad(), host.GetPort())
+		if weighted {
+			loadMap[name].Weight = host.GetWeight()
+		}
+	}
+
+	return hashing.NewFromExisting(loadMap, tables.GetReplicationFactor(), cache)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"testing"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/pkg/placement/hashing"
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+	securityfake "github.com/dapr/dapr/pkg/security/fake"
+	"github.com/dapr/kit/crypto/test"
+)
+
+func testPlacementTables() *v1pb.PlacementTables {
+	return &v1pb.PlacementTables{
+		Version:           "7",
+		ReplicationFactor: 100,
+		Entries: map[string]*v1pb.PlacementTable{
+			"DogActor": {
+				LoadMap: map[string]*v1pb.Host{
+					"10.0.0.1:50002": {Name: "10.0.0.1:50002", Id: "app1", Port: 50002, Load: 3},
+					"10.0.0.2:50002": {Name: "10.0.0.2:50002", Id: "app2", Port: 50002, Load: 1},
+				},
+				TotalLoad: 4,
+			},
+			"CatActor": {
+				LoadMap: map[string]*v1pb.Host{
+					"10.0.0.1:50002": {Name: "10.0.0.1:50002", Id: "app1", Port: 50002},
+				},
+			},
+			"EmptyActor": {
+				LoadMap: map[string]*v1pb.Host{},
+			},
+		},
+	}
+}
+
+func TestResolveActor(t *testing.T) {
+	tables := testPlacementTables()
+	cache := hashing.NewVirtualNodesCache()
+
+	t.Run("resolves the actor like daprd", func(t *testing.T) {
+		res, err := resolveActor(tables, cache, "default", "DogActor", "rex")
+		require.NoError(t, err)
+
+		ring := hashRing(tables, tables.GetEntries()["DogActor"], hashing.NewVirtualNodesCache())
+		expected, err := ring.GetHost("rex")
+		require.NoError(t, err)
+
+		assert.Equal(t, expected.Name, res.GetHost().GetName())
+		assert.Equal(t, expected.AppID, res.GetHost().GetId())
+		assert.Equal(t, "7", res.GetVersion())
+	})
+
+	t.Run("single host owns all actors", func(t *testing.T) {
+		res, err := resolveActor(tables, cache, "default", "CatActor", "tom")
+		require.NoError(t, err)
+		assert.Equal(t, "10.0.0.1:50002", res.GetHost().GetName())
+	})
+
+	t.Run("missing actor type or ID", func(t *testing.T) {
+		_, err := resolveActor(tables, cache, "default", "", "rex")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = resolveActor(tables, cache, "default", "DogActor", "")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown actor type", func(t *testing.T) {
+		_, err := resolveActor(tables, cache, "default", "BirdActor", "tweety")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("actor type without hosts", func(t *testing.T) {
+		_, err := resolveActor(tables, cache, "default", "EmptyActor", "nobody")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestNamespaceLoads(t *testing.T) {
+	res := namespaceLoads(testPlacementTables(), hashing.NewVirtualNodesCache())
+
+	assert.Equal(t, "7", res.GetVersion())
+	assert.Equal(t, int64(100), res.GetReplicationFactor())
+	require.Len(t, res.GetActorTypes(), 3)
+	assert.Equal(t, "CatActor", res.GetActorTypes()[0].GetActorType())
+	assert.Equal(t, "DogActor", res.GetActorTypes()[1].GetActorType())
+	assert.Equal(t, "EmptyActor", res.GetActorTypes()[2].GetActorType())
+
+	cat := res.GetActorTypes()[0]
+	require.Len(t, cat.GetHosts(), 1)
+	assert.Equal(t, int64(100), cat.GetHosts()[0].GetVirtualNodes())
+	assert.InDelta(t, 1, cat.GetHosts()[0].GetVirtualNodeShare(), 1e-9)
+
+	dog := res.GetActorTypes()[1]
+	assert.Equal(t, int64(4), dog.GetTotalLoad())
+	require.Len(t, dog.GetHosts(), 2)
+	assert.Equal(t, "10.0.0.1:50002", dog.GetHosts()[0].GetName())
+	assert.Equal(t, "app1", dog.GetHosts()[0].GetId())
+	assert.Equal(t, int64(3), dog.GetHosts()[0].GetLoad())
+	assert.Equal(t, int64(1), dog.GetHosts()[1].GetLoad())
+	var share float64
+	for _, host := range dog.GetHosts() {
+		assert.Equal(t, int64(100), host.GetVirtualNodes())
+		share += host.GetVirtualNodeShare()
+	}
+	assert.InDelta(t, 1, share, 1e-9)
+
+	assert.Empty(t, res.GetActorTypes()[2].GetHosts())
+}
+
+func TestNamespaceLoadsWeighted(t *testing.T) {
+	tables := testPlacementTables()
+	tables.GetEntries()["DogActor"].GetLoadMap()["10.0.0.1:50002"].Weight = 3
+
+	t.Run("weights are ignored below the weighted API level", func(t *testing.T) {
+		tables.ApiLevel = hashing.WeightedAPILevel - 1
+		dog := namespaceLoads(tables, hashing.NewVirtualNodesCache()).GetActorTypes()[1]
+		assert.Equal(t, int64(100), dog.GetHosts()[0].GetVirtualNodes())
+		assert.Equal(t, uint32(0), dog.GetHosts()[0].GetWeight())
+	})
+
+	t.Run("weights are applied from the weighted API level", func(t *testing.T) {
+		tables.ApiLevel = hashing.WeightedAPILevel
+		dog := namespaceLoads(tables, hashing.NewVirtualNodesCache()).GetActorTypes()[1]
+		assert.Equal(t, int64(300), dog.GetHosts()[0].GetVirtualNodes())
+		assert.Equal(t, uint32(3), dog.GetHosts()[0].GetWeight())
+		assert.Equal(t, int64(100), dog.GetHosts()[1].GetVirtualNodes())
+		assert.InDelta(t, 0.75, dog.GetHosts()[0].GetVirtualNodeShare(), 0.05)
+	})
+}
+
+func TestAuthorizeNamespace(t *testing.T) {
+	appID := spiffeid.RequireFromString("spiffe://example.org/ns/ns1/app1")
+	serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-placement")
+	pki := test.GenPKI(t, test.PKIOptions{LeafID: serverID, ClientID: appID})
+
+	t.Run("any namespace without mTLS", func(t *testing.T) {
+		p := &Service{sec: securityfake.New().WithMTLSEnabled(false)}
+		namespace, err := p.authorizeNamespace(t.Context(), "ns2")
+		require.NoError(t, err)
+		assert.Equal(t, "ns2", namespace)
+	})
+
+	p := &Service{sec: securityfake.New().WithMTLSEnabled(true)}
+
+	t.Run("no identity", func(t *testing.T) {
+		_, err := p.authorizeNamespace(t.Context(), "ns1")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("same namespace", func(t *testing.T) {
+		namespace, err := p.authorizeNamespace(pki.ClientGRPCCtx(t), "ns1")
+		require.NoError(t, err)
+		assert.Equal(t, "ns1", namespace)
+	})
+
+	t.Run("defaults to the namespace of the identity", func(t *testing.T) {
+		namespace, err := p.authorizeNamespace(pki.ClientGRPCCtx(t), "")
+		require.NoError(t, err)
+		assert.Equal(t, "ns1", namespace)
+	})
+
+	t.Run("different namespace", func(t *testing.T) {
+		_, err := p.authorizeNamespace(pki.ClientGRPCCtx(t), "ns2")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -32,6 +32,7 @@ import (
 	"k8s.io/utils/clock"
 
 	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/placement/hashing"
 	"github.com/dapr/dapr/pkg/placement/monitoring"
 	"github.com/dapr/dapr/pkg/placement/raft"
 	placementv1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
@@ -150,6 +151,10 @@ type Service struct {
 	// clock keeps time. Mocked in tests.
 	clock clock.WithTicker
 
+	// virtualNodesCache caches the virtual nodes of hosts used to resolve actors
+	// in the placement tables.
+	virtualNodesCache *hashing.VirtualNodesCache
+
 	sec           security.Provider
 	port          int
 	listenAddress string
@@ -203,6 +208,7 @@ func New(opts ServiceOpts) (*Service, error) {
 		closedCh:           make(chan struct{}),
 		sec:                opts.SecProvider,
 		disseminateLocks:   cmap.NewMutex[string](),
+		virtualNodesCache:  hashing.NewVirtualNodesCache(),
 		memberUpdateCount:  *haxmap.New[string, *atomic.Uint32](),
 		keepAliveTime:      opts.KeepAliveTime,
 		keepAliveTimeout:   opts.KeepAliveTimeout,
@@ -287,7 +293,7 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 
 	var isActorHost atomic.Bool // Does the daprd sidecar host any actor types
 
-	spiffeClientID, err := p.validateClient(stream)
+	spiffeClientID, err := p.validateClient(stream.Context())
 	if err != nil {
 		return err
 	}
@@ -408,6 +414,7 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 						Entities:  host.GetEntities(),
 						UpdatedAt: now.UnixNano(),
 						APILevel:  host.GetApiLevel(),
+						Weight:    host.GetWeight(),
 					},
 				}
 				log.Debugf("Member changed; upserting appid %s in namespace %s with entities %v", appID, namespace, host.GetEntities())
@@ -418,8 +425,8 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 	return status.Errorf(codes.FailedPrecondition, "node id=%s is not a leader. Only the leader can serve requests", p.raftNode.GetID())
 }
 
-func (p *Service) validateClient(stream placementv1pb.Placement_ReportDaprStatusServer) (*spiffe.Parsed, error) {
-	sec, err := p.sec.Handler(stream.Context())
+func (p *Service) validateClient(ctx context.Context) (*spiffe.Parsed, error) {
+	sec, err := p.sec.Handler(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "")
 	}
@@ -428,7 +435,7 @@ func (p *Service) validateClient(stream placementv1pb.Placement_ReportDaprStatus
 		return nil, nil
 	}
 
-	clientID, ok, err := spiffe.FromGRPCContext(stream.Context())
+	clientID, ok, err := spiffe.FromGRPCContext(ctx)
 	if err != nil || !ok {
 		log.Debugf("failed to get client ID from context: err=%v, ok=%t", err, ok)
 		return nil, status.Errorf(codes.Unauthenticated, "failed to get client ID from context")
@@ -111,10 +111,11 @@ func (c *FSM) PlacementState(namespace string) *v1pb.PlacementTables {
 
 			for lk, lv := range loadMap {
 				h := v1pb.Host{
-					Name: lv.Name,
-					Load: lv.Load,
-					Port: lv.Port,
-					Id:   lv.AppID,
+					Name:   lv.Name,
+					Load:   lv.Load,
+					Port:   lv.Port,
+					Id:     lv.AppID,
+					Weight: lv.Weight,
 				}
 				table.LoadMap[lk] = &h
 			}
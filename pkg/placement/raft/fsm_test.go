@@ -110,6 +110,7 @@ func TestPlacementState(t *testing.T) {
 		Namespace: "ns1",
 		AppID:     "fakeAppID",
 		Entities:  []string{"actorTypeOne", "actorTypeTwo"},
+		Weight:    2,
 	}
 	cmdLog, err := makeRaftLogCommand(MemberUpsert, m)
 	require.NoError(t, err)
@@ -131,6 +132,7 @@ func TestPlacementState(t *testing.T) {
 		assert.Empty(t, host.GetSortedSet())
 		assert.Len(t, host.GetLoadMap(), 1)
 		assert.Contains(t, host.GetLoadMap(), "127.0.0.1:3030")
+		assert.Equal(t, uint32(2), host.GetLoadMap()["127.0.0.1:3030"].GetWeight())
 	}
 }
 
@@ -46,6 +46,10 @@ type DaprHostMember struct {
 
 	// Version of the Actor APIs supported by the Dapr runtime
 	APILevel uint32
+
+	// Weight is the capacity of the host relative to the other hosts of its
+	// actor types.
+	Weight uint32
 }
 
 func (d *DaprHostMember) NamespaceAndName() string {
@@ -215,7 +219,7 @@ func (s *DaprHostMemberState) UpsertRequired(ns string, new *placementv1pb.Host)
 	}
 	if m, ok := n.Members[new.GetName()]; ok {
 		// If all attributes match, no upsert is required
-		return !(m.AppID == new.GetId() && m.Name == new.GetName() && cmp.Equal(m.Entities, new.GetEntities()))
+		return !(m.AppID == new.GetId() && m.Name == new.GetName() && m.Weight == new.GetWeight() && cmp.Equal(m.Entities, new.GetEntities()))
 	}
 
 	return true
@@ -325,6 +329,7 @@ func (s *DaprHostMemberState) clone() *DaprHostMemberState {
 				Entities:  make([]string, len(v.Entities)),
 				UpdatedAt: v.UpdatedAt,
 				APILevel:  v.APILevel,
+				Weight:    v.Weight,
 			}
 			copy(m.Entities, v.Entities)
 			newMembers.data.Namespace[nsName].Members[k] = m
@@ -351,7 +356,7 @@ func (s *DaprHostMemberState) updateHashingTables(host *DaprHostMember) {
 			s.data.Namespace[host.Namespace].hashingTableMap[e] = hashing.NewConsistentHash(s.config.replicationFactor)
 		}
 
-		s.data.Namespace[host.Namespace].hashingTableMap[e].Add(host.Name, host.AppID, 0)
+		s.data.Namespace[host.Namespace].hashingTableMap[e].AddWeighted(host.Name, host.AppID, 0, host.Weight)
 	}
 }
 
@@ -394,7 +399,7 @@ func (s *DaprHostMemberState) upsertMember(host *DaprHostMember) bool {
 
 	if m, ok := ns.Members[host.Name]; ok {
 		// No need to update consistent hashing table if the same dapr host member exists
-		if m.AppID == host.AppID && m.Name == host.Name && cmp.Equal(m.Entities, host.Entities) {
+		if m.AppID == host.AppID && m.Name == host.Name && m.Weight == host.Weight && cmp.Equal(m.Entities, host.Entities) {
 			m.UpdatedAt = host.UpdatedAt
 			return false
 		}
@@ -410,6 +415,7 @@ func (s *DaprHostMemberState) upsertMember(host *DaprHostMember) bool {
 		AppID:     host.AppID,
 		UpdatedAt: host.UpdatedAt,
 		APILevel:  host.APILevel,
+		Weight:    host.Weight,
 	}
 
 	ns.Members[host.Name].Entities = make([]string, len(host.Entities))
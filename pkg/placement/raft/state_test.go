@@ -153,6 +153,24 @@ func TestUpsertMemberNoHashingTable(t *testing.T) {
 	})
 
 	require.False(t, updated)
+
+	// Changing the weight of the host updates the hashing tables.
+	updated = s.upsertMember(&DaprHostMember{
+		Name:      "127.0.0.1:8081",
+		Namespace: "ns1",
+		AppID:     "FakeID_2",
+		Entities:  []string{"actorTypeOne", "actorTypeTwo", "actorTypeThree"},
+		UpdatedAt: 3,
+		Weight:    2,
+	})
+
+	require.True(t, updated)
+	require.True(t, s.UpsertRequired("ns1", &placementv1pb.Host{
+		Name:     "127.0.0.1:8081",
+		Id:       "FakeID_2",
+		Entities: []string{"actorTypeOne", "actorTypeTwo", "actorTypeThree"},
+		Weight:   3,
+	}))
 }
 
 func TestUpsertMemberNonActorHost(t *testing.T) {
@@ -73,6 +73,15 @@ const (
 	// OperatorHTTPEndpointUpdateProcedure is the fully-qualified name of the Operator's
 	// HTTPEndpointUpdate RPC.
 	OperatorHTTPEndpointUpdateProcedure = "/dapr.proto.operator.v1.Operator/HTTPEndpointUpdate"
+	// OperatorReportResourceStatusProcedure is the fully-qualified name of the Operator's
+	// ReportResourceStatus RPC.
+	OperatorReportResourceStatusProcedure = "/dapr.proto.operator.v1.Operator/ReportResourceStatus"
+	// OperatorConfigurationUpdateProcedure is the fully-qualified name of the Operator's
+	// ConfigurationUpdate RPC.
+	OperatorConfigurationUpdateProcedure = "/dapr.proto.operator.v1.Operator/ConfigurationUpdate"
+	// OperatorResiliencyUpdateProcedure is the fully-qualified name of the Operator's ResiliencyUpdate
+	// RPC.
+	OperatorResiliencyUpdateProcedure = "/dapr.proto.operator.v1.Operator/ResiliencyUpdate"
 )
 
 // OperatorClient is a client for the dapr.proto.operator.v1.Operator service.
@@ -97,6 +106,14 @@ type OperatorClient interface {
 	ListHTTPEndpoints(context.Context, *connect.Request[v1.ListHTTPEndpointsRequest]) (*connect.Response[v1.ListHTTPEndpointsResponse], error)
 	// Sends events to Dapr sidecars upon http endpoint changes.
 	HTTPEndpointUpdate(context.Context, *connect.Request[v1.HTTPEndpointUpdateRequest]) (*connect.ServerStreamForClient[v1.HTTPEndpointUpdateEvent], error)
+	// Streams the results of the resources loaded by a Dapr sidecar, which are
+	// aggregated into the status of the resources. The results of a sidecar are
+	// discarded when its stream closes.
+	ReportResourceStatus(context.Context) *connect.ClientStreamForClient[v1.ReportResourceStatusRequest, emptypb.Empty]
+	// Sends events to Dapr sidecars upon changes to the configuration they use.
+	ConfigurationUpdate(context.Context, *connect.Request[v1.ConfigurationUpdateRequest]) (*connect.ServerStreamForClient[v1.ConfigurationUpdateEvent], error)
+	// Sends events to Dapr sidecars upon resiliency changes.
+	ResiliencyUpdate(context.Context, *connect.Request[v1.ResiliencyUpdateRequest]) (*connect.ServerStreamForClient[v1.ResiliencyUpdateEvent], error)
 }
 
 // NewOperatorClient constructs a client for the dapr.proto.operator.v1.Operator service. By
@@ -159,21 +176,39 @@ func NewOperatorClient(httpClient connect.HTTPClient, baseURL string, opts ...co
 			baseURL+OperatorHTTPEndpointUpdateProcedure,
 			opts...,
 		),
+		reportResourceStatus: connect.NewClient[v1.ReportResourceStatusRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OperatorReportResourceStatusProcedure,
+			opts...,
+		),
+		configurationUpdate: connect.NewClient[v1.ConfigurationUpdateRequest, v1.ConfigurationUpdateEvent](
+			httpClient,
+			baseURL+OperatorConfigurationUpdateProcedure,
+			opts...,
+		),
+		resiliencyUpdate: connect.NewClient[v1.ResiliencyUpdateRequest, v1.ResiliencyUpdateEvent](
+			httpClient,
+			baseURL+OperatorResiliencyUpdateProcedure,
+			opts...,
+		),
 	}
 }
 
 // operatorClient implements OperatorClient.
 type operatorClient struct {
-	componentUpdate     *connect.Client[v1.ComponentUpdateRequest, v1.ComponentUpdateEvent]
-	listComponents      *connect.Client[v1.ListComponentsRequest, v1.ListComponentResponse]
-	getConfiguration    *connect.Client[v1.GetConfigurationRequest, v1.GetConfigurationResponse]
-	listSubscriptions   *connect.Client[emptypb.Empty, v1.ListSubscriptionsResponse]
-	getResiliency       *connect.Client[v1.GetResiliencyRequest, v1.GetResiliencyResponse]
-	listResiliency      *connect.Client[v1.ListResiliencyRequest, v1.ListResiliencyResponse]
-	listSubscriptionsV2 *connect.Client[v1.ListSubscriptionsRequest, v1.ListSubscriptionsResponse]
-	subscriptionUpdate  *connect.Client[v1.SubscriptionUpdateRequest, v1.SubscriptionUpdateEvent]
-	listHTTPEndpoints   *connect.Client[v1.ListHTTPEndpointsRequest, v1.ListHTTPEndpointsResponse]
-	hTTPEndpointUpdate  *connect.Client[v1.HTTPEndpointUpdateRequest, v1.HTTPEndpointUpdateEvent]
+	componentUpdate      *connect.Client[v1.ComponentUpdateRequest, v1.ComponentUpdateEvent]
+	listComponents       *connect.Client[v1.ListComponentsRequest, v1.ListComponentResponse]
+	getConfiguration     *connect.Client[v1.GetConfigurationRequest, v1.GetConfigurationResponse]
+	listSubscriptions    *connect.Client[emptypb.Empty, v1.ListSubscriptionsResponse]
+	getResiliency        *connect.Client[v1.GetResiliencyRequest, v1.GetResiliencyResponse]
+	listResiliency       *connect.Client[v1.ListResiliencyRequest, v1.ListResiliencyResponse]
+	listSubscriptionsV2  *connect.Client[v1.ListSubscriptionsRequest, v1.ListSubscriptionsResponse]
+	subscriptionUpdate   *connect.Client[v1.SubscriptionUpdateRequest, v1.SubscriptionUpdateEvent]
+	listHTTPEndpoints    *connect.Client[v1.ListHTTPEndpointsRequest, v1.ListHTTPEndpointsResponse]
+	hTTPEndpointUpdate   *connect.Client[v1.HTTPEndpointUpdateRequest, v1.HTTPEndpointUpdateEvent]
+	reportResourceStatus *connect.Client[v1.ReportResourceStatusRequest, emptypb.Empty]
+	configurationUpdate  *connect.Client[v1.ConfigurationUpdateRequest, v1.ConfigurationUpdateEvent]
+	resiliencyUpdate     *connect.Client[v1.ResiliencyUpdateRequest, v1.ResiliencyUpdateEvent]
 }
 
 // ComponentUpdate calls dapr.proto.operator.v1.Operator.ComponentUpdate.
@@ -226,6 +261,21 @@ func (c *operatorClient) HTTPEndpointUpdate(ctx context.Context, req *connect.Re
 	return c.hTTPEndpointUpdate.CallServerStream(ctx, req)
 }
 
+// ReportResourceStatus calls dapr.proto.operator.v1.Operator.ReportResourceStatus.
+func (c *operatorClient) ReportResourceStatus(ctx context.Context) *connect.ClientStreamForClient[v1.ReportResourceStatusRequest, emptypb.Empty] {
+	return c.reportResourceStatus.CallClientStream(ctx)
+}
+
+// ConfigurationUpdate calls dapr.proto.operator.v1.Operator.ConfigurationUpdate.
+func (c *operatorClient) ConfigurationUpdate(ctx context.Context, req *connect.Request[v1.ConfigurationUpdateRequest]) (*connect.ServerStreamForClient[v1.ConfigurationUpdateEvent], error) {
+	return c.configurationUpdate.CallServerStream(ctx, req)
+}
+
+// ResiliencyUpdate calls dapr.proto.operator.v1.Operator.ResiliencyUpdate.
+func (c *operatorClient) ResiliencyUpdate(ctx context.Context, req *connect.Request[v1.ResiliencyUpdateRequest]) (*connect.ServerStreamForClient[v1.ResiliencyUpdateEvent], error) {
+	return c.resiliencyUpdate.CallServerStream(ctx, req)
+}
+
 // OperatorHandler is an implementation of the dapr.proto.operator.v1.Operator service.
 type OperatorHandler interface {
 	// Sends events to Dapr sidecars upon component changes.
@@ -248,6 +298,14 @@ type OperatorHandler interface {
 	ListHTTPEndpoints(context.Context, *connect.Request[v1.ListHTTPEndpointsRequest]) (*connect.Response[v1.ListHTTPEndpointsResponse], error)
 	// Sends events to Dapr sidecars upon http endpoint changes.
 	HTTPEndpointUpdate(context.Context, *connect.Request[v1.HTTPEndpointUpdateRequest], *connect.ServerStream[v1.HTTPEndpointUpdateEvent]) error
+	// Streams the results of the resources loaded by a Dapr sidecar, which are
+	// aggregated into the status of the resources. The results of a sidecar are
+	// discarded when its stream closes.
+	ReportResourceStatus(context.Context, *connect.ClientStream[v1.ReportResourceStatusRequest]) (*connect.Response[emptypb.Empty], error)
+	// Sends events to Dapr sidecars upon changes to the configuration they use.
+	ConfigurationUpdate(context.Context, *connect.Request[v1.ConfigurationUpdateRequest], *connect.ServerStream[v1.ConfigurationUpdateEvent]) error
+	// Sends events to Dapr sidecars upon resiliency changes.
+	ResiliencyUpdate(context.Context, *connect.Request[v1.ResiliencyUpdateRequest], *connect.ServerStream[v1.ResiliencyUpdateEvent]) error
 }
 
 // NewOperatorHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -306,6 +364,21 @@ func NewOperatorHandler(svc OperatorHandler, opts ...connect.HandlerOption) (str
 		svc.HTTPEndpointUpdate,
 		opts...,
 	)
+	operatorReportResourceStatusHandler := connect.NewClientStreamHandler(
+		OperatorReportResourceStatusProcedure,
+		svc.ReportResourceStatus,
+		opts...,
+	)
+	operatorConfigurationUpdateHandler := connect.NewServerStreamHandler(
+		OperatorConfigurationUpdateProcedure,
+		svc.ConfigurationUpdate,
+		opts...,
+	)
+	operatorResiliencyUpdateHandler := connect.NewServerStreamHandler(
+		OperatorResiliencyUpdateProcedure,
+		svc.ResiliencyUpdate,
+		opts...,
+	)
 	return "/dapr.proto.operator.v1.Operator/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case OperatorComponentUpdateProcedure:
@@ -328,6 +401,12 @@ func NewOperatorHandler(svc OperatorHandler, opts ...connect.HandlerOption) (str
 			operatorListHTTPEndpointsHandler.ServeHTTP(w, r)
 		case OperatorHTTPEndpointUpdateProcedure:
 			operatorHTTPEndpointUpdateHandler.ServeHTTP(w, r)
+		case OperatorReportResourceStatusProcedure:
+			operatorReportResourceStatusHandler.ServeHTTP(w, r)
+		case OperatorConfigurationUpdateProcedure:
+			operatorConfigurationUpdateHandler.ServeHTTP(w, r)
+		case OperatorResiliencyUpdateProcedure:
+			operatorResiliencyUpdateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -376,3 +455,15 @@ func (UnimplementedOperatorHandler) ListHTTPEndpoints(context.Context, *connect.
 func (UnimplementedOperatorHandler) HTTPEndpointUpdate(context.Context, *connect.Request[v1.HTTPEndpointUpdateRequest], *connect.ServerStream[v1.HTTPEndpointUpdateEvent]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.operator.v1.Operator.HTTPEndpointUpdate is not implemented"))
 }
+
+func (UnimplementedOperatorHandler) ReportResourceStatus(context.Context, *connect.ClientStream[v1.ReportResourceStatusRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.operator.v1.Operator.ReportResourceStatus is not implemented"))
+}
+
+func (UnimplementedOperatorHandler) ConfigurationUpdate(context.Context, *connect.Request[v1.ConfigurationUpdateRequest], *connect.ServerStream[v1.ConfigurationUpdateEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.operator.v1.Operator.ConfigurationUpdate is not implemented"))
+}
+
+func (UnimplementedOperatorHandler) ResiliencyUpdate(context.Context, *connect.Request[v1.ResiliencyUpdateRequest], *connect.ServerStream[v1.ResiliencyUpdateEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.operator.v1.Operator.ResiliencyUpdate is not implemented"))
+}
@@ -244,6 +244,9 @@ type Host struct {
 	// Version of the Actor APIs supported by the Dapr runtime
 	ApiLevel  uint32 `protobuf:"varint,7,opt,name=api_level,json=apiLevel,proto3" json:"api_level,omitempty"`
 	Namespace string `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Capacity of the host relative to the other hosts of its actor types, which
+	// are assigned to it in proportion. Unset is the default weight of 1.
+	Weight uint32 `protobuf:"varint,9,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
 func (x *Host) Reset() {
@@ -334,6 +337,406 @@ func (x *Host) GetNamespace() string {
 	return ""
 }
 
+func (x *Host) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type LookupActorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ActorType string `protobuf:"bytes,2,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	ActorId   string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+}
+
+func (x *LookupActorRequest) Reset() {
+	*x = LookupActorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_placement_v1_placement_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupActorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupActorRequest) ProtoMessage() {}
+
+func (x *LookupActorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_placement_v1_placement_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupActorRequest.ProtoReflect.Descriptor instead.
+func (*LookupActorRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_placement_v1_placement_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LookupActorRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *LookupActorRequest) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *LookupActorRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type LookupActorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The host which owns the actor.
+	Host *Host `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Version of the placement tables the actor was resolved with.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *LookupActorResponse) Reset() {
+	*x = LookupActorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_placement_v1_placement_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupActorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupActorResponse) ProtoMessage() {}
+
+func (x *LookupActorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_placement_v1_placement_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupActorResponse.ProtoReflect.Descriptor instead.
+func (*LookupActorResponse) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_placement_v1_placement_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LookupActorResponse) GetHost() *Host {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *LookupActorResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type GetPlacementLoadsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *GetPlacementLoadsRequest) Reset() {
+	*x = GetPlacementLoadsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_placement_v1_placement_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlacementLoadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlacementLoadsRequest) ProtoMessage() {}
+
+func (x *GetPlacementLoadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_placement_v1_placement_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlacementLoadsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlacementLoadsRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_placement_v1_placement_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPlacementLoadsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetPlacementLoadsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorTypes []*ActorTypeLoads `protobuf:"bytes,1,rep,name=actor_types,json=actorTypes,proto3" json:"actor_types,omitempty"`
+	// Version of the placement tables the loads were computed from.
+	Version           string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ReplicationFactor int64  `protobuf:"varint,3,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
+}
+
+func (x *GetPlacementLoadsResponse) Reset() {
+	*x = GetPlacementLoadsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_placement_v1_placement_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlacementLoadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlacementLoadsResponse) ProtoMessage() {}
+
+func (x *GetPlacementLoadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_placement_v1_placement_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlacementLoadsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlacementLoadsResponse) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_placement_v1_placement_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPlacementLoadsResponse) GetActorTypes() []*ActorTypeLoads {
+	if x != nil {
+		return x.ActorTypes
+	}
+	return nil
+}
+
+func (x *GetPlacementLoadsResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetPlacementLoadsResponse) GetReplicationFactor() int64 {
+	if x != nil {
+		return x.ReplicationFactor
+	}
+	return 0
+}
+
+type ActorTypeLoads struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorType string      `protobuf:"bytes,1,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	Hosts     []*HostLoad `protobuf:"bytes,2,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	TotalLoad int64       `protobuf:"varint,3,opt,name=total_load,json=totalLoad,proto3" json:"total_load,omitempty"`
+}
+
+func (x *ActorTypeLoads) Reset() {
+	*x = ActorTypeLoads{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_placement_v1_placement_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActorTypeLoads) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActorTypeLoads) ProtoMessage() {}
+
+func (x *ActorTypeLoads) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_placement_v1_placement_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActorTypeLoads.ProtoReflect.Descriptor instead.
+func (*ActorTypeLoads) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_placement_v1_placement_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ActorTypeLoads) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *ActorTypeLoads) GetHosts() []*HostLoad {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *ActorTypeLoads) GetTotalLoad() int64 {
+	if x != nil {
+		return x.TotalLoad
+	}
+	return 0
+}
+
+type HostLoad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id   string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Port int64  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Load int64  `protobuf:"varint,4,opt,name=load,proto3" json:"load,omitempty"`
+	// Number of virtual nodes of the host on the hash ring.
+	VirtualNodes int64 `protobuf:"varint,5,opt,name=virtual_nodes,json=virtualNodes,proto3" json:"virtual_nodes,omitempty"`
+	// Fraction of the hash ring owned by the virtual nodes of the host, which is
+	// the share of actor IDs of the actor type assigned to it.
+	VirtualNodeShare float64 `protobuf:"fixed64,6,opt,name=virtual_node_share,json=virtualNodeShare,proto3" json:"virtual_node_share,omitempty"`
+	// Weight of the host, if applied to the hash ring.
+	Weight uint32 `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *HostLoad) Reset() {
+	*x = HostLoad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_placement_v1_placement_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostLoad) ProtoMessage() {}
+
+func (x *HostLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_placement_v1_placement_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostLoad.ProtoReflect.Descriptor instead.
+func (*HostLoad) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_placement_v1_placement_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HostLoad) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HostLoad) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HostLoad) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *HostLoad) GetLoad() int64 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+func (x *HostLoad) GetVirtualNodes() int64 {
+	if x != nil {
+		return x.VirtualNodes
+	}
+	return 0
+}
+
+func (x *HostLoad) GetVirtualNodeShare() float64 {
+	if x != nil {
+		return x.VirtualNodeShare
+	}
+	return 0
+}
+
+func (x *HostLoad) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
 var File_dapr_proto_placement_v1_placement_proto protoreflect.FileDescriptor
 
 var file_dapr_proto_placement_v1_placement_proto_rawDesc = []byte{
@@ -391,7 +794,7 @@ var file_dapr_proto_placement_v1_placement_proto_rawDesc = []byte{
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x61,
 	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
 	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbb, 0x01, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x12,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd3, 0x01, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x12,
 	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
 	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
 	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03,
@@ -403,18 +806,83 @@ var file_dapr_proto_placement_v1_placement_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x70, 0x69,
 	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
 	0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x32, 0x6d, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x60, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x70, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x48,
-	0x6f, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x00, 0x28, 0x01,
-	0x30, 0x01, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76,
-	0x31, 0x3b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x6c, 0x0a, 0x12, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x62, 0x0a, 0x13, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x38, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x6f,
+	0x61, 0x64, 0x73, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x61, 0x70, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x05, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f,
+	0x61, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x76, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x32, 0xd7, 0x02, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x60, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61,
+	0x70, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x0b, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2b, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x7c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x31, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f,
+	0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x4c, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64,
+	0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -429,30 +897,43 @@ func file_dapr_proto_placement_v1_placement_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_placement_v1_placement_proto_rawDescData
 }
 
-var file_dapr_proto_placement_v1_placement_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_dapr_proto_placement_v1_placement_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_dapr_proto_placement_v1_placement_proto_goTypes = []interface{}{
-	(*PlacementOrder)(nil),  // 0: dapr.proto.placement.v1.PlacementOrder
-	(*PlacementTables)(nil), // 1: dapr.proto.placement.v1.PlacementTables
-	(*PlacementTable)(nil),  // 2: dapr.proto.placement.v1.PlacementTable
-	(*Host)(nil),            // 3: dapr.proto.placement.v1.Host
-	nil,                     // 4: dapr.proto.placement.v1.PlacementTables.EntriesEntry
-	nil,                     // 5: dapr.proto.placement.v1.PlacementTable.HostsEntry
-	nil,                     // 6: dapr.proto.placement.v1.PlacementTable.LoadMapEntry
+	(*PlacementOrder)(nil),            // 0: dapr.proto.placement.v1.PlacementOrder
+	(*PlacementTables)(nil),           // 1: dapr.proto.placement.v1.PlacementTables
+	(*PlacementTable)(nil),            // 2: dapr.proto.placement.v1.PlacementTable
+	(*Host)(nil),                      // 3: dapr.proto.placement.v1.Host
+	(*LookupActorRequest)(nil),        // 4: dapr.proto.placement.v1.LookupActorRequest
+	(*LookupActorResponse)(nil),       // 5: dapr.proto.placement.v1.LookupActorResponse
+	(*GetPlacementLoadsRequest)(nil),  // 6: dapr.proto.placement.v1.GetPlacementLoadsRequest
+	(*GetPlacementLoadsResponse)(nil), // 7: dapr.proto.placement.v1.GetPlacementLoadsResponse
+	(*ActorTypeLoads)(nil),            // 8: dapr.proto.placement.v1.ActorTypeLoads
+	(*HostLoad)(nil),                  // 9: dapr.proto.placement.v1.HostLoad
+	nil,                               // 10: dapr.proto.placement.v1.PlacementTables.EntriesEntry
+	nil,                               // 11: dapr.proto.placement.v1.PlacementTable.HostsEntry
+	nil,                               // 12: dapr.proto.placement.v1.PlacementTable.LoadMapEntry
 }
 var file_dapr_proto_placement_v1_placement_proto_depIdxs = []int32{
-	1, // 0: dapr.proto.placement.v1.PlacementOrder.tables:type_name -> dapr.proto.placement.v1.PlacementTables
-	4, // 1: dapr.proto.placement.v1.PlacementTables.entries:type_name -> dapr.proto.placement.v1.PlacementTables.EntriesEntry
-	5, // 2: dapr.proto.placement.v1.PlacementTable.hosts:type_name -> dapr.proto.placement.v1.PlacementTable.HostsEntry
-	6, // 3: dapr.proto.placement.v1.PlacementTable.load_map:type_name -> dapr.proto.placement.v1.PlacementTable.LoadMapEntry
-	2, // 4: dapr.proto.placement.v1.PlacementTables.EntriesEntry.value:type_name -> dapr.proto.placement.v1.PlacementTable
-	3, // 5: dapr.proto.placement.v1.PlacementTable.LoadMapEntry.value:type_name -> dapr.proto.placement.v1.Host
-	3, // 6: dapr.proto.placement.v1.Placement.ReportDaprStatus:input_type -> dapr.proto.placement.v1.Host
-	0, // 7: dapr.proto.placement.v1.Placement.ReportDaprStatus:output_type -> dapr.proto.placement.v1.PlacementOrder
-	7, // [7:8] is the sub-list for method output_type
-	6, // [6:7] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	1,  // 0: dapr.proto.placement.v1.PlacementOrder.tables:type_name -> dapr.proto.placement.v1.PlacementTables
+	10, // 1: dapr.proto.placement.v1.PlacementTables.entries:type_name -> dapr.proto.placement.v1.PlacementTables.EntriesEntry
+	11, // 2: dapr.proto.placement.v1.PlacementTable.hosts:type_name -> dapr.proto.placement.v1.PlacementTable.HostsEntry
+	12, // 3: dapr.proto.placement.v1.PlacementTable.load_map:type_name -> dapr.proto.placement.v1.PlacementTable.LoadMapEntry
+	3,  // 4: dapr.proto.placement.v1.LookupActorResponse.host:type_name -> dapr.proto.placement.v1.Host
+	8,  // 5: dapr.proto.placement.v1.GetPlacementLoadsResponse.actor_types:type_name -> dapr.proto.placement.v1.ActorTypeLoads
+	9,  // 6: dapr.proto.placement.v1.ActorTypeLoads.hosts:type_name -> dapr.proto.placement.v1.HostLoad
+	2,  // 7: dapr.proto.placement.v1.PlacementTables.EntriesEntry.value:type_name -> dapr.proto.placement.v1.PlacementTable
+	3,  // 8: dapr.proto.placement.v1.PlacementTable.LoadMapEntry.value:type_name -> dapr.proto.placement.v1.Host
+	3,  // 9: dapr.proto.placement.v1.Placement.ReportDaprStatus:input_type -> dapr.proto.placement.v1.Host
+	4,  // 10: dapr.proto.placement.v1.Placement.LookupActor:input_type -> dapr.proto.placement.v1.LookupActorRequest
+	6,  // 11: dapr.proto.placement.v1.Placement.GetPlacementLoads:input_type -> dapr.proto.placement.v1.GetPlacementLoadsRequest
+	0,  // 12: dapr.proto.placement.v1.Placement.ReportDaprStatus:output_type -> dapr.proto.placement.v1.PlacementOrder
+	5,  // 13: dapr.proto.placement.v1.Placement.LookupActor:output_type -> dapr.proto.placement.v1.LookupActorResponse
+	7,  // 14: dapr.proto.placement.v1.Placement.GetPlacementLoads:output_type -> dapr.proto.placement.v1.GetPlacementLoadsResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_dapr_proto_placement_v1_placement_proto_init() }
@@ -509,6 +990,78 @@ func file_dapr_proto_placement_v1_placement_proto_init() {
 				return nil
 			}
 		}
+		file_dapr_proto_placement_v1_placement_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupActorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_placement_v1_placement_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupActorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_placement_v1_placement_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlacementLoadsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_placement_v1_placement_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlacementLoadsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_placement_v1_placement_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActorTypeLoads); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_placement_v1_placement_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostLoad); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -516,7 +1069,7 @@ func file_dapr_proto_placement_v1_placement_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_placement_v1_placement_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
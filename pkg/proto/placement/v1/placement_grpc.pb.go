@@ -31,7 +31,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Placement_ReportDaprStatus_FullMethodName = "/dapr.proto.placement.v1.Placement/ReportDaprStatus"
+	Placement_ReportDaprStatus_FullMethodName  = "/dapr.proto.placement.v1.Placement/ReportDaprStatus"
+	Placement_LookupActor_FullMethodName       = "/dapr.proto.placement.v1.Placement/LookupActor"
+	Placement_GetPlacementLoads_FullMethodName = "/dapr.proto.placement.v1.Placement/GetPlacementLoads"
 )
 
 // PlacementClient is the client API for Placement service.
@@ -40,6 +42,10 @@ const (
 type PlacementClient interface {
 	// Reports Dapr actor status and retrieves actor placement table.
 	ReportDaprStatus(ctx context.Context, opts ...grpc.CallOption) (Placement_ReportDaprStatusClient, error)
+	// Returns the host which the placement tables assign an actor to.
+	LookupActor(ctx context.Context, in *LookupActorRequest, opts ...grpc.CallOption) (*LookupActorResponse, error)
+	// Returns how the actor types of a namespace are spread across their hosts.
+	GetPlacementLoads(ctx context.Context, in *GetPlacementLoadsRequest, opts ...grpc.CallOption) (*GetPlacementLoadsResponse, error)
 }
 
 type placementClient struct {
@@ -81,12 +87,34 @@ func (x *placementReportDaprStatusClient) Recv() (*PlacementOrder, error) {
 	return m, nil
 }
 
+func (c *placementClient) LookupActor(ctx context.Context, in *LookupActorRequest, opts ...grpc.CallOption) (*LookupActorResponse, error) {
+	out := new(LookupActorResponse)
+	err := c.cc.Invoke(ctx, Placement_LookupActor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placementClient) GetPlacementLoads(ctx context.Context, in *GetPlacementLoadsRequest, opts ...grpc.CallOption) (*GetPlacementLoadsResponse, error) {
+	out := new(GetPlacementLoadsResponse)
+	err := c.cc.Invoke(ctx, Placement_GetPlacementLoads_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PlacementServer is the server API for Placement service.
 // All implementations should embed UnimplementedPlacementServer
 // for forward compatibility
 type PlacementServer interface {
 	// Reports Dapr actor status and retrieves actor placement table.
 	ReportDaprStatus(Placement_ReportDaprStatusServer) error
+	// Returns the host which the placement tables assign an actor to.
+	LookupActor(context.Context, *LookupActorRequest) (*LookupActorResponse, error)
+	// Returns how the actor types of a namespace are spread across their hosts.
+	GetPlacementLoads(context.Context, *GetPlacementLoadsRequest) (*GetPlacementLoadsResponse, error)
 }
 
 // UnimplementedPlacementServer should be embedded to have forward compatible implementations.
@@ -96,6 +124,12 @@ type UnimplementedPlacementServer struct {
 func (UnimplementedPlacementServer) ReportDaprStatus(Placement_ReportDaprStatusServer) error {
 	return status.Errorf(codes.Unimplemented, "method ReportDaprStatus not implemented")
 }
+func (UnimplementedPlacementServer) LookupActor(context.Context, *LookupActorRequest) (*LookupActorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupActor not implemented")
+}
+func (UnimplementedPlacementServer) GetPlacementLoads(context.Context, *GetPlacementLoadsRequest) (*GetPlacementLoadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlacementLoads not implemented")
+}
 
 // UnsafePlacementServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PlacementServer will
@@ -134,13 +168,58 @@ func (x *placementReportDaprStatusServer) Recv() (*Host, error) {
 	return m, nil
 }
 
+func _Placement_LookupActor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupActorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlacementServer).LookupActor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Placement_LookupActor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlacementServer).LookupActor(ctx, req.(*LookupActorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Placement_GetPlacementLoads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlacementLoadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlacementServer).GetPlacementLoads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Placement_GetPlacementLoads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlacementServer).GetPlacementLoads(ctx, req.(*GetPlacementLoadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Placement_ServiceDesc is the grpc.ServiceDesc for Placement service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Placement_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "dapr.proto.placement.v1.Placement",
 	HandlerType: (*PlacementServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LookupActor",
+			Handler:    _Placement_LookupActor_Handler,
+		},
+		{
+			MethodName: "GetPlacementLoads",
+			Handler:    _Placement_GetPlacementLoads_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "ReportDaprStatus",
@@ -48,12 +48,21 @@ const (
 	// PlacementReportDaprStatusProcedure is the fully-qualified name of the Placement's
 	// ReportDaprStatus RPC.
 	PlacementReportDaprStatusProcedure = "/dapr.proto.placement.v1.Placement/ReportDaprStatus"
+	// PlacementLookupActorProcedure is the fully-qualified name of the Placement's LookupActor RPC.
+	PlacementLookupActorProcedure = "/dapr.proto.placement.v1.Placement/LookupActor"
+	// PlacementGetPlacementLoadsProcedure is the fully-qualified name of the Placement's
+	// GetPlacementLoads RPC.
+	PlacementGetPlacementLoadsProcedure = "/dapr.proto.placement.v1.Placement/GetPlacementLoads"
 )
 
 // PlacementClient is a client for the dapr.proto.placement.v1.Placement service.
 type PlacementClient interface {
 	// Reports Dapr actor status and retrieves actor placement table.
 	ReportDaprStatus(context.Context) *connect.BidiStreamForClient[v1.Host, v1.PlacementOrder]
+	// Returns the host which the placement tables assign an actor to.
+	LookupActor(context.Context, *connect.Request[v1.LookupActorRequest]) (*connect.Response[v1.LookupActorResponse], error)
+	// Returns how the actor types of a namespace are spread across their hosts.
+	GetPlacementLoads(context.Context, *connect.Request[v1.GetPlacementLoadsRequest]) (*connect.Response[v1.GetPlacementLoadsResponse], error)
 }
 
 // NewPlacementClient constructs a client for the dapr.proto.placement.v1.Placement service. By
@@ -71,12 +80,24 @@ func NewPlacementClient(httpClient connect.HTTPClient, baseURL string, opts ...c
 			baseURL+PlacementReportDaprStatusProcedure,
 			opts...,
 		),
+		lookupActor: connect.NewClient[v1.LookupActorRequest, v1.LookupActorResponse](
+			httpClient,
+			baseURL+PlacementLookupActorProcedure,
+			opts...,
+		),
+		getPlacementLoads: connect.NewClient[v1.GetPlacementLoadsRequest, v1.GetPlacementLoadsResponse](
+			httpClient,
+			baseURL+PlacementGetPlacementLoadsProcedure,
+			opts...,
+		),
 	}
 }
 
 // placementClient implements PlacementClient.
 type placementClient struct {
-	reportDaprStatus *connect.Client[v1.Host, v1.PlacementOrder]
+	reportDaprStatus  *connect.Client[v1.Host, v1.PlacementOrder]
+	lookupActor       *connect.Client[v1.LookupActorRequest, v1.LookupActorResponse]
+	getPlacementLoads *connect.Client[v1.GetPlacementLoadsRequest, v1.GetPlacementLoadsResponse]
 }
 
 // ReportDaprStatus calls dapr.proto.placement.v1.Placement.ReportDaprStatus.
@@ -84,10 +105,24 @@ func (c *placementClient) ReportDaprStatus(ctx context.Context) *connect.BidiStr
 	return c.reportDaprStatus.CallBidiStream(ctx)
 }
 
+// LookupActor calls dapr.proto.placement.v1.Placement.LookupActor.
+func (c *placementClient) LookupActor(ctx context.Context, req *connect.Request[v1.LookupActorRequest]) (*connect.Response[v1.LookupActorResponse], error) {
+	return c.lookupActor.CallUnary(ctx, req)
+}
+
+// GetPlacementLoads calls dapr.proto.placement.v1.Placement.GetPlacementLoads.
+func (c *placementClient) GetPlacementLoads(ctx context.Context, req *connect.Request[v1.GetPlacementLoadsRequest]) (*connect.Response[v1.GetPlacementLoadsResponse], error) {
+	return c.getPlacementLoads.CallUnary(ctx, req)
+}
+
 // PlacementHandler is an implementation of the dapr.proto.placement.v1.Placement service.
 type PlacementHandler interface {
 	// Reports Dapr actor status and retrieves actor placement table.
 	ReportDaprStatus(context.Context, *connect.BidiStream[v1.Host, v1.PlacementOrder]) error
+	// Returns the host which the placement tables assign an actor to.
+	LookupActor(context.Context, *connect.Request[v1.LookupActorRequest]) (*connect.Response[v1.LookupActorResponse], error)
+	// Returns how the actor types of a namespace are spread across their hosts.
+	GetPlacementLoads(context.Context, *connect.Request[v1.GetPlacementLoadsRequest]) (*connect.Response[v1.GetPlacementLoadsResponse], error)
 }
 
 // NewPlacementHandler builds an HTTP handler from the service implementation. It returns the path
@@ -101,10 +136,24 @@ func NewPlacementHandler(svc PlacementHandler, opts ...connect.HandlerOption) (s
 		svc.ReportDaprStatus,
 		opts...,
 	)
+	placementLookupActorHandler := connect.NewUnaryHandler(
+		PlacementLookupActorProcedure,
+		svc.LookupActor,
+		opts...,
+	)
+	placementGetPlacementLoadsHandler := connect.NewUnaryHandler(
+		PlacementGetPlacementLoadsProcedure,
+		svc.GetPlacementLoads,
+		opts...,
+	)
 	return "/dapr.proto.placement.v1.Placement/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PlacementReportDaprStatusProcedure:
 			placementReportDaprStatusHandler.ServeHTTP(w, r)
+		case PlacementLookupActorProcedure:
+			placementLookupActorHandler.ServeHTTP(w, r)
+		case PlacementGetPlacementLoadsProcedure:
+			placementGetPlacementLoadsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -117,3 +166,11 @@ type UnimplementedPlacementHandler struct{}
 func (UnimplementedPlacementHandler) ReportDaprStatus(context.Context, *connect.BidiStream[v1.Host, v1.PlacementOrder]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.placement.v1.Placement.ReportDaprStatus is not implemented"))
 }
+
+func (UnimplementedPlacementHandler) LookupActor(context.Context, *connect.Request[v1.LookupActorRequest]) (*connect.Response[v1.LookupActorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.placement.v1.Placement.LookupActor is not implemented"))
+}
+
+func (UnimplementedPlacementHandler) GetPlacementLoads(context.Context, *connect.Request[v1.GetPlacementLoadsRequest]) (*connect.Response[v1.GetPlacementLoadsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.placement.v1.Placement.GetPlacementLoads is not implemented"))
+}
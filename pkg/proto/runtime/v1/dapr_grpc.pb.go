@@ -33,71 +33,73 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Dapr_InvokeService_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/InvokeService"
-	Dapr_GetState_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/GetState"
-	Dapr_GetBulkState_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetBulkState"
-	Dapr_SaveState_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/SaveState"
-	Dapr_QueryStateAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/QueryStateAlpha1"
-	Dapr_DeleteState_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/DeleteState"
-	Dapr_DeleteBulkState_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/DeleteBulkState"
-	Dapr_ExecuteStateTransaction_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/ExecuteStateTransaction"
-	Dapr_PublishEvent_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/PublishEvent"
-	Dapr_BulkPublishEventAlpha1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/BulkPublishEventAlpha1"
-	Dapr_SubscribeTopicEventsAlpha1_FullMethodName     = "/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1"
-	Dapr_InvokeBinding_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/InvokeBinding"
-	Dapr_GetSecret_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/GetSecret"
-	Dapr_GetBulkSecret_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/GetBulkSecret"
-	Dapr_RegisterActorTimer_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/RegisterActorTimer"
-	Dapr_UnregisterActorTimer_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/UnregisterActorTimer"
-	Dapr_RegisterActorReminder_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/RegisterActorReminder"
-	Dapr_UnregisterActorReminder_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/UnregisterActorReminder"
-	Dapr_UnregisterActorRemindersByType_FullMethodName = "/dapr.proto.runtime.v1.Dapr/UnregisterActorRemindersByType"
-	Dapr_ListActorReminders_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/ListActorReminders"
-	Dapr_GetActorState_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/GetActorState"
-	Dapr_GetActorReminder_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/GetActorReminder"
-	Dapr_ExecuteActorStateTransaction_FullMethodName   = "/dapr.proto.runtime.v1.Dapr/ExecuteActorStateTransaction"
-	Dapr_InvokeActor_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/InvokeActor"
-	Dapr_GetConfigurationAlpha1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/GetConfigurationAlpha1"
-	Dapr_GetConfiguration_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/GetConfiguration"
-	Dapr_SubscribeConfigurationAlpha1_FullMethodName   = "/dapr.proto.runtime.v1.Dapr/SubscribeConfigurationAlpha1"
-	Dapr_SubscribeConfiguration_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/SubscribeConfiguration"
-	Dapr_UnsubscribeConfigurationAlpha1_FullMethodName = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfigurationAlpha1"
-	Dapr_UnsubscribeConfiguration_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfiguration"
-	Dapr_TryLockAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/TryLockAlpha1"
-	Dapr_UnlockAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/UnlockAlpha1"
-	Dapr_EncryptAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/EncryptAlpha1"
-	Dapr_DecryptAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/DecryptAlpha1"
-	Dapr_GetMetadata_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/GetMetadata"
-	Dapr_SetMetadata_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/SetMetadata"
-	Dapr_SubtleGetKeyAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleGetKeyAlpha1"
-	Dapr_SubtleEncryptAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/SubtleEncryptAlpha1"
-	Dapr_SubtleDecryptAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/SubtleDecryptAlpha1"
-	Dapr_SubtleWrapKeyAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/SubtleWrapKeyAlpha1"
-	Dapr_SubtleUnwrapKeyAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/SubtleUnwrapKeyAlpha1"
-	Dapr_SubtleSignAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/SubtleSignAlpha1"
-	Dapr_SubtleVerifyAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleVerifyAlpha1"
-	Dapr_StartWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/StartWorkflowAlpha1"
-	Dapr_GetWorkflowAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/GetWorkflowAlpha1"
-	Dapr_PurgeWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowAlpha1"
-	Dapr_TerminateWorkflowAlpha1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/TerminateWorkflowAlpha1"
-	Dapr_PauseWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowAlpha1"
-	Dapr_ResumeWorkflowAlpha1_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowAlpha1"
-	Dapr_RaiseEventWorkflowAlpha1_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowAlpha1"
-	Dapr_StartWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/StartWorkflowBeta1"
-	Dapr_GetWorkflowBeta1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/GetWorkflowBeta1"
-	Dapr_PurgeWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowBeta1"
-	Dapr_TerminateWorkflowBeta1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/TerminateWorkflowBeta1"
-	Dapr_PauseWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowBeta1"
-	Dapr_ResumeWorkflowBeta1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowBeta1"
-	Dapr_RaiseEventWorkflowBeta1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowBeta1"
-	Dapr_Shutdown_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/Shutdown"
-	Dapr_ScheduleJobAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/ScheduleJobAlpha1"
-	Dapr_GetJobAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetJobAlpha1"
-	Dapr_DeleteJobAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/DeleteJobAlpha1"
-	Dapr_DeleteJobsByPrefixAlpha1_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/DeleteJobsByPrefixAlpha1"
-	Dapr_ListJobsAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ListJobsAlpha1"
-	Dapr_ConverseAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha1"
-	Dapr_ConverseAlpha2_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha2"
+	Dapr_InvokeService_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/InvokeService"
+	Dapr_GetState_FullMethodName                          = "/dapr.proto.runtime.v1.Dapr/GetState"
+	Dapr_GetBulkState_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/GetBulkState"
+	Dapr_SaveState_FullMethodName                         = "/dapr.proto.runtime.v1.Dapr/SaveState"
+	Dapr_QueryStateAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/QueryStateAlpha1"
+	Dapr_DeleteState_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/DeleteState"
+	Dapr_DeleteBulkState_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/DeleteBulkState"
+	Dapr_ExecuteStateTransaction_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/ExecuteStateTransaction"
+	Dapr_SubscribeStateChangesAlpha1_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/SubscribeStateChangesAlpha1"
+	Dapr_PublishEvent_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/PublishEvent"
+	Dapr_CancelScheduledPublishEventAlpha1_FullMethodName = "/dapr.proto.runtime.v1.Dapr/CancelScheduledPublishEventAlpha1"
+	Dapr_BulkPublishEventAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/BulkPublishEventAlpha1"
+	Dapr_SubscribeTopicEventsAlpha1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1"
+	Dapr_InvokeBinding_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/InvokeBinding"
+	Dapr_GetSecret_FullMethodName                         = "/dapr.proto.runtime.v1.Dapr/GetSecret"
+	Dapr_GetBulkSecret_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/GetBulkSecret"
+	Dapr_RegisterActorTimer_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/RegisterActorTimer"
+	Dapr_UnregisterActorTimer_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/UnregisterActorTimer"
+	Dapr_RegisterActorReminder_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/RegisterActorReminder"
+	Dapr_UnregisterActorReminder_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/UnregisterActorReminder"
+	Dapr_UnregisterActorRemindersByType_FullMethodName    = "/dapr.proto.runtime.v1.Dapr/UnregisterActorRemindersByType"
+	Dapr_ListActorReminders_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/ListActorReminders"
+	Dapr_GetActorState_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/GetActorState"
+	Dapr_GetActorReminder_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/GetActorReminder"
+	Dapr_ExecuteActorStateTransaction_FullMethodName      = "/dapr.proto.runtime.v1.Dapr/ExecuteActorStateTransaction"
+	Dapr_InvokeActor_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/InvokeActor"
+	Dapr_GetConfigurationAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/GetConfigurationAlpha1"
+	Dapr_GetConfiguration_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/GetConfiguration"
+	Dapr_SubscribeConfigurationAlpha1_FullMethodName      = "/dapr.proto.runtime.v1.Dapr/SubscribeConfigurationAlpha1"
+	Dapr_SubscribeConfiguration_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/SubscribeConfiguration"
+	Dapr_UnsubscribeConfigurationAlpha1_FullMethodName    = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfigurationAlpha1"
+	Dapr_UnsubscribeConfiguration_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfiguration"
+	Dapr_TryLockAlpha1_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/TryLockAlpha1"
+	Dapr_UnlockAlpha1_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/UnlockAlpha1"
+	Dapr_EncryptAlpha1_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/EncryptAlpha1"
+	Dapr_DecryptAlpha1_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/DecryptAlpha1"
+	Dapr_GetMetadata_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/GetMetadata"
+	Dapr_SetMetadata_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/SetMetadata"
+	Dapr_SubtleGetKeyAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/SubtleGetKeyAlpha1"
+	Dapr_SubtleEncryptAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/SubtleEncryptAlpha1"
+	Dapr_SubtleDecryptAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/SubtleDecryptAlpha1"
+	Dapr_SubtleWrapKeyAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/SubtleWrapKeyAlpha1"
+	Dapr_SubtleUnwrapKeyAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleUnwrapKeyAlpha1"
+	Dapr_SubtleSignAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/SubtleSignAlpha1"
+	Dapr_SubtleVerifyAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/SubtleVerifyAlpha1"
+	Dapr_StartWorkflowAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/StartWorkflowAlpha1"
+	Dapr_GetWorkflowAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/GetWorkflowAlpha1"
+	Dapr_PurgeWorkflowAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowAlpha1"
+	Dapr_TerminateWorkflowAlpha1_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/TerminateWorkflowAlpha1"
+	Dapr_PauseWorkflowAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowAlpha1"
+	Dapr_ResumeWorkflowAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowAlpha1"
+	Dapr_RaiseEventWorkflowAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowAlpha1"
+	Dapr_StartWorkflowBeta1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/StartWorkflowBeta1"
+	Dapr_GetWorkflowBeta1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/GetWorkflowBeta1"
+	Dapr_PurgeWorkflowBeta1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowBeta1"
+	Dapr_TerminateWorkflowBeta1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/TerminateWorkflowBeta1"
+	Dapr_PauseWorkflowBeta1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowBeta1"
+	Dapr_ResumeWorkflowBeta1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowBeta1"
+	Dapr_RaiseEventWorkflowBeta1_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowBeta1"
+	Dapr_Shutdown_FullMethodName                          = "/dapr.proto.runtime.v1.Dapr/Shutdown"
+	Dapr_ScheduleJobAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ScheduleJobAlpha1"
+	Dapr_GetJobAlpha1_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/GetJobAlpha1"
+	Dapr_DeleteJobAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/DeleteJobAlpha1"
+	Dapr_DeleteJobsByPrefixAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/DeleteJobsByPrefixAlpha1"
+	Dapr_ListJobsAlpha1_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/ListJobsAlpha1"
+	Dapr_ConverseAlpha1_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha1"
+	Dapr_ConverseAlpha2_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha2"
 )
 
 // DaprClient is the client API for Dapr service.
@@ -121,8 +123,13 @@ type DaprClient interface {
 	DeleteBulkState(ctx context.Context, in *DeleteBulkStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Executes transactions for a specified store
 	ExecuteStateTransaction(ctx context.Context, in *ExecuteStateTransactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Subscribes to the changes of keys or key prefixes in a state store.
+	SubscribeStateChangesAlpha1(ctx context.Context, in *SubscribeStateChangesRequestAlpha1, opts ...grpc.CallOption) (Dapr_SubscribeStateChangesAlpha1Client, error)
 	// Publishes events to the specific topic.
 	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Cancels an event which was published with a delay and has not been
+	// delivered yet.
+	CancelScheduledPublishEventAlpha1(ctx context.Context, in *CancelScheduledPublishEventRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Bulk Publishes multiple events to the specified topic.
 	BulkPublishEventAlpha1(ctx context.Context, in *BulkPublishRequest, opts ...grpc.CallOption) (*BulkPublishResponse, error)
 	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic
@@ -321,6 +328,38 @@ func (c *daprClient) ExecuteStateTransaction(ctx context.Context, in *ExecuteSta
 	return out, nil
 }
 
+func (c *daprClient) SubscribeStateChangesAlpha1(ctx context.Context, in *SubscribeStateChangesRequestAlpha1, opts ...grpc.CallOption) (Dapr_SubscribeStateChangesAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[0], Dapr_SubscribeStateChangesAlpha1_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprSubscribeStateChangesAlpha1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dapr_SubscribeStateChangesAlpha1Client interface {
+	Recv() (*SubscribeStateChangesResponseAlpha1, error)
+	grpc.ClientStream
+}
+
+type daprSubscribeStateChangesAlpha1Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprSubscribeStateChangesAlpha1Client) Recv() (*SubscribeStateChangesResponseAlpha1, error) {
+	m := new(SubscribeStateChangesResponseAlpha1)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *daprClient) PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Dapr_PublishEvent_FullMethodName, in, out, opts...)
@@ -330,6 +369,15 @@ func (c *daprClient) PublishEvent(ctx context.Context, in *PublishEventRequest,
 	return out, nil
 }
 
+func (c *daprClient) CancelScheduledPublishEventAlpha1(ctx context.Context, in *CancelScheduledPublishEventRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Dapr_CancelScheduledPublishEventAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) BulkPublishEventAlpha1(ctx context.Context, in *BulkPublishRequest, opts ...grpc.CallOption) (*BulkPublishResponse, error) {
 	out := new(BulkPublishResponse)
 	err := c.cc.Invoke(ctx, Dapr_BulkPublishEventAlpha1_FullMethodName, in, out, opts...)
@@ -340,7 +388,7 @@ func (c *daprClient) BulkPublishEventAlpha1(ctx context.Context, in *BulkPublish
 }
 
 func (c *daprClient) SubscribeTopicEventsAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_SubscribeTopicEventsAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[0], Dapr_SubscribeTopicEventsAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[1], Dapr_SubscribeTopicEventsAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -506,7 +554,7 @@ func (c *daprClient) GetConfiguration(ctx context.Context, in *GetConfigurationR
 }
 
 func (c *daprClient) SubscribeConfigurationAlpha1(ctx context.Context, in *SubscribeConfigurationRequest, opts ...grpc.CallOption) (Dapr_SubscribeConfigurationAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[1], Dapr_SubscribeConfigurationAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[2], Dapr_SubscribeConfigurationAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -538,7 +586,7 @@ func (x *daprSubscribeConfigurationAlpha1Client) Recv() (*SubscribeConfiguration
 }
 
 func (c *daprClient) SubscribeConfiguration(ctx context.Context, in *SubscribeConfigurationRequest, opts ...grpc.CallOption) (Dapr_SubscribeConfigurationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[2], Dapr_SubscribeConfiguration_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[3], Dapr_SubscribeConfiguration_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -606,7 +654,7 @@ func (c *daprClient) UnlockAlpha1(ctx context.Context, in *UnlockRequest, opts .
 }
 
 func (c *daprClient) EncryptAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_EncryptAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[3], Dapr_EncryptAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[4], Dapr_EncryptAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -637,7 +685,7 @@ func (x *daprEncryptAlpha1Client) Recv() (*EncryptResponse, error) {
 }
 
 func (c *daprClient) DecryptAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_DecryptAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[4], Dapr_DecryptAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[5], Dapr_DecryptAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -974,8 +1022,13 @@ type DaprServer interface {
 	DeleteBulkState(context.Context, *DeleteBulkStateRequest) (*emptypb.Empty, error)
 	// Executes transactions for a specified store
 	ExecuteStateTransaction(context.Context, *ExecuteStateTransactionRequest) (*emptypb.Empty, error)
+	// Subscribes to the changes of keys or key prefixes in a state store.
+	SubscribeStateChangesAlpha1(*SubscribeStateChangesRequestAlpha1, Dapr_SubscribeStateChangesAlpha1Server) error
 	// Publishes events to the specific topic.
 	PublishEvent(context.Context, *PublishEventRequest) (*emptypb.Empty, error)
+	// Cancels an event which was published with a delay and has not been
+	// delivered yet.
+	CancelScheduledPublishEventAlpha1(context.Context, *CancelScheduledPublishEventRequestAlpha1) (*emptypb.Empty, error)
 	// Bulk Publishes multiple events to the specified topic.
 	BulkPublishEventAlpha1(context.Context, *BulkPublishRequest) (*BulkPublishResponse, error)
 	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic
@@ -1122,9 +1175,15 @@ func (UnimplementedDaprServer) DeleteBulkState(context.Context, *DeleteBulkState
 func (UnimplementedDaprServer) ExecuteStateTransaction(context.Context, *ExecuteStateTransactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteStateTransaction not implemented")
 }
+func (UnimplementedDaprServer) SubscribeStateChangesAlpha1(*SubscribeStateChangesRequestAlpha1, Dapr_SubscribeStateChangesAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeStateChangesAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) PublishEvent(context.Context, *PublishEventRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishEvent not implemented")
 }
+func (UnimplementedDaprServer) CancelScheduledPublishEventAlpha1(context.Context, *CancelScheduledPublishEventRequestAlpha1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledPublishEventAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) BulkPublishEventAlpha1(context.Context, *BulkPublishRequest) (*BulkPublishResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkPublishEventAlpha1 not implemented")
 }
@@ -1449,6 +1508,27 @@ func _Dapr_ExecuteStateTransaction_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_SubscribeStateChangesAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeStateChangesRequestAlpha1)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaprServer).SubscribeStateChangesAlpha1(m, &daprSubscribeStateChangesAlpha1Server{stream})
+}
+
+type Dapr_SubscribeStateChangesAlpha1Server interface {
+	Send(*SubscribeStateChangesResponseAlpha1) error
+	grpc.ServerStream
+}
+
+type daprSubscribeStateChangesAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprSubscribeStateChangesAlpha1Server) Send(m *SubscribeStateChangesResponseAlpha1) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Dapr_PublishEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PublishEventRequest)
 	if err := dec(in); err != nil {
@@ -1467,6 +1547,24 @@ func _Dapr_PublishEvent_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_CancelScheduledPublishEventAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledPublishEventRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).CancelScheduledPublishEventAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_CancelScheduledPublishEventAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).CancelScheduledPublishEventAlpha1(ctx, req.(*CancelScheduledPublishEventRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_BulkPublishEventAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BulkPublishRequest)
 	if err := dec(in); err != nil {
@@ -2548,6 +2646,10 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PublishEvent",
 			Handler:    _Dapr_PublishEvent_Handler,
 		},
+		{
+			MethodName: "CancelScheduledPublishEventAlpha1",
+			Handler:    _Dapr_CancelScheduledPublishEventAlpha1_Handler,
+		},
 		{
 			MethodName: "BulkPublishEventAlpha1",
 			Handler:    _Dapr_BulkPublishEventAlpha1_Handler,
@@ -2754,6 +2856,11 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeStateChangesAlpha1",
+			Handler:       _Dapr_SubscribeStateChangesAlpha1_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeTopicEventsAlpha1",
 			Handler:       _Dapr_SubscribeTopicEventsAlpha1_Handler,
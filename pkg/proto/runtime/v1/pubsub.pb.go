@@ -120,6 +120,66 @@ func (x *PublishEventRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+// CancelScheduledPublishEventRequestAlpha1 is the message to cancel an event
+// which was published with a delay and has not been delivered yet.
+type CancelScheduledPublishEventRequestAlpha1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the pubsub component
+	PubsubName string `protobuf:"bytes,1,opt,name=pubsub_name,json=pubsubName,proto3" json:"pubsub_name,omitempty"`
+	// The ID of the scheduled event, as returned in the dapr-scheduled-id header
+	// when it was published.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelScheduledPublishEventRequestAlpha1) Reset() {
+	*x = CancelScheduledPublishEventRequestAlpha1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledPublishEventRequestAlpha1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPublishEventRequestAlpha1) ProtoMessage() {}
+
+func (x *CancelScheduledPublishEventRequestAlpha1) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPublishEventRequestAlpha1.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPublishEventRequestAlpha1) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CancelScheduledPublishEventRequestAlpha1) GetPubsubName() string {
+	if x != nil {
+		return x.PubsubName
+	}
+	return ""
+}
+
+func (x *CancelScheduledPublishEventRequestAlpha1) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 // BulkPublishRequest is the message to bulk publish events to pubsub topic
 type BulkPublishRequest struct {
 	state         protoimpl.MessageState
@@ -139,7 +199,7 @@ type BulkPublishRequest struct {
 func (x *BulkPublishRequest) Reset() {
 	*x = BulkPublishRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[1]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -152,7 +212,7 @@ func (x *BulkPublishRequest) String() string {
 func (*BulkPublishRequest) ProtoMessage() {}
 
 func (x *BulkPublishRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[1]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,7 +225,7 @@ func (x *BulkPublishRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkPublishRequest.ProtoReflect.Descriptor instead.
 func (*BulkPublishRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{1}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *BulkPublishRequest) GetPubsubName() string {
@@ -215,7 +275,7 @@ type BulkPublishRequestEntry struct {
 func (x *BulkPublishRequestEntry) Reset() {
 	*x = BulkPublishRequestEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[2]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -228,7 +288,7 @@ func (x *BulkPublishRequestEntry) String() string {
 func (*BulkPublishRequestEntry) ProtoMessage() {}
 
 func (x *BulkPublishRequestEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[2]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +301,7 @@ func (x *BulkPublishRequestEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkPublishRequestEntry.ProtoReflect.Descriptor instead.
 func (*BulkPublishRequestEntry) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{2}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BulkPublishRequestEntry) GetEntryId() string {
@@ -285,7 +345,7 @@ type BulkPublishResponse struct {
 func (x *BulkPublishResponse) Reset() {
 	*x = BulkPublishResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[3]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -298,7 +358,7 @@ func (x *BulkPublishResponse) String() string {
 func (*BulkPublishResponse) ProtoMessage() {}
 
 func (x *BulkPublishResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[3]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +371,7 @@ func (x *BulkPublishResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkPublishResponse.ProtoReflect.Descriptor instead.
 func (*BulkPublishResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{3}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BulkPublishResponse) GetFailedEntries() []*BulkPublishResponseFailedEntry {
@@ -336,7 +396,7 @@ type BulkPublishResponseFailedEntry struct {
 func (x *BulkPublishResponseFailedEntry) Reset() {
 	*x = BulkPublishResponseFailedEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[4]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -349,7 +409,7 @@ func (x *BulkPublishResponseFailedEntry) String() string {
 func (*BulkPublishResponseFailedEntry) ProtoMessage() {}
 
 func (x *BulkPublishResponseFailedEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[4]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +422,7 @@ func (x *BulkPublishResponseFailedEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkPublishResponseFailedEntry.ProtoReflect.Descriptor instead.
 func (*BulkPublishResponseFailedEntry) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{4}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BulkPublishResponseFailedEntry) GetEntryId() string {
@@ -398,7 +458,7 @@ type SubscribeTopicEventsRequestAlpha1 struct {
 func (x *SubscribeTopicEventsRequestAlpha1) Reset() {
 	*x = SubscribeTopicEventsRequestAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -411,7 +471,7 @@ func (x *SubscribeTopicEventsRequestAlpha1) String() string {
 func (*SubscribeTopicEventsRequestAlpha1) ProtoMessage() {}
 
 func (x *SubscribeTopicEventsRequestAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,7 +484,7 @@ func (x *SubscribeTopicEventsRequestAlpha1) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SubscribeTopicEventsRequestAlpha1.ProtoReflect.Descriptor instead.
 func (*SubscribeTopicEventsRequestAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{5}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{6}
 }
 
 func (m *SubscribeTopicEventsRequestAlpha1) GetSubscribeTopicEventsRequestType() isSubscribeTopicEventsRequestAlpha1_SubscribeTopicEventsRequestType {
@@ -490,7 +550,7 @@ type SubscribeTopicEventsRequestInitialAlpha1 struct {
 func (x *SubscribeTopicEventsRequestInitialAlpha1) Reset() {
 	*x = SubscribeTopicEventsRequestInitialAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -503,7 +563,7 @@ func (x *SubscribeTopicEventsRequestInitialAlpha1) String() string {
 func (*SubscribeTopicEventsRequestInitialAlpha1) ProtoMessage() {}
 
 func (x *SubscribeTopicEventsRequestInitialAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +576,7 @@ func (x *SubscribeTopicEventsRequestInitialAlpha1) ProtoReflect() protoreflect.M
 
 // Deprecated: Use SubscribeTopicEventsRequestInitialAlpha1.ProtoReflect.Descriptor instead.
 func (*SubscribeTopicEventsRequestInitialAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{6}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SubscribeTopicEventsRequestInitialAlpha1) GetPubsubName() string {
@@ -563,7 +623,7 @@ type SubscribeTopicEventsRequestProcessedAlpha1 struct {
 func (x *SubscribeTopicEventsRequestProcessedAlpha1) Reset() {
 	*x = SubscribeTopicEventsRequestProcessedAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[7]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -576,7 +636,7 @@ func (x *SubscribeTopicEventsRequestProcessedAlpha1) String() string {
 func (*SubscribeTopicEventsRequestProcessedAlpha1) ProtoMessage() {}
 
 func (x *SubscribeTopicEventsRequestProcessedAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[7]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +649,7 @@ func (x *SubscribeTopicEventsRequestProcessedAlpha1) ProtoReflect() protoreflect
 
 // Deprecated: Use SubscribeTopicEventsRequestProcessedAlpha1.ProtoReflect.Descriptor instead.
 func (*SubscribeTopicEventsRequestProcessedAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{7}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SubscribeTopicEventsRequestProcessedAlpha1) GetId() string {
@@ -623,7 +683,7 @@ type SubscribeTopicEventsResponseAlpha1 struct {
 func (x *SubscribeTopicEventsResponseAlpha1) Reset() {
 	*x = SubscribeTopicEventsResponseAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[8]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -636,7 +696,7 @@ func (x *SubscribeTopicEventsResponseAlpha1) String() string {
 func (*SubscribeTopicEventsResponseAlpha1) ProtoMessage() {}
 
 func (x *SubscribeTopicEventsResponseAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[8]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +709,7 @@ func (x *SubscribeTopicEventsResponseAlpha1) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SubscribeTopicEventsResponseAlpha1.ProtoReflect.Descriptor instead.
 func (*SubscribeTopicEventsResponseAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{8}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{9}
 }
 
 func (m *SubscribeTopicEventsResponseAlpha1) GetSubscribeTopicEventsResponseType() isSubscribeTopicEventsResponseAlpha1_SubscribeTopicEventsResponseType {
@@ -702,7 +762,7 @@ type SubscribeTopicEventsResponseInitialAlpha1 struct {
 func (x *SubscribeTopicEventsResponseInitialAlpha1) Reset() {
 	*x = SubscribeTopicEventsResponseInitialAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[9]
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -715,7 +775,7 @@ func (x *SubscribeTopicEventsResponseInitialAlpha1) String() string {
 func (*SubscribeTopicEventsResponseInitialAlpha1) ProtoMessage() {}
 
 func (x *SubscribeTopicEventsResponseInitialAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[9]
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +788,7 @@ func (x *SubscribeTopicEventsResponseInitialAlpha1) ProtoReflect() protoreflect.
 
 // Deprecated: Use SubscribeTopicEventsResponseInitialAlpha1.ProtoReflect.Descriptor instead.
 func (*SubscribeTopicEventsResponseInitialAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{9}
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{10}
 }
 
 var File_dapr_proto_runtime_v1_pubsub_proto protoreflect.FileDescriptor
@@ -758,129 +818,135 @@ var file_dapr_proto_runtime_v1_pubsub_proto_rawDesc = []byte{
 	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa7, 0x02, 0x0a, 0x12, 0x42, 0x75, 0x6c, 0x6b, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x12, 0x48, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
-	0x6c, 0x6b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x53,
-	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x37, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x84, 0x02, 0x0a, 0x17, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08,
-	0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x58, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a, 0x28, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xa7, 0x02, 0x0a, 0x12, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75,
+	0x62, 0x73, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x12, 0x48, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
 	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x50,
 	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x13, 0x42, 0x75, 0x6c, 0x6b, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b,
-	0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
-	0x6c, 0x6b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x66, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x1e, 0x42,
-	0x75, 0x6c, 0x6b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a,
-	0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa4,
-	0x02, 0x0a, 0x21, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x12, 0x6a, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
 	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x48, 0x00,
-	0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x6c, 0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x64, 0x61, 0x70, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x48, 0x00, 0x52, 0x0e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x25,
-	0x0a, 0x23, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd0, 0x02, 0x0a, 0x28, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x69, 0x0a, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x64, 0x61,
-	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74,
-	0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x88, 0x01, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74,
-	0x65, 0x72, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x7f, 0x0a, 0x2a, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
-	0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x22, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x12, 0x6d, 0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x61, 0x70,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x84, 0x02,
+	0x0a, 0x17, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x58, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x13, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0d, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x1e, 0x42, 0x75, 0x6c, 0x6b,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa4, 0x02, 0x0a, 0x21,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x12, 0x6a, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x61, 0x70,
 	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
 	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x48, 0x00, 0x52, 0x0f,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4f, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x48, 0x00, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x42, 0x26, 0x0a, 0x24, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2b, 0x0a, 0x29, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x69, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72,
-	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x44, 0x61, 0x70, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a,
-	0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72,
-	0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0xaa, 0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x48, 0x00, 0x52, 0x0e, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6c, 0x0a,
+	0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x48, 0x00, 0x52, 0x0e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x25, 0x0a, 0x23, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0xd0, 0x02, 0x0a, 0x28, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x69, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x2f, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0f,
+	0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x88,
+	0x01, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x14, 0x0a, 0x12, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x7f, 0x0a, 0x2a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x22, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x12, 0x6d, 0x0a,
+	0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x48, 0x00, 0x52, 0x0f, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0d,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x26, 0x0a,
+	0x24, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2b, 0x0a, 0x29, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x42, 0x69, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x76, 0x31,
+	0x42, 0x0a, 0x44, 0x61, 0x70, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x31, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61,
+	0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0xaa,
+	0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75,
+	0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -895,37 +961,38 @@ func file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescData
 }
 
-var file_dapr_proto_runtime_v1_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_dapr_proto_runtime_v1_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_dapr_proto_runtime_v1_pubsub_proto_goTypes = []interface{}{
 	(*PublishEventRequest)(nil),                        // 0: dapr.proto.runtime.v1.PublishEventRequest
-	(*BulkPublishRequest)(nil),                         // 1: dapr.proto.runtime.v1.BulkPublishRequest
-	(*BulkPublishRequestEntry)(nil),                    // 2: dapr.proto.runtime.v1.BulkPublishRequestEntry
-	(*BulkPublishResponse)(nil),                        // 3: dapr.proto.runtime.v1.BulkPublishResponse
-	(*BulkPublishResponseFailedEntry)(nil),             // 4: dapr.proto.runtime.v1.BulkPublishResponseFailedEntry
-	(*SubscribeTopicEventsRequestAlpha1)(nil),          // 5: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1
-	(*SubscribeTopicEventsRequestInitialAlpha1)(nil),   // 6: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1
-	(*SubscribeTopicEventsRequestProcessedAlpha1)(nil), // 7: dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1
-	(*SubscribeTopicEventsResponseAlpha1)(nil),         // 8: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1
-	(*SubscribeTopicEventsResponseInitialAlpha1)(nil),  // 9: dapr.proto.runtime.v1.SubscribeTopicEventsResponseInitialAlpha1
-	nil,                        // 10: dapr.proto.runtime.v1.PublishEventRequest.MetadataEntry
-	nil,                        // 11: dapr.proto.runtime.v1.BulkPublishRequest.MetadataEntry
-	nil,                        // 12: dapr.proto.runtime.v1.BulkPublishRequestEntry.MetadataEntry
-	nil,                        // 13: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.MetadataEntry
-	(*TopicEventResponse)(nil), // 14: dapr.proto.runtime.v1.TopicEventResponse
-	(*TopicEventRequest)(nil),  // 15: dapr.proto.runtime.v1.TopicEventRequest
+	(*CancelScheduledPublishEventRequestAlpha1)(nil),   // 1: dapr.proto.runtime.v1.CancelScheduledPublishEventRequestAlpha1
+	(*BulkPublishRequest)(nil),                         // 2: dapr.proto.runtime.v1.BulkPublishRequest
+	(*BulkPublishRequestEntry)(nil),                    // 3: dapr.proto.runtime.v1.BulkPublishRequestEntry
+	(*BulkPublishResponse)(nil),                        // 4: dapr.proto.runtime.v1.BulkPublishResponse
+	(*BulkPublishResponseFailedEntry)(nil),             // 5: dapr.proto.runtime.v1.BulkPublishResponseFailedEntry
+	(*SubscribeTopicEventsRequestAlpha1)(nil),          // 6: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1
+	(*SubscribeTopicEventsRequestInitialAlpha1)(nil),   // 7: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1
+	(*SubscribeTopicEventsRequestProcessedAlpha1)(nil), // 8: dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1
+	(*SubscribeTopicEventsResponseAlpha1)(nil),         // 9: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1
+	(*SubscribeTopicEventsResponseInitialAlpha1)(nil),  // 10: dapr.proto.runtime.v1.SubscribeTopicEventsResponseInitialAlpha1
+	nil,                        // 11: dapr.proto.runtime.v1.PublishEventRequest.MetadataEntry
+	nil,                        // 12: dapr.proto.runtime.v1.BulkPublishRequest.MetadataEntry
+	nil,                        // 13: dapr.proto.runtime.v1.BulkPublishRequestEntry.MetadataEntry
+	nil,                        // 14: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.MetadataEntry
+	(*TopicEventResponse)(nil), // 15: dapr.proto.runtime.v1.TopicEventResponse
+	(*TopicEventRequest)(nil),  // 16: dapr.proto.runtime.v1.TopicEventRequest
 }
 var file_dapr_proto_runtime_v1_pubsub_proto_depIdxs = []int32{
-	10, // 0: dapr.proto.runtime.v1.PublishEventRequest.metadata:type_name -> dapr.proto.runtime.v1.PublishEventRequest.MetadataEntry
-	2,  // 1: dapr.proto.runtime.v1.BulkPublishRequest.entries:type_name -> dapr.proto.runtime.v1.BulkPublishRequestEntry
-	11, // 2: dapr.proto.runtime.v1.BulkPublishRequest.metadata:type_name -> dapr.proto.runtime.v1.BulkPublishRequest.MetadataEntry
-	12, // 3: dapr.proto.runtime.v1.BulkPublishRequestEntry.metadata:type_name -> dapr.proto.runtime.v1.BulkPublishRequestEntry.MetadataEntry
-	4,  // 4: dapr.proto.runtime.v1.BulkPublishResponse.failedEntries:type_name -> dapr.proto.runtime.v1.BulkPublishResponseFailedEntry
-	6,  // 5: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1.initial_request:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1
-	7,  // 6: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1.event_processed:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1
-	13, // 7: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.metadata:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.MetadataEntry
-	14, // 8: dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1.status:type_name -> dapr.proto.runtime.v1.TopicEventResponse
-	9,  // 9: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1.initial_response:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsResponseInitialAlpha1
-	15, // 10: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1.event_message:type_name -> dapr.proto.runtime.v1.TopicEventRequest
+	11, // 0: dapr.proto.runtime.v1.PublishEventRequest.metadata:type_name -> dapr.proto.runtime.v1.PublishEventRequest.MetadataEntry
+	3,  // 1: dapr.proto.runtime.v1.BulkPublishRequest.entries:type_name -> dapr.proto.runtime.v1.BulkPublishRequestEntry
+	12, // 2: dapr.proto.runtime.v1.BulkPublishRequest.metadata:type_name -> dapr.proto.runtime.v1.BulkPublishRequest.MetadataEntry
+	13, // 3: dapr.proto.runtime.v1.BulkPublishRequestEntry.metadata:type_name -> dapr.proto.runtime.v1.BulkPublishRequestEntry.MetadataEntry
+	5,  // 4: dapr.proto.runtime.v1.BulkPublishResponse.failedEntries:type_name -> dapr.proto.runtime.v1.BulkPublishResponseFailedEntry
+	7,  // 5: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1.initial_request:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1
+	8,  // 6: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1.event_processed:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1
+	14, // 7: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.metadata:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.MetadataEntry
+	15, // 8: dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1.status:type_name -> dapr.proto.runtime.v1.TopicEventResponse
+	10, // 9: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1.initial_response:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsResponseInitialAlpha1
+	16, // 10: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1.event_message:type_name -> dapr.proto.runtime.v1.TopicEventRequest
 	11, // [11:11] is the sub-list for method output_type
 	11, // [11:11] is the sub-list for method input_type
 	11, // [11:11] is the sub-list for extension type_name
@@ -953,7 +1020,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkPublishRequest); i {
+			switch v := v.(*CancelScheduledPublishEventRequestAlpha1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -965,7 +1032,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkPublishRequestEntry); i {
+			switch v := v.(*BulkPublishRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -977,7 +1044,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkPublishResponse); i {
+			switch v := v.(*BulkPublishRequestEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -989,7 +1056,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkPublishResponseFailedEntry); i {
+			switch v := v.(*BulkPublishResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1001,7 +1068,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeTopicEventsRequestAlpha1); i {
+			switch v := v.(*BulkPublishResponseFailedEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1013,7 +1080,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeTopicEventsRequestInitialAlpha1); i {
+			switch v := v.(*SubscribeTopicEventsRequestAlpha1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1025,7 +1092,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeTopicEventsRequestProcessedAlpha1); i {
+			switch v := v.(*SubscribeTopicEventsRequestInitialAlpha1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1037,7 +1104,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeTopicEventsResponseAlpha1); i {
+			switch v := v.(*SubscribeTopicEventsRequestProcessedAlpha1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1049,6 +1116,18 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeTopicEventsResponseAlpha1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeTopicEventsResponseInitialAlpha1); i {
 			case 0:
 				return &v.state
@@ -1061,12 +1140,12 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			}
 		}
 	}
-	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*SubscribeTopicEventsRequestAlpha1_InitialRequest)(nil),
 		(*SubscribeTopicEventsRequestAlpha1_EventProcessed)(nil),
 	}
-	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[6].OneofWrappers = []interface{}{}
-	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*SubscribeTopicEventsResponseAlpha1_InitialResponse)(nil),
 		(*SubscribeTopicEventsResponseAlpha1_EventMessage)(nil),
 	}
@@ -1076,7 +1155,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_runtime_v1_pubsub_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
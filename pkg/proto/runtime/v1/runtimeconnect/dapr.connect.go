@@ -64,8 +64,14 @@ const (
 	// DaprExecuteStateTransactionProcedure is the fully-qualified name of the Dapr's
 	// ExecuteStateTransaction RPC.
 	DaprExecuteStateTransactionProcedure = "/dapr.proto.runtime.v1.Dapr/ExecuteStateTransaction"
+	// DaprSubscribeStateChangesAlpha1Procedure is the fully-qualified name of the Dapr's
+	// SubscribeStateChangesAlpha1 RPC.
+	DaprSubscribeStateChangesAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/SubscribeStateChangesAlpha1"
 	// DaprPublishEventProcedure is the fully-qualified name of the Dapr's PublishEvent RPC.
 	DaprPublishEventProcedure = "/dapr.proto.runtime.v1.Dapr/PublishEvent"
+	// DaprCancelScheduledPublishEventAlpha1Procedure is the fully-qualified name of the Dapr's
+	// CancelScheduledPublishEventAlpha1 RPC.
+	DaprCancelScheduledPublishEventAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/CancelScheduledPublishEventAlpha1"
 	// DaprBulkPublishEventAlpha1Procedure is the fully-qualified name of the Dapr's
 	// BulkPublishEventAlpha1 RPC.
 	DaprBulkPublishEventAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/BulkPublishEventAlpha1"
@@ -225,8 +231,13 @@ type DaprClient interface {
 	DeleteBulkState(context.Context, *connect.Request[v1.DeleteBulkStateRequest]) (*connect.Response[emptypb.Empty], error)
 	// Executes transactions for a specified store
 	ExecuteStateTransaction(context.Context, *connect.Request[v1.ExecuteStateTransactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// Subscribes to the changes of keys or key prefixes in a state store.
+	SubscribeStateChangesAlpha1(context.Context, *connect.Request[v1.SubscribeStateChangesRequestAlpha1]) (*connect.ServerStreamForClient[v1.SubscribeStateChangesResponseAlpha1], error)
 	// Publishes events to the specific topic.
 	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[emptypb.Empty], error)
+	// Cancels an event which was published with a delay and has not been
+	// delivered yet.
+	CancelScheduledPublishEventAlpha1(context.Context, *connect.Request[v1.CancelScheduledPublishEventRequestAlpha1]) (*connect.Response[emptypb.Empty], error)
 	// Bulk Publishes multiple events to the specified topic.
 	BulkPublishEventAlpha1(context.Context, *connect.Request[v1.BulkPublishRequest]) (*connect.Response[v1.BulkPublishResponse], error)
 	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic
@@ -402,11 +413,21 @@ func NewDaprClient(httpClient connect.HTTPClient, baseURL string, opts ...connec
 			baseURL+DaprExecuteStateTransactionProcedure,
 			opts...,
 		),
+		subscribeStateChangesAlpha1: connect.NewClient[v1.SubscribeStateChangesRequestAlpha1, v1.SubscribeStateChangesResponseAlpha1](
+			httpClient,
+			baseURL+DaprSubscribeStateChangesAlpha1Procedure,
+			opts...,
+		),
 		publishEvent: connect.NewClient[v1.PublishEventRequest, emptypb.Empty](
 			httpClient,
 			baseURL+DaprPublishEventProcedure,
 			opts...,
 		),
+		cancelScheduledPublishEventAlpha1: connect.NewClient[v1.CancelScheduledPublishEventRequestAlpha1, emptypb.Empty](
+			httpClient,
+			baseURL+DaprCancelScheduledPublishEventAlpha1Procedure,
+			opts...,
+		),
 		bulkPublishEventAlpha1: connect.NewClient[v1.BulkPublishRequest, v1.BulkPublishResponse](
 			httpClient,
 			baseURL+DaprBulkPublishEventAlpha1Procedure,
@@ -692,71 +713,73 @@ func NewDaprClient(httpClient connect.HTTPClient, baseURL string, opts ...connec
 
 // daprClient implements DaprClient.
 type daprClient struct {
-	invokeService                  *connect.Client[v1.InvokeServiceRequest, v11.InvokeResponse]
-	getState                       *connect.Client[v1.GetStateRequest, v1.GetStateResponse]
-	getBulkState                   *connect.Client[v1.GetBulkStateRequest, v1.GetBulkStateResponse]
-	saveState                      *connect.Client[v1.SaveStateRequest, emptypb.Empty]
-	queryStateAlpha1               *connect.Client[v1.QueryStateRequest, v1.QueryStateResponse]
-	deleteState                    *connect.Client[v1.DeleteStateRequest, emptypb.Empty]
-	deleteBulkState                *connect.Client[v1.DeleteBulkStateRequest, emptypb.Empty]
-	executeStateTransaction        *connect.Client[v1.ExecuteStateTransactionRequest, emptypb.Empty]
-	publishEvent                   *connect.Client[v1.PublishEventRequest, emptypb.Empty]
-	bulkPublishEventAlpha1         *connect.Client[v1.BulkPublishRequest, v1.BulkPublishResponse]
-	subscribeTopicEventsAlpha1     *connect.Client[v1.SubscribeTopicEventsRequestAlpha1, v1.SubscribeTopicEventsResponseAlpha1]
-	invokeBinding                  *connect.Client[v1.InvokeBindingRequest, v1.InvokeBindingResponse]
-	getSecret                      *connect.Client[v1.GetSecretRequest, v1.GetSecretResponse]
-	getBulkSecret                  *connect.Client[v1.GetBulkSecretRequest, v1.GetBulkSecretResponse]
-	registerActorTimer             *connect.Client[v1.RegisterActorTimerRequest, emptypb.Empty]
-	unregisterActorTimer           *connect.Client[v1.UnregisterActorTimerRequest, emptypb.Empty]
-	registerActorReminder          *connect.Client[v1.RegisterActorReminderRequest, emptypb.Empty]
-	unregisterActorReminder        *connect.Client[v1.UnregisterActorReminderRequest, emptypb.Empty]
-	unregisterActorRemindersByType *connect.Client[v1.UnregisterActorRemindersByTypeRequest, v1.UnregisterActorRemindersByTypeResponse]
-	listActorReminders             *connect.Client[v1.ListActorRemindersRequest, v1.ListActorRemindersResponse]
-	getActorState                  *connect.Client[v1.GetActorStateRequest, v1.GetActorStateResponse]
-	getActorReminder               *connect.Client[v1.GetActorReminderRequest, v1.GetActorReminderResponse]
-	executeActorStateTransaction   *connect.Client[v1.ExecuteActorStateTransactionRequest, emptypb.Empty]
-	invokeActor                    *connect.Client[v1.InvokeActorRequest, v1.InvokeActorResponse]
-	getConfigurationAlpha1         *connect.Client[v1.GetConfigurationRequest, v1.GetConfigurationResponse]
-	getConfiguration               *connect.Client[v1.GetConfigurationRequest, v1.GetConfigurationResponse]
-	subscribeConfigurationAlpha1   *connect.Client[v1.SubscribeConfigurationRequest, v1.SubscribeConfigurationResponse]
-	subscribeConfiguration         *connect.Client[v1.SubscribeConfigurationRequest, v1.SubscribeConfigurationResponse]
-	unsubscribeConfigurationAlpha1 *connect.Client[v1.UnsubscribeConfigurationRequest, v1.UnsubscribeConfigurationResponse]
-	unsubscribeConfiguration       *connect.Client[v1.UnsubscribeConfigurationRequest, v1.UnsubscribeConfigurationResponse]
-	tryLockAlpha1                  *connect.Client[v1.TryLockRequest, v1.TryLockResponse]
-	unlockAlpha1                   *connect.Client[v1.UnlockRequest, v1.UnlockResponse]
-	encryptAlpha1                  *connect.Client[v1.EncryptRequest, v1.EncryptResponse]
-	decryptAlpha1                  *connect.Client[v1.DecryptRequest, v1.DecryptResponse]
-	getMetadata                    *connect.Client[v1.GetMetadataRequest, v1.GetMetadataResponse]
-	setMetadata                    *connect.Client[v1.SetMetadataRequest, emptypb.Empty]
-	subtleGetKeyAlpha1             *connect.Client[v1.SubtleGetKeyRequest, v1.SubtleGetKeyResponse]
-	subtleEncryptAlpha1            *connect.Client[v1.SubtleEncryptRequest, v1.SubtleEncryptResponse]
-	subtleDecryptAlpha1            *connect.Client[v1.SubtleDecryptRequest, v1.SubtleDecryptResponse]
-	subtleWrapKeyAlpha1            *connect.Client[v1.SubtleWrapKeyRequest, v1.SubtleWrapKeyResponse]
-	subtleUnwrapKeyAlpha1          *connect.Client[v1.SubtleUnwrapKeyRequest, v1.SubtleUnwrapKeyResponse]
-	subtleSignAlpha1               *connect.Client[v1.SubtleSignRequest, v1.SubtleSignResponse]
-	subtleVerifyAlpha1             *connect.Client[v1.SubtleVerifyRequest, v1.SubtleVerifyResponse]
-	startWorkflowAlpha1            *connect.Client[v1.StartWorkflowRequest, v1.StartWorkflowResponse]
-	getWorkflowAlpha1              *connect.Client[v1.GetWorkflowRequest, v1.GetWorkflowResponse]
-	purgeWorkflowAlpha1            *connect.Client[v1.PurgeWorkflowRequest, emptypb.Empty]
-	terminateWorkflowAlpha1        *connect.Client[v1.TerminateWorkflowRequest, emptypb.Empty]
-	pauseWorkflowAlpha1            *connect.Client[v1.PauseWorkflowRequest, emptypb.Empty]
-	resumeWorkflowAlpha1           *connect.Client[v1.ResumeWorkflowRequest, emptypb.Empty]
-	raiseEventWorkflowAlpha1       *connect.Client[v1.RaiseEventWorkflowRequest, emptypb.Empty]
-	startWorkflowBeta1             *connect.Client[v1.StartWorkflowRequest, v1.StartWorkflowResponse]
-	getWorkflowBeta1               *connect.Client[v1.GetWorkflowRequest, v1.GetWorkflowResponse]
-	purgeWorkflowBeta1             *connect.Client[v1.PurgeWorkflowRequest, emptypb.Empty]
-	terminateWorkflowBeta1         *connect.Client[v1.TerminateWorkflowRequest, emptypb.Empty]
-	pauseWorkflowBeta1             *connect.Client[v1.PauseWorkflowRequest, emptypb.Empty]
-	resumeWorkflowBeta1            *connect.Client[v1.ResumeWorkflowRequest, emptypb.Empty]
-	raiseEventWorkflowBeta1        *connect.Client[v1.RaiseEventWorkflowRequest, emptypb.Empty]
-	shutdown                       *connect.Client[v1.ShutdownRequest, emptypb.Empty]
-	scheduleJobAlpha1              *connect.Client[v1.ScheduleJobRequest, v1.ScheduleJobResponse]
-	getJobAlpha1                   *connect.Client[v1.GetJobRequest, v1.GetJobResponse]
-	deleteJobAlpha1                *connect.Client[v1.DeleteJobRequest, v1.DeleteJobResponse]
-	deleteJobsByPrefixAlpha1       *connect.Client[v1.DeleteJobsByPrefixRequestAlpha1, v1.DeleteJobsByPrefixResponseAlpha1]
-	listJobsAlpha1                 *connect.Client[v1.ListJobsRequestAlpha1, v1.ListJobsResponseAlpha1]
-	converseAlpha1                 *connect.Client[v1.ConversationRequest, v1.ConversationResponse]
-	converseAlpha2                 *connect.Client[v1.ConversationRequestAlpha2, v1.ConversationResponseAlpha2]
+	invokeService                     *connect.Client[v1.InvokeServiceRequest, v11.InvokeResponse]
+	getState                          *connect.Client[v1.GetStateRequest, v1.GetStateResponse]
+	getBulkState                      *connect.Client[v1.GetBulkStateRequest, v1.GetBulkStateResponse]
+	saveState                         *connect.Client[v1.SaveStateRequest, emptypb.Empty]
+	queryStateAlpha1                  *connect.Client[v1.QueryStateRequest, v1.QueryStateResponse]
+	deleteState                       *connect.Client[v1.DeleteStateRequest, emptypb.Empty]
+	deleteBulkState                   *connect.Client[v1.DeleteBulkStateRequest, emptypb.Empty]
+	executeStateTransaction           *connect.Client[v1.ExecuteStateTransactionRequest, emptypb.Empty]
+	subscribeStateChangesAlpha1       *connect.Client[v1.SubscribeStateChangesRequestAlpha1, v1.SubscribeStateChangesResponseAlpha1]
+	publishEvent                      *connect.Client[v1.PublishEventRequest, emptypb.Empty]
+	cancelScheduledPublishEventAlpha1 *connect.Client[v1.CancelScheduledPublishEventRequestAlpha1, emptypb.Empty]
+	bulkPublishEventAlpha1            *connect.Client[v1.BulkPublishRequest, v1.BulkPublishResponse]
+	subscribeTopicEventsAlpha1        *connect.Client[v1.SubscribeTopicEventsRequestAlpha1, v1.SubscribeTopicEventsResponseAlpha1]
+	invokeBinding                     *connect.Client[v1.InvokeBindingRequest, v1.InvokeBindingResponse]
+	getSecret                         *connect.Client[v1.GetSecretRequest, v1.GetSecretResponse]
+	getBulkSecret                     *connect.Client[v1.GetBulkSecretRequest, v1.GetBulkSecretResponse]
+	registerActorTimer                *connect.Client[v1.RegisterActorTimerRequest, emptypb.Empty]
+	unregisterActorTimer              *connect.Client[v1.UnregisterActorTimerRequest, emptypb.Empty]
+	registerActorReminder             *connect.Client[v1.RegisterActorReminderRequest, emptypb.Empty]
+	unregisterActorReminder           *connect.Client[v1.UnregisterActorReminderRequest, emptypb.Empty]
+	unregisterActorRemindersByType    *connect.Client[v1.UnregisterActorRemindersByTypeRequest, v1.UnregisterActorRemindersByTypeResponse]
+	listActorReminders                *connect.Client[v1.ListActorRemindersRequest, v1.ListActorRemindersResponse]
+	getActorState                     *connect.Client[v1.GetActorStateRequest, v1.GetActorStateResponse]
+	getActorReminder                  *connect.Client[v1.GetActorReminderRequest, v1.GetActorReminderResponse]
+	executeActorStateTransaction      *connect.Client[v1.ExecuteActorStateTransactionRequest, emptypb.Empty]
+	invokeActor                       *connect.Client[v1.InvokeActorRequest, v1.InvokeActorResponse]
+	getConfigurationAlpha1            *connect.Client[v1.GetConfigurationRequest, v1.GetConfigurationResponse]
+	getConfiguration                  *connect.Client[v1.GetConfigurationRequest, v1.GetConfigurationResponse]
+	subscribeConfigurationAlpha1      *connect.Client[v1.SubscribeConfigurationRequest, v1.SubscribeConfigurationResponse]
+	subscribeConfiguration            *connect.Client[v1.SubscribeConfigurationRequest, v1.SubscribeConfigurationResponse]
+	unsubscribeConfigurationAlpha1    *connect.Client[v1.UnsubscribeConfigurationRequest, v1.UnsubscribeConfigurationResponse]
+	unsubscribeConfiguration          *connect.Client[v1.UnsubscribeConfigurationRequest, v1.UnsubscribeConfigurationResponse]
+	tryLockAlpha1                     *connect.Client[v1.TryLockRequest, v1.TryLockResponse]
+	unlockAlpha1                      *connect.Client[v1.UnlockRequest, v1.UnlockResponse]
+	encryptAlpha1                     *connect.Client[v1.EncryptRequest, v1.EncryptResponse]
+	decryptAlpha1                     *connect.Client[v1.DecryptRequest, v1.DecryptResponse]
+	getMetadata                       *connect.Client[v1.GetMetadataRequest, v1.GetMetadataResponse]
+	setMetadata                       *connect.Client[v1.SetMetadataRequest, emptypb.Empty]
+	subtleGetKeyAlpha1                *connect.Client[v1.SubtleGetKeyRequest, v1.SubtleGetKeyResponse]
+	subtleEncryptAlpha1               *connect.Client[v1.SubtleEncryptRequest, v1.SubtleEncryptResponse]
+	subtleDecryptAlpha1               *connect.Client[v1.SubtleDecryptRequest, v1.SubtleDecryptResponse]
+	subtleWrapKeyAlpha1               *connect.Client[v1.SubtleWrapKeyRequest, v1.SubtleWrapKeyResponse]
+	subtleUnwrapKeyAlpha1             *connect.Client[v1.SubtleUnwrapKeyRequest, v1.SubtleUnwrapKeyResponse]
+	subtleSignAlpha1                  *connect.Client[v1.SubtleSignRequest, v1.SubtleSignResponse]
+	subtleVerifyAlpha1                *connect.Client[v1.SubtleVerifyRequest, v1.SubtleVerifyResponse]
+	startWorkflowAlpha1               *connect.Client[v1.StartWorkflowRequest, v1.StartWorkflowResponse]
+	getWorkflowAlpha1                 *connect.Client[v1.GetWorkflowRequest, v1.GetWorkflowResponse]
+	purgeWorkflowAlpha1               *connect.Client[v1.PurgeWorkflowRequest, emptypb.Empty]
+	terminateWorkflowAlpha1           *connect.Client[v1.TerminateWorkflowRequest, emptypb.Empty]
+	pauseWorkflowAlpha1               *connect.Client[v1.PauseWorkflowRequest, emptypb.Empty]
+	resumeWorkflowAlpha1              *connect.Client[v1.ResumeWorkflowRequest, emptypb.Empty]
+	raiseEventWorkflowAlpha1          *connect.Client[v1.RaiseEventWorkflowRequest, emptypb.Empty]
+	startWorkflowBeta1                *connect.Client[v1.StartWorkflowRequest, v1.StartWorkflowResponse]
+	getWorkflowBeta1                  *connect.Client[v1.GetWorkflowRequest, v1.GetWorkflowResponse]
+	purgeWorkflowBeta1                *connect.Client[v1.PurgeWorkflowRequest, emptypb.Empty]
+	terminateWorkflowBeta1            *connect.Client[v1.TerminateWorkflowRequest, emptypb.Empty]
+	pauseWorkflowBeta1                *connect.Client[v1.PauseWorkflowRequest, emptypb.Empty]
+	resumeWorkflowBeta1               *connect.Client[v1.ResumeWorkflowRequest, emptypb.Empty]
+	raiseEventWorkflowBeta1           *connect.Client[v1.RaiseEventWorkflowRequest, emptypb.Empty]
+	shutdown                          *connect.Client[v1.ShutdownRequest, emptypb.Empty]
+	scheduleJobAlpha1                 *connect.Client[v1.ScheduleJobRequest, v1.ScheduleJobResponse]
+	getJobAlpha1                      *connect.Client[v1.GetJobRequest, v1.GetJobResponse]
+	deleteJobAlpha1                   *connect.Client[v1.DeleteJobRequest, v1.DeleteJobResponse]
+	deleteJobsByPrefixAlpha1          *connect.Client[v1.DeleteJobsByPrefixRequestAlpha1, v1.DeleteJobsByPrefixResponseAlpha1]
+	listJobsAlpha1                    *connect.Client[v1.ListJobsRequestAlpha1, v1.ListJobsResponseAlpha1]
+	converseAlpha1                    *connect.Client[v1.ConversationRequest, v1.ConversationResponse]
+	converseAlpha2                    *connect.Client[v1.ConversationRequestAlpha2, v1.ConversationResponseAlpha2]
 }
 
 // InvokeService calls dapr.proto.runtime.v1.Dapr.InvokeService.
@@ -799,11 +822,21 @@ func (c *daprClient) ExecuteStateTransaction(ctx context.Context, req *connect.R
 	return c.executeStateTransaction.CallUnary(ctx, req)
 }
 
+// SubscribeStateChangesAlpha1 calls dapr.proto.runtime.v1.Dapr.SubscribeStateChangesAlpha1.
+func (c *daprClient) SubscribeStateChangesAlpha1(ctx context.Context, req *connect.Request[v1.SubscribeStateChangesRequestAlpha1]) (*connect.ServerStreamForClient[v1.SubscribeStateChangesResponseAlpha1], error) {
+	return c.subscribeStateChangesAlpha1.CallServerStream(ctx, req)
+}
+
 // PublishEvent calls dapr.proto.runtime.v1.Dapr.PublishEvent.
 func (c *daprClient) PublishEvent(ctx context.Context, req *connect.Request[v1.PublishEventRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.publishEvent.CallUnary(ctx, req)
 }
 
+// CancelScheduledPublishEventAlpha1 calls dapr.proto.runtime.v1.Dapr.CancelScheduledPublishEventAlpha1.
+func (c *daprClient) CancelScheduledPublishEventAlpha1(ctx context.Context, req *connect.Request[v1.CancelScheduledPublishEventRequestAlpha1]) (*connect.Response[emptypb.Empty], error) {
+	return c.cancelScheduledPublishEventAlpha1.CallUnary(ctx, req)
+}
+
 // BulkPublishEventAlpha1 calls dapr.proto.runtime.v1.Dapr.BulkPublishEventAlpha1.
 func (c *daprClient) BulkPublishEventAlpha1(ctx context.Context, req *connect.Request[v1.BulkPublishRequest]) (*connect.Response[v1.BulkPublishResponse], error) {
 	return c.bulkPublishEventAlpha1.CallUnary(ctx, req)
@@ -1117,8 +1150,13 @@ type DaprHandler interface {
 	DeleteBulkState(context.Context, *connect.Request[v1.DeleteBulkStateRequest]) (*connect.Response[emptypb.Empty], error)
 	// Executes transactions for a specified store
 	ExecuteStateTransaction(context.Context, *connect.Request[v1.ExecuteStateTransactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// Subscribes to the changes of keys or key prefixes in a state store.
+	SubscribeStateChangesAlpha1(context.Context, *connect.Request[v1.SubscribeStateChangesRequestAlpha1], *connect.ServerStream[v1.SubscribeStateChangesResponseAlpha1]) error
 	// Publishes events to the specific topic.
 	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[emptypb.Empty], error)
+	// Cancels an event which was published with a delay and has not been
+	// delivered yet.
+	CancelScheduledPublishEventAlpha1(context.Context, *connect.Request[v1.CancelScheduledPublishEventRequestAlpha1]) (*connect.Response[emptypb.Empty], error)
 	// Bulk Publishes multiple events to the specified topic.
 	BulkPublishEventAlpha1(context.Context, *connect.Request[v1.BulkPublishRequest]) (*connect.Response[v1.BulkPublishResponse], error)
 	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic
@@ -1290,11 +1328,21 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 		svc.ExecuteStateTransaction,
 		opts...,
 	)
+	daprSubscribeStateChangesAlpha1Handler := connect.NewServerStreamHandler(
+		DaprSubscribeStateChangesAlpha1Procedure,
+		svc.SubscribeStateChangesAlpha1,
+		opts...,
+	)
 	daprPublishEventHandler := connect.NewUnaryHandler(
 		DaprPublishEventProcedure,
 		svc.PublishEvent,
 		opts...,
 	)
+	daprCancelScheduledPublishEventAlpha1Handler := connect.NewUnaryHandler(
+		DaprCancelScheduledPublishEventAlpha1Procedure,
+		svc.CancelScheduledPublishEventAlpha1,
+		opts...,
+	)
 	daprBulkPublishEventAlpha1Handler := connect.NewUnaryHandler(
 		DaprBulkPublishEventAlpha1Procedure,
 		svc.BulkPublishEventAlpha1,
@@ -1593,8 +1641,12 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 			daprDeleteBulkStateHandler.ServeHTTP(w, r)
 		case DaprExecuteStateTransactionProcedure:
 			daprExecuteStateTransactionHandler.ServeHTTP(w, r)
+		case DaprSubscribeStateChangesAlpha1Procedure:
+			daprSubscribeStateChangesAlpha1Handler.ServeHTTP(w, r)
 		case DaprPublishEventProcedure:
 			daprPublishEventHandler.ServeHTTP(w, r)
+		case DaprCancelScheduledPublishEventAlpha1Procedure:
+			daprCancelScheduledPublishEventAlpha1Handler.ServeHTTP(w, r)
 		case DaprBulkPublishEventAlpha1Procedure:
 			daprBulkPublishEventAlpha1Handler.ServeHTTP(w, r)
 		case DaprSubscribeTopicEventsAlpha1Procedure:
@@ -1748,10 +1800,18 @@ func (UnimplementedDaprHandler) ExecuteStateTransaction(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.ExecuteStateTransaction is not implemented"))
 }
 
+func (UnimplementedDaprHandler) SubscribeStateChangesAlpha1(context.Context, *connect.Request[v1.SubscribeStateChangesRequestAlpha1], *connect.ServerStream[v1.SubscribeStateChangesResponseAlpha1]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.SubscribeStateChangesAlpha1 is not implemented"))
+}
+
 func (UnimplementedDaprHandler) PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.PublishEvent is not implemented"))
 }
 
+func (UnimplementedDaprHandler) CancelScheduledPublishEventAlpha1(context.Context, *connect.Request[v1.CancelScheduledPublishEventRequestAlpha1]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.CancelScheduledPublishEventAlpha1 is not implemented"))
+}
+
 func (UnimplementedDaprHandler) BulkPublishEventAlpha1(context.Context, *connect.Request[v1.BulkPublishRequest]) (*connect.Response[v1.BulkPublishResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.BulkPublishEventAlpha1 is not implemented"))
 }
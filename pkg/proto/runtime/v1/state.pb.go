@@ -33,6 +33,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Operation is the type of the change.
+type StateChangeAlpha1_Operation int32
+
+const (
+	StateChangeAlpha1_OPERATION_UNSPECIFIED StateChangeAlpha1_Operation = 0
+	StateChangeAlpha1_SET                   StateChangeAlpha1_Operation = 1
+	StateChangeAlpha1_DELETE                StateChangeAlpha1_Operation = 2
+)
+
+// Enum value maps for StateChangeAlpha1_Operation.
+var (
+	StateChangeAlpha1_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "SET",
+		2: "DELETE",
+	}
+	StateChangeAlpha1_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"SET":                   1,
+		"DELETE":                2,
+	}
+)
+
+func (x StateChangeAlpha1_Operation) Enum() *StateChangeAlpha1_Operation {
+	p := new(StateChangeAlpha1_Operation)
+	*p = x
+	return p
+}
+
+func (x StateChangeAlpha1_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StateChangeAlpha1_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_dapr_proto_runtime_v1_state_proto_enumTypes[0].Descriptor()
+}
+
+func (StateChangeAlpha1_Operation) Type() protoreflect.EnumType {
+	return &file_dapr_proto_runtime_v1_state_proto_enumTypes[0]
+}
+
+func (x StateChangeAlpha1_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StateChangeAlpha1_Operation.Descriptor instead.
+func (StateChangeAlpha1_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_state_proto_rawDescGZIP(), []int{14, 0}
+}
+
 // GetStateRequest is the message to get key-value states from specific state store.
 type GetStateRequest struct {
 	state         protoimpl.MessageState
@@ -927,6 +977,214 @@ func (x *ExecuteStateTransactionRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+// SubscribeStateChangesRequestAlpha1 is the message to subscribe to the changes
+// of keys in a state store.
+// Unless the state store provides a native change feed, only the changes made
+// through the state API of the same sidecar are sent. Changes made by other
+// sidecars, by actors and workflows, or directly in the store are not sent.
+type SubscribeStateChangesRequestAlpha1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The name of state store.
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// The keys to watch.
+	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	// The key prefixes to watch. Changes of every key starting with one of the
+	// prefixes are sent.
+	KeyPrefixes []string `protobuf:"bytes,3,rep,name=key_prefixes,json=keyPrefixes,proto3" json:"key_prefixes,omitempty"`
+}
+
+func (x *SubscribeStateChangesRequestAlpha1) Reset() {
+	*x = SubscribeStateChangesRequestAlpha1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_state_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeStateChangesRequestAlpha1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeStateChangesRequestAlpha1) ProtoMessage() {}
+
+func (x *SubscribeStateChangesRequestAlpha1) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_state_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeStateChangesRequestAlpha1.ProtoReflect.Descriptor instead.
+func (*SubscribeStateChangesRequestAlpha1) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_state_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SubscribeStateChangesRequestAlpha1) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *SubscribeStateChangesRequestAlpha1) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *SubscribeStateChangesRequestAlpha1) GetKeyPrefixes() []string {
+	if x != nil {
+		return x.KeyPrefixes
+	}
+	return nil
+}
+
+// StateChangeAlpha1 is a change of a key in a state store.
+type StateChangeAlpha1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key which was changed.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The type of the change.
+	Operation StateChangeAlpha1_Operation `protobuf:"varint,2,opt,name=operation,proto3,enum=dapr.proto.runtime.v1.StateChangeAlpha1_Operation" json:"operation,omitempty"`
+	// The new value of the key. Empty if the key was deleted.
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// The entity tag of the change, if known.
+	Etag *v1.Etag `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	// The metadata of the change.
+	Metadata map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *StateChangeAlpha1) Reset() {
+	*x = StateChangeAlpha1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_state_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateChangeAlpha1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateChangeAlpha1) ProtoMessage() {}
+
+func (x *StateChangeAlpha1) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_state_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateChangeAlpha1.ProtoReflect.Descriptor instead.
+func (*StateChangeAlpha1) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_state_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StateChangeAlpha1) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StateChangeAlpha1) GetOperation() StateChangeAlpha1_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return StateChangeAlpha1_OPERATION_UNSPECIFIED
+}
+
+func (x *StateChangeAlpha1) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *StateChangeAlpha1) GetEtag() *v1.Etag {
+	if x != nil {
+		return x.Etag
+	}
+	return nil
+}
+
+func (x *StateChangeAlpha1) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// SubscribeStateChangesResponseAlpha1 is a message sent on a state change
+// subscription. The first message of the stream has no changes and signals
+// that the subscription is ready.
+type SubscribeStateChangesResponseAlpha1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The changes of watched keys.
+	Changes []*StateChangeAlpha1 `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *SubscribeStateChangesResponseAlpha1) Reset() {
+	*x = SubscribeStateChangesResponseAlpha1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_state_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeStateChangesResponseAlpha1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeStateChangesResponseAlpha1) ProtoMessage() {}
+
+func (x *SubscribeStateChangesResponseAlpha1) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_state_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeStateChangesResponseAlpha1.ProtoReflect.Descriptor instead.
+func (*SubscribeStateChangesResponseAlpha1) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_state_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SubscribeStateChangesResponseAlpha1) GetChanges() []*StateChangeAlpha1 {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
 var File_dapr_proto_runtime_v1_state_proto protoreflect.FileDescriptor
 
 var file_dapr_proto_runtime_v1_state_proto_rawDesc = []byte{
@@ -1100,15 +1358,54 @@ var file_dapr_proto_runtime_v1_state_proto_rawDesc = []byte{
 	0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
 	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
-	0x69, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x44,
-	0x61, 0x70, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0xaa, 0x02, 0x1b, 0x44,
-	0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x67,
-	0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x7a, 0x0a, 0x22, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x5f,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x6b, 0x65, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x8b, 0x03, 0x0a, 0x11,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x50, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x65,
+	0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x70, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x74, 0x61, 0x67, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x52, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a,
+	0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x09,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x22, 0x69, 0x0a, 0x23, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x12, 0x42, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x42, 0x69, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x76, 0x31, 0x42, 0x0a, 0x44, 0x61, 0x70, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x31,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f,
+	0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0xaa, 0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x41, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1123,57 +1420,67 @@ func file_dapr_proto_runtime_v1_state_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_runtime_v1_state_proto_rawDescData
 }
 
-var file_dapr_proto_runtime_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_dapr_proto_runtime_v1_state_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_dapr_proto_runtime_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_dapr_proto_runtime_v1_state_proto_goTypes = []interface{}{
-	(*GetStateRequest)(nil),                // 0: dapr.proto.runtime.v1.GetStateRequest
-	(*GetBulkStateRequest)(nil),            // 1: dapr.proto.runtime.v1.GetBulkStateRequest
-	(*GetBulkStateResponse)(nil),           // 2: dapr.proto.runtime.v1.GetBulkStateResponse
-	(*BulkStateItem)(nil),                  // 3: dapr.proto.runtime.v1.BulkStateItem
-	(*GetStateResponse)(nil),               // 4: dapr.proto.runtime.v1.GetStateResponse
-	(*DeleteStateRequest)(nil),             // 5: dapr.proto.runtime.v1.DeleteStateRequest
-	(*DeleteBulkStateRequest)(nil),         // 6: dapr.proto.runtime.v1.DeleteBulkStateRequest
-	(*SaveStateRequest)(nil),               // 7: dapr.proto.runtime.v1.SaveStateRequest
-	(*QueryStateRequest)(nil),              // 8: dapr.proto.runtime.v1.QueryStateRequest
-	(*QueryStateItem)(nil),                 // 9: dapr.proto.runtime.v1.QueryStateItem
-	(*QueryStateResponse)(nil),             // 10: dapr.proto.runtime.v1.QueryStateResponse
-	(*TransactionalStateOperation)(nil),    // 11: dapr.proto.runtime.v1.TransactionalStateOperation
-	(*ExecuteStateTransactionRequest)(nil), // 12: dapr.proto.runtime.v1.ExecuteStateTransactionRequest
-	nil,                                    // 13: dapr.proto.runtime.v1.GetStateRequest.MetadataEntry
-	nil,                                    // 14: dapr.proto.runtime.v1.GetBulkStateRequest.MetadataEntry
-	nil,                                    // 15: dapr.proto.runtime.v1.BulkStateItem.MetadataEntry
-	nil,                                    // 16: dapr.proto.runtime.v1.GetStateResponse.MetadataEntry
-	nil,                                    // 17: dapr.proto.runtime.v1.DeleteStateRequest.MetadataEntry
-	nil,                                    // 18: dapr.proto.runtime.v1.QueryStateRequest.MetadataEntry
-	nil,                                    // 19: dapr.proto.runtime.v1.QueryStateResponse.MetadataEntry
-	nil,                                    // 20: dapr.proto.runtime.v1.ExecuteStateTransactionRequest.MetadataEntry
-	(v1.StateOptions_StateConsistency)(0),  // 21: dapr.proto.common.v1.StateOptions.StateConsistency
-	(*v1.Etag)(nil),                        // 22: dapr.proto.common.v1.Etag
-	(*v1.StateOptions)(nil),                // 23: dapr.proto.common.v1.StateOptions
-	(*v1.StateItem)(nil),                   // 24: dapr.proto.common.v1.StateItem
+	(StateChangeAlpha1_Operation)(0),            // 0: dapr.proto.runtime.v1.StateChangeAlpha1.Operation
+	(*GetStateRequest)(nil),                     // 1: dapr.proto.runtime.v1.GetStateRequest
+	(*GetBulkStateRequest)(nil),                 // 2: dapr.proto.runtime.v1.GetBulkStateRequest
+	(*GetBulkStateResponse)(nil),                // 3: dapr.proto.runtime.v1.GetBulkStateResponse
+	(*BulkStateItem)(nil),                       // 4: dapr.proto.runtime.v1.BulkStateItem
+	(*GetStateResponse)(nil),                    // 5: dapr.proto.runtime.v1.GetStateResponse
+	(*DeleteStateRequest)(nil),                  // 6: dapr.proto.runtime.v1.DeleteStateRequest
+	(*DeleteBulkStateRequest)(nil),              // 7: dapr.proto.runtime.v1.DeleteBulkStateRequest
+	(*SaveStateRequest)(nil),                    // 8: dapr.proto.runtime.v1.SaveStateRequest
+	(*QueryStateRequest)(nil),                   // 9: dapr.proto.runtime.v1.QueryStateRequest
+	(*QueryStateItem)(nil),                      // 10: dapr.proto.runtime.v1.QueryStateItem
+	(*QueryStateResponse)(nil),                  // 11: dapr.proto.runtime.v1.QueryStateResponse
+	(*TransactionalStateOperation)(nil),         // 12: dapr.proto.runtime.v1.TransactionalStateOperation
+	(*ExecuteStateTransactionRequest)(nil),      // 13: dapr.proto.runtime.v1.ExecuteStateTransactionRequest
+	(*SubscribeStateChangesRequestAlpha1)(nil),  // 14: dapr.proto.runtime.v1.SubscribeStateChangesRequestAlpha1
+	(*StateChangeAlpha1)(nil),                   // 15: dapr.proto.runtime.v1.StateChangeAlpha1
+	(*SubscribeStateChangesResponseAlpha1)(nil), // 16: dapr.proto.runtime.v1.SubscribeStateChangesResponseAlpha1
+	nil,                                   // 17: dapr.proto.runtime.v1.GetStateRequest.MetadataEntry
+	nil,                                   // 18: dapr.proto.runtime.v1.GetBulkStateRequest.MetadataEntry
+	nil,                                   // 19: dapr.proto.runtime.v1.BulkStateItem.MetadataEntry
+	nil,                                   // 20: dapr.proto.runtime.v1.GetStateResponse.MetadataEntry
+	nil,                                   // 21: dapr.proto.runtime.v1.DeleteStateRequest.MetadataEntry
+	nil,                                   // 22: dapr.proto.runtime.v1.QueryStateRequest.MetadataEntry
+	nil,                                   // 23: dapr.proto.runtime.v1.QueryStateResponse.MetadataEntry
+	nil,                                   // 24: dapr.proto.runtime.v1.ExecuteStateTransactionRequest.MetadataEntry
+	nil,                                   // 25: dapr.proto.runtime.v1.StateChangeAlpha1.MetadataEntry
+	(v1.StateOptions_StateConsistency)(0), // 26: dapr.proto.common.v1.StateOptions.StateConsistency
+	(*v1.Etag)(nil),                       // 27: dapr.proto.common.v1.Etag
+	(*v1.StateOptions)(nil),               // 28: dapr.proto.common.v1.StateOptions
+	(*v1.StateItem)(nil),                  // 29: dapr.proto.common.v1.StateItem
 }
 var file_dapr_proto_runtime_v1_state_proto_depIdxs = []int32{
-	21, // 0: dapr.proto.runtime.v1.GetStateRequest.consistency:type_name -> dapr.proto.common.v1.StateOptions.StateConsistency
-	13, // 1: dapr.proto.runtime.v1.GetStateRequest.metadata:type_name -> dapr.proto.runtime.v1.GetStateRequest.MetadataEntry
-	14, // 2: dapr.proto.runtime.v1.GetBulkStateRequest.metadata:type_name -> dapr.proto.runtime.v1.GetBulkStateRequest.MetadataEntry
-	3,  // 3: dapr.proto.runtime.v1.GetBulkStateResponse.items:type_name -> dapr.proto.runtime.v1.BulkStateItem
-	15, // 4: dapr.proto.runtime.v1.BulkStateItem.metadata:type_name -> dapr.proto.runtime.v1.BulkStateItem.MetadataEntry
-	16, // 5: dapr.proto.runtime.v1.GetStateResponse.metadata:type_name -> dapr.proto.runtime.v1.GetStateResponse.MetadataEntry
-	22, // 6: dapr.proto.runtime.v1.DeleteStateRequest.etag:type_name -> dapr.proto.common.v1.Etag
-	23, // 7: dapr.proto.runtime.v1.DeleteStateRequest.options:type_name -> dapr.proto.common.v1.StateOptions
-	17, // 8: dapr.proto.runtime.v1.DeleteStateRequest.metadata:type_name -> dapr.proto.runtime.v1.DeleteStateRequest.MetadataEntry
-	24, // 9: dapr.proto.runtime.v1.DeleteBulkStateRequest.states:type_name -> dapr.proto.common.v1.StateItem
-	24, // 10: dapr.proto.runtime.v1.SaveStateRequest.states:type_name -> dapr.proto.common.v1.StateItem
-	18, // 11: dapr.proto.runtime.v1.QueryStateRequest.metadata:type_name -> dapr.proto.runtime.v1.QueryStateRequest.MetadataEntry
-	9,  // 12: dapr.proto.runtime.v1.QueryStateResponse.results:type_name -> dapr.proto.runtime.v1.QueryStateItem
-	19, // 13: dapr.proto.runtime.v1.QueryStateResponse.metadata:type_name -> dapr.proto.runtime.v1.QueryStateResponse.MetadataEntry
-	24, // 14: dapr.proto.runtime.v1.TransactionalStateOperation.request:type_name -> dapr.proto.common.v1.StateItem
-	11, // 15: dapr.proto.runtime.v1.ExecuteStateTransactionRequest.operations:type_name -> dapr.proto.runtime.v1.TransactionalStateOperation
-	20, // 16: dapr.proto.runtime.v1.ExecuteStateTransactionRequest.metadata:type_name -> dapr.proto.runtime.v1.ExecuteStateTransactionRequest.MetadataEntry
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	26, // 0: dapr.proto.runtime.v1.GetStateRequest.consistency:type_name -> dapr.proto.common.v1.StateOptions.StateConsistency
+	17, // 1: dapr.proto.runtime.v1.GetStateRequest.metadata:type_name -> dapr.proto.runtime.v1.GetStateRequest.MetadataEntry
+	18, // 2: dapr.proto.runtime.v1.GetBulkStateRequest.metadata:type_name -> dapr.proto.runtime.v1.GetBulkStateRequest.MetadataEntry
+	4,  // 3: dapr.proto.runtime.v1.GetBulkStateResponse.items:type_name -> dapr.proto.runtime.v1.BulkStateItem
+	19, // 4: dapr.proto.runtime.v1.BulkStateItem.metadata:type_name -> dapr.proto.runtime.v1.BulkStateItem.MetadataEntry
+	20, // 5: dapr.proto.runtime.v1.GetStateResponse.metadata:type_name -> dapr.proto.runtime.v1.GetStateResponse.MetadataEntry
+	27, // 6: dapr.proto.runtime.v1.DeleteStateRequest.etag:type_name -> dapr.proto.common.v1.Etag
+	28, // 7: dapr.proto.runtime.v1.DeleteStateRequest.options:type_name -> dapr.proto.common.v1.StateOptions
+	21, // 8: dapr.proto.runtime.v1.DeleteStateRequest.metadata:type_name -> dapr.proto.runtime.v1.DeleteStateRequest.MetadataEntry
+	29, // 9: dapr.proto.runtime.v1.DeleteBulkStateRequest.states:type_name -> dapr.proto.common.v1.StateItem
+	29, // 10: dapr.proto.runtime.v1.SaveStateRequest.states:type_name -> dapr.proto.common.v1.StateItem
+	22, // 11: dapr.proto.runtime.v1.QueryStateRequest.metadata:type_name -> dapr.proto.runtime.v1.QueryStateRequest.MetadataEntry
+	10, // 12: dapr.proto.runtime.v1.QueryStateResponse.results:type_name -> dapr.proto.runtime.v1.QueryStateItem
+	23, // 13: dapr.proto.runtime.v1.QueryStateResponse.metadata:type_name -> dapr.proto.runtime.v1.QueryStateResponse.MetadataEntry
+	29, // 14: dapr.proto.runtime.v1.TransactionalStateOperation.request:type_name -> dapr.proto.common.v1.StateItem
+	12, // 15: dapr.proto.runtime.v1.ExecuteStateTransactionRequest.operations:type_name -> dapr.proto.runtime.v1.TransactionalStateOperation
+	24, // 16: dapr.proto.runtime.v1.ExecuteStateTransactionRequest.metadata:type_name -> dapr.proto.runtime.v1.ExecuteStateTransactionRequest.MetadataEntry
+	0,  // 17: dapr.proto.runtime.v1.StateChangeAlpha1.operation:type_name -> dapr.proto.runtime.v1.StateChangeAlpha1.Operation
+	27, // 18: dapr.proto.runtime.v1.StateChangeAlpha1.etag:type_name -> dapr.proto.common.v1.Etag
+	25, // 19: dapr.proto.runtime.v1.StateChangeAlpha1.metadata:type_name -> dapr.proto.runtime.v1.StateChangeAlpha1.MetadataEntry
+	15, // 20: dapr.proto.runtime.v1.SubscribeStateChangesResponseAlpha1.changes:type_name -> dapr.proto.runtime.v1.StateChangeAlpha1
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_dapr_proto_runtime_v1_state_proto_init() }
@@ -1338,19 +1645,56 @@ func file_dapr_proto_runtime_v1_state_proto_init() {
 				return nil
 			}
 		}
+		file_dapr_proto_runtime_v1_state_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeStateChangesRequestAlpha1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_state_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateChangeAlpha1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_state_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeStateChangesResponseAlpha1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_runtime_v1_state_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   21,
+			NumEnums:      1,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_dapr_proto_runtime_v1_state_proto_goTypes,
 		DependencyIndexes: file_dapr_proto_runtime_v1_state_proto_depIdxs,
+		EnumInfos:         file_dapr_proto_runtime_v1_state_proto_enumTypes,
 		MessageInfos:      file_dapr_proto_runtime_v1_state_proto_msgTypes,
 	}.Build()
 	File_dapr_proto_runtime_v1_state_proto = out.File
@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"sync/atomic"
+)
+
+// Reloadable is a Provider whose underlying Resiliency can be swapped at
+// runtime, for example when resiliency resources are hot reloaded.
+// Circuit breaker state is not carried over to the new Resiliency.
+type Reloadable struct {
+	current atomic.Pointer[Resiliency]
+}
+
+// NewReloadable returns a Reloadable provider serving r.
+func NewReloadable(r *Resiliency) *Reloadable {
+	var rl Reloadable
+	rl.current.Store(r)
+	return &rl
+}
+
+// Store replaces the Resiliency served by the provider.
+func (rl *Reloadable) Store(r *Resiliency) {
+	rl.current.Store(r)
+}
+
+// Load returns the Resiliency currently served by the provider.
+func (rl *Reloadable) Load() *Resiliency {
+	return rl.current.Load()
+}
+
+// EndpointPolicy returns the policy for a service endpoint.
+func (rl *Reloadable) EndpointPolicy(service string, endpoint string) *PolicyDefinition {
+	return rl.current.Load().EndpointPolicy(service, endpoint)
+}
+
+// ActorPreLockPolicy returns the policy for an actor instance to be used before the lock is acquired.
+func (rl *Reloadable) ActorPreLockPolicy(actorType string, id string) *PolicyDefinition {
+	return rl.current.Load().ActorPreLockPolicy(actorType, id)
+}
+
+// ActorPostLockPolicy returns the policy for an actor instance to be used after the lock is acquired.
+func (rl *Reloadable) ActorPostLockPolicy(actorType string, id string) *PolicyDefinition {
+	return rl.current.Load().ActorPostLockPolicy(actorType, id)
+}
+
+// ComponentOutboundPolicy returns the outbound policy for a component.
+func (rl *Reloadable) ComponentOutboundPolicy(name string, componentType ComponentType) *PolicyDefinition {
+	return rl.current.Load().ComponentOutboundPolicy(name, componentType)
+}
+
+// ComponentInboundPolicy returns the inbound policy for a component.
+func (rl *Reloadable) ComponentInboundPolicy(name string, componentType ComponentType) *PolicyDefinition {
+	return rl.current.Load().ComponentInboundPolicy(name, componentType)
+}
+
+// BuiltInPolicy returns a built-in policy.
+func (rl *Reloadable) BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition {
+	return rl.current.Load().BuiltInPolicy(name)
+}
+
+// PolicyDefined returns true if there's policy that applies to the target.
+func (rl *Reloadable) PolicyDefined(target string, policyType PolicyType) (exists bool) {
+	return rl.current.Load().PolicyDefined(target, policyType)
+}
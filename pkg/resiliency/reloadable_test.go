@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+func TestReloadable(t *testing.T) {
+	var p Provider = NewReloadable(FromConfigurations(log))
+	assert.False(t, p.PolicyDefined("myApp", EndpointPolicy{}))
+	assert.NotNil(t, p.BuiltInPolicy(BuiltInServiceRetries))
+
+	p.(*Reloadable).Store(FromConfigurations(log, &resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Timeouts: map[string]string{"myTimeout": "2s"},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"myApp": {Timeout: "myTimeout"},
+				},
+			},
+		},
+	}))
+	assert.True(t, p.PolicyDefined("myApp", EndpointPolicy{}))
+	assert.NotNil(t, p.EndpointPolicy("myApp", "myEndpoint"))
+	assert.NotNil(t, p.BuiltInPolicy(BuiltInServiceRetries))
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/dapr/components-contrib/workflows"
 	compsv1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	httpEndpointV1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	compstate "github.com/dapr/dapr/pkg/components/state"
 	"github.com/dapr/dapr/pkg/config"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 )
@@ -40,6 +41,7 @@ type ComponentStore struct {
 	lock sync.RWMutex
 
 	states                  map[string]state.Store
+	stateCaches             map[string]*compstate.Cache
 	configurations          map[string]configuration.Store
 	configurationSubscribes map[string]chan struct{}
 	secretsConfigurations   map[string]config.SecretsScope
@@ -72,6 +74,7 @@ type ComponentStore struct {
 func New() *ComponentStore {
 	return &ComponentStore{
 		states:                  make(map[string]state.Store),
+		stateCaches:             make(map[string]*compstate.Cache),
 		configurations:          make(map[string]configuration.Store),
 		configurationSubscribes: make(map[string]chan struct{}),
 		secretsConfigurations:   make(map[string]config.SecretsScope),
@@ -17,6 +17,7 @@ import (
 	"fmt"
 
 	"github.com/dapr/components-contrib/state"
+	compstate "github.com/dapr/dapr/pkg/components/state"
 )
 
 func (c *ComponentStore) AddStateStore(name string, store state.Store) {
@@ -58,6 +59,7 @@ func (c *ComponentStore) DeleteStateStore(name string) {
 		c.actorStateStore.store = nil
 	}
 	delete(c.states, name)
+	delete(c.stateCaches, name)
 }
 
 func (c *ComponentStore) StateStoresLen() int {
@@ -74,3 +76,23 @@ func (c *ComponentStore) GetStateStoreActor() (state.Store, string, bool) {
 	}
 	return c.actorStateStore.store, c.actorStateStore.name, true
 }
+
+// AddStateStoreCache sets the read cache of a state store. A nil cache removes
+// the read cache of the store.
+func (c *ComponentStore) AddStateStoreCache(name string, cache *compstate.Cache) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if cache == nil {
+		delete(c.stateCaches, name)
+		return
+	}
+	c.stateCaches[name] = cache
+}
+
+// GetStateStoreCache returns the read cache of a state store, or nil if the
+// store has no read cache.
+func (c *ComponentStore) GetStateStoreCache(name string) *compstate.Cache {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.stateCaches[name]
+}
@@ -75,6 +75,15 @@ func (c *ComponentStore) AddDeclarativeSubscription(comp *subapi.Subscription, s
 }
 
 func (c *ComponentStore) AddStreamSubscription(comp *subapi.Subscription, connectionID rtpubsub.ConnectionID) error {
+	var ordering *rtpubsub.Ordering
+	if o := comp.Spec.Ordering; o != nil {
+		var err error
+		ordering, err = rtpubsub.CreateOrdering(o.Key, o.Expression, o.MaxConcurrency)
+		if err != nil {
+			return err
+		}
+	}
+
 	c.lock.Lock()
 	defer func() {
 		c.lock.Unlock()
@@ -91,6 +100,7 @@ func (c *ComponentStore) AddStreamSubscription(comp *subapi.Subscription, connec
 				DeadLetterTopic: comp.Spec.DeadLetterTopic,
 				Metadata:        comp.Spec.Metadata,
 				Rules:           []*rtpubsub.Rule{{Path: "/"}},
+				Ordering:        ordering,
 			},
 		},
 	}
@@ -99,6 +99,7 @@ type Config struct {
 	DaprGracefulShutdownSeconds   int
 	DaprBlockShutdownDuration     *time.Duration
 	ActorsService                 string
+	PlacementWeight               uint32
 	RemindersService              string
 	SchedulerAddress              []string
 	SchedulerStreams              uint
@@ -135,6 +136,7 @@ type internalConfig struct {
 	appConnectionConfig          config.AppConnectionConfig
 	mode                         modes.DaprMode
 	actorsService                string
+	placementWeight              uint32
 	remindersService             string
 	schedulerAddress             []string
 	schedulerStreams             uint
@@ -269,12 +271,13 @@ func FromConfig(ctx context.Context, cfg *Config) (*DaprRuntime, error) {
 	}
 
 	// Load Resiliency
-	var resiliencyProvider *resiliencyConfig.Resiliency
+	var resiliencyProvider resiliencyConfig.Provider
 	switch intc.mode {
 	case modes.KubernetesMode:
 		resiliencyConfigs := resiliencyConfig.LoadKubernetesResiliency(log, intc.id, namespace, operatorClient)
 		log.Debugf("Found %d resiliency configurations from Kubernetes", len(resiliencyConfigs))
-		resiliencyProvider = resiliencyConfig.FromConfigurations(log, resiliencyConfigs...)
+		// Reloadable so that hot reloading can swap in updated policies.
+		resiliencyProvider = resiliencyConfig.NewReloadable(resiliencyConfig.FromConfigurations(log, resiliencyConfigs...))
 	case modes.StandaloneMode:
 		if len(intc.standalone.ResourcesPath) > 0 {
 			resiliencyConfigs := resiliencyConfig.LoadLocalResiliency(log, intc.id, intc.standalone.ResourcesPath...)
@@ -330,6 +333,7 @@ func (c *Config) toInternal() (*internalConfig, error) {
 		metricsExporter:           metrics.New(c.Metrics),
 		blockShutdownDuration:     c.DaprBlockShutdownDuration,
 		actorsService:             c.ActorsService,
+		placementWeight:           c.PlacementWeight,
 		remindersService:          c.RemindersService,
 		schedulerAddress:          c.SchedulerAddress,
 		schedulerStreams:          c.SchedulerStreams,
@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/dapr/dapr/pkg/apis/common"
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 )
 
@@ -44,6 +45,7 @@ func Test_toComparableObj(t *testing.T) {
 			compWithoutObject.TypeMeta = metav1.TypeMeta{
 				Kind: "Component", APIVersion: "dapr.io/v1alpha1",
 			}
+			compWithoutObject.Status = common.ResourceStatus{}
 			assert.Equal(t, compWithoutObject, toComparableObj[componentsapi.Component](components[i]))
 		})
 	}
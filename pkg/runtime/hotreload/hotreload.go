@@ -21,6 +21,7 @@ import (
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/healthz"
 	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/authorizer"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	"github.com/dapr/dapr/pkg/runtime/hotreload/loader"
@@ -47,12 +48,19 @@ type OptionsReloaderDisk struct {
 type OptionsReloaderOperator struct {
 	PodName        string
 	Namespace      string
+	AppID          string
 	Client         operatorv1.OperatorClient
 	Config         *config.Configuration
 	ComponentStore *compstore.ComponentStore
 	Authorizer     *authorizer.Authorizer
 	Processor      *processor.Processor
 	Healthz        healthz.Healthz
+
+	// Resiliency is rebuilt whenever a Resiliency resource changes.
+	Resiliency *resiliency.Reloadable
+	// ConfigName is the name of the Configuration resource daprd was started
+	// with. Changes to it are logged as requiring a restart.
+	ConfigName string
 }
 
 type Reloader struct {
@@ -60,6 +68,7 @@ type Reloader struct {
 	loader                  loader.Interface
 	componentsReconciler    *reconciler.Reconciler[compapi.Component]
 	subscriptionsReconciler *reconciler.Reconciler[subapi.Subscription]
+	operatorRunners         []concurrency.Runner
 }
 
 func NewDisk(opts OptionsReloaderDisk) (*Reloader, error) {
@@ -110,6 +119,25 @@ func NewOperator(opts OptionsReloaderOperator) *Reloader {
 		OperatorClient: opts.Client,
 	})
 
+	var runners []concurrency.Runner
+	if opts.Resiliency != nil {
+		runners = append(runners, (&resiliencyReloader{
+			client:    opts.Client,
+			podName:   opts.PodName,
+			namespace: opts.Namespace,
+			appID:     opts.AppID,
+			provider:  opts.Resiliency,
+		}).Run)
+	}
+	if len(opts.ConfigName) > 0 {
+		runners = append(runners, (&configurationWatcher{
+			client:    opts.Client,
+			podName:   opts.PodName,
+			namespace: opts.Namespace,
+			name:      opts.ConfigName,
+		}).Run)
+	}
+
 	return &Reloader{
 		isEnabled: isEnabled,
 		loader:    loader,
@@ -127,6 +155,7 @@ func NewOperator(opts OptionsReloaderOperator) *Reloader {
 			Authorizer: opts.Authorizer,
 			Healthz:    opts.Healthz,
 		}),
+		operatorRunners: runners,
 	}
 }
 
@@ -137,11 +166,15 @@ func (r *Reloader) Run(ctx context.Context) error {
 		return nil
 	}
 
-	log.Info("Hot reloading enabled. Daprd will reload 'Component' and 'Subscription' resources on change.")
+	if len(r.operatorRunners) > 0 {
+		log.Info("Hot reloading enabled. Daprd will reload 'Component', 'Subscription' and 'Resiliency' resources on change. 'Configuration' changes require a restart.")
+	} else {
+		log.Info("Hot reloading enabled. Daprd will reload 'Component' and 'Subscription' resources on change.")
+	}
 
-	return concurrency.NewRunnerManager(
+	return concurrency.NewRunnerManager(append([]concurrency.Runner{
 		r.loader.Run,
 		r.componentsReconciler.Run,
 		r.subscriptionsReconciler.Run,
-	).Run(ctx)
+	}, r.operatorRunners...)...).Run(ctx)
 }
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hotreload
+
+import (
+	"context"
+
+	"github.com/cenkalti/backoff/v4"
+
+	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// receiver is implemented by the operator's server streaming clients.
+type receiver[T any] interface {
+	Recv() (T, error)
+}
+
+// watch establishes the stream returned by establish, calling onEvent for
+// every event received. The stream is re-established with backoff when it
+// fails; onReconnect is called once a stream has been re-established so that
+// events missed while disconnected can be accounted for.
+func watch[T any](ctx context.Context, name string, establish func(context.Context) (receiver[T], error), onEvent func(T), onReconnect func()) error {
+	for reconnect := false; ; reconnect = true {
+		stream, err := backoff.RetryWithData(func() (receiver[T], error) {
+			s, serr := establish(ctx)
+			if serr != nil && ctx.Err() == nil {
+				log.Errorf("Failed to establish %s stream with operator: %s", name, serr)
+			}
+			return s, serr
+		}, backoff.WithContext(backoff.NewExponentialBackOff(), ctx))
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		if reconnect {
+			log.Infof("Reconnected %s stream to operator", name)
+			onReconnect()
+		}
+
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				log.Errorf("Error from operator %s stream: %s", name, err)
+				break
+			}
+			onEvent(event)
+		}
+	}
+}
+
+// resiliencyReloader rebuilds the runtime's resiliency policies whenever a
+// Resiliency resource in the namespace changes.
+type resiliencyReloader struct {
+	client    operatorv1.OperatorClient
+	podName   string
+	namespace string
+	appID     string
+	provider  *resiliency.Reloadable
+}
+
+func (r *resiliencyReloader) Run(ctx context.Context) error {
+	return watch(ctx, "resiliency",
+		func(ctx context.Context) (receiver[*operatorv1.ResiliencyUpdateEvent], error) {
+			return r.client.ResiliencyUpdate(ctx, &operatorv1.ResiliencyUpdateRequest{
+				Namespace: r.namespace,
+				PodName:   r.podName,
+			})
+		},
+		func(event *operatorv1.ResiliencyUpdateEvent) {
+			log.Infof("Received Resiliency %s event from operator", event.GetType())
+			r.reload()
+		},
+		r.reload,
+	)
+}
+
+// reload lists all Resiliency resources and swaps the runtime's resiliency
+// provider. Circuit breaker state is reset.
+func (r *resiliencyReloader) reload() {
+	configs := resiliency.LoadKubernetesResiliency(log, r.appID, r.namespace, r.client)
+	r.provider.Store(resiliency.FromConfigurations(log, configs...))
+	log.Infof("Reloaded %d Resiliency configurations", len(configs))
+}
+
+// configurationWatcher watches the Configuration resource of the runtime.
+// Configuration is applied at startup only, so changes are surfaced as a
+// warning asking for daprd to be restarted.
+type configurationWatcher struct {
+	client    operatorv1.OperatorClient
+	podName   string
+	namespace string
+	name      string
+}
+
+func (c *configurationWatcher) Run(ctx context.Context) error {
+	return watch(ctx, "configuration",
+		func(ctx context.Context) (receiver[*operatorv1.ConfigurationUpdateEvent], error) {
+			return c.client.ConfigurationUpdate(ctx, &operatorv1.ConfigurationUpdateRequest{
+				Name:      c.name,
+				Namespace: c.namespace,
+				PodName:   c.podName,
+			})
+		},
+		func(event *operatorv1.ConfigurationUpdateEvent) {
+			log.Warnf("Configuration '%s' was %s; Configuration changes are not hot reloaded, restart daprd to apply them", c.name, event.GetType())
+		},
+		func() {},
+	)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hotreload
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeReceiver struct {
+	ch chan error
+}
+
+func (f *fakeReceiver) Recv() (string, error) {
+	if err := <-f.ch; err != nil {
+		return "", err
+	}
+	return "event", nil
+}
+
+func Test_watch(t *testing.T) {
+	recvCh := make(chan error)
+	var establishes, reconnects atomic.Int32
+	events := make(chan string, 1)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error)
+	go func() {
+		errCh <- watch(ctx, "test",
+			func(ctx context.Context) (receiver[string], error) {
+				if establishes.Add(1) == 1 {
+					return nil, errors.New("not yet")
+				}
+				return &fakeReceiver{ch: recvCh}, nil
+			},
+			func(event string) { events <- event },
+			func() { reconnects.Add(1) },
+		)
+	}()
+
+	recvCh <- nil
+	select {
+	case event := <-events:
+		assert.Equal(t, "event", event)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for event")
+	}
+	assert.Equal(t, int32(2), establishes.Load())
+	assert.Equal(t, int32(0), reconnects.Load())
+
+	recvCh <- errors.New("stream broken")
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, int32(1), reconnects.Load())
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(3), establishes.Load())
+
+	cancel()
+	recvCh <- errors.New("context canceled")
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for watch to return")
+	}
+}
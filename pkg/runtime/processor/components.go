@@ -47,17 +47,18 @@ func (p *Processor) Init(ctx context.Context, comp componentsapi.Component) erro
 		message = ptr.Of(initerr.Error())
 	}
 
-	if err := p.reporter(ctx, comp,
-		&operatorv1.ResourceResult{
-			ResourceType:        operatorv1.ResourceType_RESOURCE_COMPONENT,
-			EventType:           operatorv1.EventType_EVENT_INIT,
-			Name:                comp.GetName(),
-			Condition:           condition,
-			Reason:              reason,
-			Message:             message,
-			ObservedGeneration:  comp.GetGeneration(),
-			LastTransactionTime: timestamppb.New(time.Now()),
-		}); err != nil {
+	result := &operatorv1.ResourceResult{
+		ResourceType:        operatorv1.ResourceType_RESOURCE_COMPONENT,
+		EventType:           operatorv1.EventType_EVENT_INIT,
+		Name:                comp.GetName(),
+		Condition:           condition,
+		Reason:              reason,
+		Message:             message,
+		ObservedGeneration:  comp.GetGeneration(),
+		LastTransactionTime: timestamppb.New(time.Now()),
+	}
+	p.status.Report(result)
+	if err := p.reporter(ctx, comp, result); err != nil {
 		return errors.Join(initerr, fmt.Errorf("error reporting component init result: %w", err), p.Close(comp))
 	}
 
@@ -104,17 +105,18 @@ func (p *Processor) Close(comp componentsapi.Component) error {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	if err := p.reporter(ctx, comp,
-		&operatorv1.ResourceResult{
-			ResourceType:        operatorv1.ResourceType_RESOURCE_COMPONENT,
-			EventType:           operatorv1.EventType_EVENT_CLOSE,
-			Name:                comp.GetName(),
-			Condition:           condition,
-			Reason:              reason,
-			Message:             message,
-			ObservedGeneration:  comp.GetGeneration(),
-			LastTransactionTime: timestamppb.New(time.Now()),
-		}); err != nil {
+	result := &operatorv1.ResourceResult{
+		ResourceType:        operatorv1.ResourceType_RESOURCE_COMPONENT,
+		EventType:           operatorv1.EventType_EVENT_CLOSE,
+		Name:                comp.GetName(),
+		Condition:           condition,
+		Reason:              reason,
+		Message:             message,
+		ObservedGeneration:  comp.GetGeneration(),
+		LastTransactionTime: timestamppb.New(time.Now()),
+	}
+	p.status.Report(result)
+	if err := p.reporter(ctx, comp, result); err != nil {
 		return errors.Join(closeErr, fmt.Errorf("error reporting component close result: %w", err))
 	}
 
@@ -21,6 +21,7 @@ import (
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	"github.com/dapr/dapr/pkg/internal/apis"
+	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
 )
 
 func (p *Processor) AddPendingEndpoint(ctx context.Context, endpoint httpendpointsapi.HTTPEndpoint) bool {
@@ -47,6 +48,8 @@ func (p *Processor) processHTTPEndpoints(ctx context.Context) error {
 		}
 		p.processHTTPEndpointSecrets(ctx, &endpoint)
 		p.compStore.AddHTTPEndpoint(endpoint)
+		p.reportStatus(operatorv1.ResourceType_RESOURCE_HTTPENDPOINT, operatorv1.EventType_EVENT_INIT,
+			endpoint.Name, endpoint.Generation, nil)
 	}
 
 	return nil
@@ -23,7 +23,9 @@ import (
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/runtime/meta"
+	"github.com/dapr/dapr/pkg/runtime/processor/subscriber"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
 )
 
 // manager implements the life cycle events of a component category.
@@ -52,6 +54,10 @@ type SubscribeManager interface {
 	StopStreamerSubscription(sub *subapi.Subscription, connectionID rtpubsub.ConnectionID)
 	ReloadPubSub(string) error
 	StopPubSub(string)
+	ListSubscriptionStatuses() []subscriber.SubscriptionStatus
+	PauseSubscription(pubsubName, topic string) error
+	ResumeSubscription(pubsubName, topic string) error
+	SetScheduler(schedclient.Interface)
 }
 
 type BindingManager interface {
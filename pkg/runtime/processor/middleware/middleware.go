@@ -16,10 +16,13 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	contribmiddle "github.com/dapr/components-contrib/middleware"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	compmiddlegrpc "github.com/dapr/dapr/pkg/components/middleware/grpc"
 	compmiddlehttp "github.com/dapr/dapr/pkg/components/middleware/http"
+	"github.com/dapr/dapr/pkg/middleware/grpc"
 	"github.com/dapr/dapr/pkg/middleware/http"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	"github.com/dapr/dapr/pkg/runtime/meta"
@@ -34,13 +37,24 @@ type Options struct {
 
 	// HTTP is the HTTP middleware pipeline.
 	HTTP *http.HTTP
+
+	// RegistryGRPC is the gRPC middleware registry.
+	RegistryGRPC *compmiddlegrpc.Registry
+
+	// GRPC is the gRPC middleware pipeline.
+	GRPC *grpc.GRPC
 }
 
+// grpcTypePrefix is the prefix of the type of gRPC middleware components.
+const grpcTypePrefix = "middleware.grpc."
+
 // middleware is a component that implements the middleware interface.
 type middleware struct {
 	meta         *meta.Meta
 	registryHTTP *compmiddlehttp.Registry
 	http         *http.HTTP
+	registryGRPC *compmiddlegrpc.Registry
+	grpc         *grpc.GRPC
 }
 
 func New(opts Options) *middleware {
@@ -48,6 +62,8 @@ func New(opts Options) *middleware {
 		meta:         opts.Meta,
 		registryHTTP: opts.RegistryHTTP,
 		http:         opts.HTTP,
+		registryGRPC: opts.RegistryGRPC,
+		grpc:         opts.GRPC,
 	}
 }
 
@@ -57,6 +73,10 @@ func (m *middleware) Init(_ context.Context, comp compapi.Component) error {
 		return err
 	}
 
+	if strings.HasPrefix(strings.ToLower(comp.Spec.Type), grpcTypePrefix) {
+		return m.initGRPC(comp, contribmiddle.Metadata{Base: meta})
+	}
+
 	middle, err := m.registryHTTP.Create(comp.Spec.Type, comp.Spec.Version, contribmiddle.Metadata{Base: meta}, comp.LogName())
 	if err != nil {
 		return rterrors.NewInit(rterrors.CreateComponentFailure, comp.LogName(),
@@ -72,7 +92,36 @@ func (m *middleware) Init(_ context.Context, comp compapi.Component) error {
 	return nil
 }
 
+func (m *middleware) initGRPC(comp compapi.Component, meta contribmiddle.Metadata) error {
+	if m.registryGRPC == nil || m.grpc == nil {
+		return rterrors.NewInit(rterrors.CreateComponentFailure, comp.LogName(),
+			fmt.Errorf("process component %s error: gRPC middleware is not supported", comp.Name),
+		)
+	}
+
+	middle, err := m.registryGRPC.Create(comp.Spec.Type, comp.Spec.Version, meta, comp.LogName())
+	if err != nil {
+		return rterrors.NewInit(rterrors.CreateComponentFailure, comp.LogName(),
+			fmt.Errorf("process component %s error: %w", comp.Name, err),
+		)
+	}
+
+	m.grpc.Add(grpc.Spec{
+		Component:      comp,
+		Implementation: middle,
+	})
+
+	return nil
+}
+
 func (m *middleware) Close(comp compapi.Component) error {
+	if strings.HasPrefix(strings.ToLower(comp.Spec.Type), grpcTypePrefix) {
+		if m.grpc != nil {
+			m.grpc.Remove(comp.Name)
+		}
+		return nil
+	}
+
 	m.http.Remove(comp.Name)
 	return nil
 }
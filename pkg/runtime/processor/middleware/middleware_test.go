@@ -14,20 +14,24 @@ limitations under the License.
 package middleware
 
 import (
+	"context"
 	nethttp "net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	grpcgo "google.golang.org/grpc"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	contribmiddleware "github.com/dapr/components-contrib/middleware"
 	"github.com/dapr/dapr/pkg/apis/common"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	compmiddlegrpc "github.com/dapr/dapr/pkg/components/middleware/grpc"
 	compmiddlehttp "github.com/dapr/dapr/pkg/components/middleware/http"
 	"github.com/dapr/dapr/pkg/config"
 	daprmiddleware "github.com/dapr/dapr/pkg/middleware"
+	"github.com/dapr/dapr/pkg/middleware/grpc"
 	"github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/dapr/dapr/pkg/runtime/meta"
 	"github.com/dapr/dapr/pkg/runtime/registry"
@@ -268,4 +272,63 @@ func TestInit(t *testing.T) {
 		assert.Equal(t, 4, rootCalled)
 		assert.Equal(t, 5, middlewareCalled)
 	})
+	t.Run("grpc middleware should be added to and removed from gRPC middleware manager", func(t *testing.T) {
+		reg := registry.New(
+			registry.NewOptions().WithGRPCMiddlewares(compmiddlegrpc.NewRegistry()),
+		).GRPCMiddlewares()
+		mngr := grpc.New()
+
+		pipeline := mngr.BuildPipelineFromSpec("test", &config.PipelineSpec{
+			Handlers: []config.HandlerSpec{
+				{Name: "test", Type: "middleware.grpc.mock", Version: "v1"},
+			},
+		})
+
+		m := New(Options{
+			Meta:         meta.New(meta.Options{}),
+			HTTP:         http.New(),
+			RegistryGRPC: reg,
+			GRPC:         mngr,
+		})
+
+		var middlewareCalled int
+		reg.RegisterComponent(func(logger.Logger) compmiddlegrpc.FactoryMethod {
+			return func(meta contribmiddleware.Metadata) (daprmiddleware.GRPC, error) {
+				return daprmiddleware.GRPC{
+					Unary: func(ctx context.Context, req any, _ *grpcgo.UnaryServerInfo, handler grpcgo.UnaryHandler) (any, error) {
+						middlewareCalled++
+						return handler(ctx, req)
+					},
+				}, nil
+			}
+		}, "mock")
+
+		var rootCalled int
+		invoke := func() {
+			_, err := pipeline.Unary(t.Context(), nil, &grpcgo.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+				rootCalled++
+				return nil, nil
+			})
+			require.NoError(t, err)
+		}
+
+		comp := compapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec:       compapi.ComponentSpec{Type: "middleware.grpc.mock", Version: "v1"},
+		}
+
+		invoke()
+		assert.Equal(t, 1, rootCalled)
+		assert.Equal(t, 0, middlewareCalled)
+
+		require.NoError(t, m.Init(t.Context(), comp))
+		invoke()
+		assert.Equal(t, 2, rootCalled)
+		assert.Equal(t, 1, middlewareCalled)
+
+		require.NoError(t, m.Close(comp))
+		invoke()
+		assert.Equal(t, 3, rootCalled)
+		assert.Equal(t, 1, middlewareCalled)
+	})
 }
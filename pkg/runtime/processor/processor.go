@@ -20,12 +20,15 @@ import (
 	"sync/atomic"
 	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/dapr/dapr/pkg/actors"
 	grpcmanager "github.com/dapr/dapr/pkg/api/grpc/manager"
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	"github.com/dapr/dapr/pkg/components"
 	"github.com/dapr/dapr/pkg/config"
+	middlewaregrpc "github.com/dapr/dapr/pkg/middleware/grpc"
 	"github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/outbox"
@@ -46,9 +49,11 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/processor/subscriber"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/registry"
+	"github.com/dapr/dapr/pkg/runtime/status"
 	"github.com/dapr/dapr/pkg/security"
 	"github.com/dapr/kit/concurrency"
 	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
 )
 
 const (
@@ -100,6 +105,8 @@ type Options struct {
 
 	MiddlewareHTTP *http.HTTP
 
+	MiddlewareGRPC *middlewaregrpc.GRPC
+
 	Security security.Handler
 
 	Outbox outbox.Outbox
@@ -109,6 +116,10 @@ type Options struct {
 
 	// Reporter is the reporter for the operator.
 	Reporter registry.Reporter
+
+	// Status reports the results of loading resources to the operator. May be
+	// nil.
+	Status *status.Reporter
 }
 
 // Processor manages the lifecycle of all components categories.
@@ -123,6 +134,7 @@ type Processor struct {
 	security        security.Handler
 	subscriber      *subscriber.Subscriber
 	reporter        registry.Reporter
+	status          *status.Reporter
 
 	pendingHTTPEndpoints       chan httpendpointsapi.HTTPEndpoint
 	pendingComponents          chan componentsapi.Component
@@ -197,6 +209,7 @@ func New(opts Options) *Processor {
 		security:                   opts.Security,
 		subscriber:                 subscriber,
 		reporter:                   reporter,
+		status:                     opts.Status,
 		managers: map[components.Category]manager{
 			components.CategoryBindings: binding,
 			components.CategoryConfiguration: configuration.New(configuration.Options{
@@ -227,6 +240,8 @@ func New(opts Options) *Processor {
 				Meta:         opts.Meta,
 				RegistryHTTP: opts.Registry.HTTPMiddlewares(),
 				HTTP:         opts.MiddlewareHTTP,
+				RegistryGRPC: opts.Registry.GRPCMiddlewares(),
+				GRPC:         opts.MiddlewareGRPC,
 			}),
 			components.CategoryConversation: conversation.New(conversation.Options{
 				Meta:     opts.Meta,
@@ -264,3 +279,22 @@ func (p *Processor) Process(ctx context.Context) error {
 func DefaultReporter(context.Context, componentsapi.Component, *operatorv1.ResourceResult) error {
 	return nil
 }
+
+// reportStatus reports the result of loading, or closing, a resource to the
+// operator.
+func (p *Processor) reportStatus(resourceType operatorv1.ResourceType, eventType operatorv1.EventType, name string, generation int64, err error) {
+	result := &operatorv1.ResourceResult{
+		ResourceType:        resourceType,
+		EventType:           eventType,
+		Name:                name,
+		Condition:           operatorv1.ResourceConditionStatus_STATUS_SUCCESS,
+		ObservedGeneration:  generation,
+		LastTransactionTime: timestamppb.New(time.Now()),
+	}
+	if err != nil {
+		result.Condition = operatorv1.ResourceConditionStatus_STATUS_FAILURE
+		result.Reason = ptr.Of("ERROR")
+		result.Message = ptr.Of(err.Error())
+	}
+	p.status.Report(result)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/meta"
 	"github.com/dapr/dapr/pkg/runtime/processor/subscriber"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	"github.com/dapr/dapr/pkg/scopes"
 )
 
@@ -82,6 +83,12 @@ func (p *pubsub) Init(ctx context.Context, comp compapi.Component) error {
 	}
 	properties["consumerID"] = consumerID
 
+	schemas, err := schema.FromMetadata(properties)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+
 	err = pubSub.Init(ctx, contribpubsub.Metadata{Base: baseMetadata})
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
@@ -96,6 +103,7 @@ func (p *pubsub) Init(ctx context.Context, comp compapi.Component) error {
 		AllowedTopics:       scopes.GetAllowedTopics(properties),
 		ProtectedTopics:     scopes.GetProtectedTopics(properties),
 		NamespaceScoped:     meta.ContainsNamespace(comp.Spec.Metadata),
+		Schemas:             schemas,
 	}
 
 	p.compStore.AddPubSub(pubsubName, pubsubItem)
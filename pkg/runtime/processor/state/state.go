@@ -91,7 +91,18 @@ func (s *state) Init(ctx context.Context, comp compapi.Component) error {
 		return rterrors.NewInit(rterrors.CreateComponentFailure, fName, err)
 	}
 
-	if encKeys.Primary.Key != "" {
+	encKeys.Envelope, err = encryption.ComponentEnvelopeKey(comp, s.compStore.GetCryptoProvider)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "creation", comp.ObjectMeta.Name)
+		return rterrors.NewInit(rterrors.CreateComponentFailure, fName, err)
+	}
+
+	if encKeys.Envelope != nil {
+		ok := encryption.AddEncryptedStateStore(comp.ObjectMeta.Name, encKeys)
+		if ok {
+			log.Infof("Envelope encryption enabled for state store %s with key %s of crypto component %s", comp.ObjectMeta.Name, encKeys.Envelope.KeyName, encKeys.Envelope.Component)
+		}
+	} else if encKeys.Primary.Key != "" {
 		ok := encryption.AddEncryptedStateStore(comp.ObjectMeta.Name, encKeys)
 		if ok {
 			log.Infof("Automatic encryption enabled for state store %s", comp.ObjectMeta.Name)
@@ -106,6 +117,12 @@ func (s *state) Init(ctx context.Context, comp compapi.Component) error {
 	}
 
 	props := meta.Properties
+	cache, err := compstate.NewCacheFromMetadata(comp.ObjectMeta.Name, props)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+
 	err = store.Init(ctx, contribstate.Metadata{Base: meta})
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
@@ -136,6 +153,10 @@ func (s *state) Init(ctx context.Context, comp compapi.Component) error {
 	}
 
 	s.compStore.AddStateStore(comp.ObjectMeta.Name, store)
+	s.compStore.AddStateStoreCache(comp.ObjectMeta.Name, cache)
+	if cache != nil {
+		log.Infof("Read cache enabled for state store %s", comp.ObjectMeta.Name)
+	}
 	err = compstate.SaveStateConfiguration(comp.ObjectMeta.Name, props)
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
@@ -160,6 +181,7 @@ func (s *state) Close(comp compapi.Component) error {
 	}
 
 	defer s.compStore.DeleteStateStore(comp.Name)
+	defer encryption.RemoveEncryptedStateStore(comp.Name)
 
 	if err := ss.Close(); err != nil {
 		return err
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	tmock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -138,6 +139,47 @@ func TestInitState(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, ok)
 	})
+
+	t.Run("test init state store, read cache", func(t *testing.T) {
+		mockStateStore := new(daprt.MockStateStore)
+		mockStateStore.On("Init", tmock.Anything).Return(nil)
+		reg.StateStores().RegisterComponent(
+			func(_ logger.Logger) contribstate.Store {
+				return mockStateStore
+			},
+			"mockCachedState",
+		)
+
+		cachedStateComponent := func(name, size string) compapi.Component {
+			return compapi.Component{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: compapi.ComponentSpec{
+					Type:    "state.mockCachedState",
+					Version: "v1",
+					Metadata: []common.NameValuePair{
+						{
+							Name: stateLoader.CacheSizeKey,
+							Value: common.DynamicValue{
+								JSON: apiextv1.JSON{Raw: []byte(size)},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		require.NoError(t, proc.Init(t.Context(), cachedStateComponent("cached", "100")))
+		assert.NotNil(t, compStore.GetStateStoreCache("cached"))
+
+		require.NoError(t, proc.Init(t.Context(), cachedStateComponent("notcached", "0")))
+		assert.Nil(t, compStore.GetStateStoreCache("notcached"))
+
+		require.Error(t, proc.Init(t.Context(), cachedStateComponent("invalidcache", "foo")))
+		_, ok := compStore.GetStateStore("invalidcache")
+		assert.False(t, ok)
+	})
 }
 
 func initMockStateStoreForRegistry(reg *registry.Registry, name, encryptKey string, e error) *daprt.MockStateStore {
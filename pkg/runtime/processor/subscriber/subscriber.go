@@ -33,6 +33,7 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/dapr/pkg/runtime/subscription"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman"
 	postmangrpc "github.com/dapr/dapr/pkg/runtime/subscription/postman/grpc"
@@ -54,6 +55,30 @@ type Options struct {
 	AdapterStreamer rtpubsub.AdapterStreamer
 }
 
+// SubscriptionState is the runtime state of a subscription.
+type SubscriptionState string
+
+const (
+	// SubscriptionStateActive is the state of a subscription which is
+	// delivering messages to the app.
+	SubscriptionStateActive SubscriptionState = "ACTIVE"
+	// SubscriptionStatePaused is the state of a subscription which has been
+	// paused, and does not consume messages until it is resumed.
+	SubscriptionStatePaused SubscriptionState = "PAUSED"
+	// SubscriptionStateInactive is the state of a subscription which is not
+	// running, for example because the app is not ready, or its pub/sub
+	// component failed to subscribe.
+	SubscriptionStateInactive SubscriptionState = "INACTIVE"
+)
+
+// SubscriptionStatus reports the runtime state of a subscription.
+type SubscriptionStatus struct {
+	PubsubName string            `json:"pubsubname"`
+	Topic      string            `json:"topic"`
+	Type       string            `json:"type"`
+	State      SubscriptionState `json:"state"`
+}
+
 type Subscriber struct {
 	appID           string
 	namespace       string
@@ -65,6 +90,7 @@ type Subscriber struct {
 	compStore       *compstore.ComponentStore
 	adapter         rtpubsub.Adapter
 	adapterStreamer rtpubsub.AdapterStreamer
+	scheduler       schedclient.Interface
 
 	appSubs      map[string][]*namedSubscription
 	streamSubs   map[string]map[rtpubsub.ConnectionID]*namedSubscription
@@ -75,6 +101,10 @@ type Subscriber struct {
 
 	retryCtx    map[string]context.Context
 	retryCancel map[string]context.CancelFunc
+
+	// paused holds, per pub/sub, the topics of app subscriptions which have
+	// been paused, and are not started until they are resumed.
+	paused map[string]map[string]struct{}
 }
 
 type namedSubscription struct {
@@ -98,6 +128,7 @@ func New(opts Options) *Subscriber {
 		adapterStreamer: opts.AdapterStreamer,
 		appSubs:         make(map[string][]*namedSubscription),
 		streamSubs:      make(map[string]map[rtpubsub.ConnectionID]*namedSubscription),
+		paused:          make(map[string]map[string]struct{}),
 		retryCtx:        make(map[string]context.Context),
 		retryCancel:     make(map[string]context.CancelFunc),
 	}
@@ -144,6 +175,15 @@ func (s *Subscriber) StopAllSubscriptionsForever() {
 	wg.Wait()
 }
 
+// SetScheduler sets the Scheduler client used to schedule the delayed
+// publish of events to retry topics. Only subscriptions started afterwards
+// use it.
+func (s *Subscriber) SetScheduler(scheduler schedclient.Interface) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.scheduler = scheduler
+}
+
 func (s *Subscriber) ReloadPubSub(name string) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -258,6 +298,10 @@ func (s *Subscriber) ReloadDeclaredAppSubscription(name, pubsubName string) erro
 		return nil
 	}
 
+	if s.isPaused(pubsubName, sub.Topic) {
+		return nil
+	}
+
 	ss, err := s.startSubscription(ps, sub.NamedSubscription, false)
 	if err != nil {
 		log.Errorf("Failed to start declared subscription %s for pubsub %s, topic %s: %s", name, pubsubName, sub.Topic, err)
@@ -333,6 +377,10 @@ func (s *Subscriber) StartAppSubscriptions() error {
 
 	for name, ps := range s.compStore.ListPubSubs() {
 		for _, sub := range s.compStore.ListSubscriptionsAppByPubSub(name) {
+			if s.isPaused(name, sub.Topic) {
+				continue
+			}
+
 			ss, err := s.startSubscription(ps, sub, false)
 			if err != nil {
 				errs = append(errs, err)
@@ -369,6 +417,14 @@ func (s *Subscriber) retrySubscription(pubsubName string, pubsub *rtpubsub.Pubsu
 			return nil
 		}
 
+		s.lock.RLock()
+		paused := s.isPaused(pubsubName, sub.Topic)
+		s.lock.RUnlock()
+		if paused {
+			log.Debugf("Stopping retry for subscription pubsub %s, topic %s as it is paused", pubsubName, sub.Topic)
+			return nil
+		}
+
 		ss, err := s.startSubscription(pubsub, sub, false)
 		if err != nil {
 			log.Errorf("Retry failed for subscription pubsub %s, topic %s: %s. Will retry.", pubsubName, sub.Topic, err)
@@ -376,14 +432,15 @@ func (s *Subscriber) retrySubscription(pubsubName string, pubsub *rtpubsub.Pubsu
 		}
 
 		s.lock.Lock()
-		if !s.closed.Load() && s.appSubActive {
+		if !s.closed.Load() && s.appSubActive && !s.isPaused(pubsubName, sub.Topic) {
 			s.appSubs[pubsubName] = append(s.appSubs[pubsubName], &namedSubscription{
 				name:         sub.Name,
 				Subscription: ss,
 			})
 			log.Infof("Successfully started subscription after retry for pubsub %s, topic %s", pubsubName, sub.Topic)
 		} else {
-			// Subscriber was closed while we were retrying, stop the subscription
+			// Subscriber was closed, or the subscription paused, while we were
+			// retrying, stop the subscription
 			ss.Stop()
 		}
 		s.lock.Unlock()
@@ -417,6 +474,144 @@ func (s *Subscriber) StopAppSubscriptions() {
 	s.appSubs = make(map[string][]*namedSubscription)
 }
 
+// ListSubscriptionStatuses returns the runtime state of every subscription of
+// the app.
+func (s *Subscriber) ListSubscriptionStatuses() []SubscriptionStatus {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	subs := s.compStore.ListTypedSubscriptions()
+	statuses := make([]SubscriptionStatus, 0, len(subs))
+	for _, sub := range subs {
+		status := SubscriptionStatus{
+			PubsubName: sub.PubsubName,
+			Topic:      sub.Topic,
+			Type:       sub.Type.String(),
+			State:      SubscriptionStateInactive,
+		}
+
+		switch {
+		case sub.Type == runtimev1pb.PubsubSubscriptionType_STREAMING:
+			status.State = SubscriptionStateActive
+		case s.isPaused(sub.PubsubName, sub.Topic):
+			status.State = SubscriptionStatePaused
+		case s.appSubscription(sub.PubsubName, sub.Topic) != nil:
+			status.State = SubscriptionStateActive
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// PauseSubscription stops the app subscription to the given topic, until it
+// is resumed. Messages which have not yet been delivered to the app are not
+// consumed, and so stay un-acknowledged in the broker. The pause outlives
+// reloads of the pub/sub component and of the subscription.
+func (s *Subscriber) PauseSubscription(pubsubName, topic string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.hasAppSubscription(pubsubName, topic) {
+		return apierrors.PubSub(pubsubName).SubscriptionNotFound(topic)
+	}
+
+	if s.isPaused(pubsubName, topic) {
+		return nil
+	}
+
+	if _, ok := s.paused[pubsubName]; !ok {
+		s.paused[pubsubName] = make(map[string]struct{})
+	}
+	s.paused[pubsubName][topic] = struct{}{}
+
+	for i, appsub := range s.appSubs[pubsubName] {
+		if appsub.Topic() == topic {
+			appsub.Stop()
+			s.appSubs[pubsubName] = append(s.appSubs[pubsubName][:i], s.appSubs[pubsubName][i+1:]...)
+			break
+		}
+	}
+
+	log.Infof("Paused subscription for pubsub %s, topic %s", pubsubName, topic)
+
+	return nil
+}
+
+// ResumeSubscription starts the paused app subscription to the given topic.
+func (s *Subscriber) ResumeSubscription(pubsubName, topic string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.isPaused(pubsubName, topic) {
+		if !s.hasAppSubscription(pubsubName, topic) {
+			return apierrors.PubSub(pubsubName).SubscriptionNotFound(topic)
+		}
+		return nil
+	}
+
+	delete(s.paused[pubsubName], topic)
+	if len(s.paused[pubsubName]) == 0 {
+		delete(s.paused, pubsubName)
+	}
+
+	log.Infof("Resumed subscription for pubsub %s, topic %s", pubsubName, topic)
+
+	if !s.appSubActive || s.closed.Load() {
+		return nil
+	}
+
+	ps, ok := s.compStore.GetPubSub(pubsubName)
+	if !ok {
+		return nil
+	}
+
+	for _, sub := range s.compStore.ListSubscriptionsAppByPubSub(pubsubName) {
+		if sub.Topic != topic {
+			continue
+		}
+
+		ss, err := s.startSubscription(ps, sub, false)
+		if err != nil {
+			log.Errorf("Failed to resume subscription for pubsub %s, topic %s: %s", pubsubName, topic, err)
+			go s.retrySubscription(pubsubName, ps, sub)
+			return fmt.Errorf("failed to create subscription for %s: %s", pubsubName, err)
+		}
+
+		s.appSubs[pubsubName] = append(s.appSubs[pubsubName], &namedSubscription{
+			name:         sub.Name,
+			Subscription: ss,
+		})
+		break
+	}
+
+	return nil
+}
+
+func (s *Subscriber) isPaused(pubsubName, topic string) bool {
+	_, ok := s.paused[pubsubName][topic]
+	return ok
+}
+
+func (s *Subscriber) hasAppSubscription(pubsubName, topic string) bool {
+	for _, sub := range s.compStore.ListSubscriptionsAppByPubSub(pubsubName) {
+		if sub.Topic == topic {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Subscriber) appSubscription(pubsubName, topic string) *namedSubscription {
+	for _, appsub := range s.appSubs[pubsubName] {
+		if appsub.Topic() == topic {
+			return appsub
+		}
+	}
+	return nil
+}
+
 func (s *Subscriber) InitProgramaticSubscriptions(ctx context.Context) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -487,6 +682,10 @@ func (s *Subscriber) reloadPubSubApp(name string, pubsub *rtpubsub.PubsubItem) e
 	subs := make([]*namedSubscription, 0, len(s.compStore.ListSubscriptionsAppByPubSub(name)))
 
 	for _, sub := range s.compStore.ListSubscriptionsAppByPubSub(name) {
+		if s.isPaused(name, sub.Topic) {
+			continue
+		}
+
 		ss, err := s.startSubscription(pubsub, sub, false)
 		if err != nil {
 			log.Errorf("Failed to reload subscription for pubsub %s, topic %s: %s", name, sub.Topic, err)
@@ -598,6 +797,8 @@ func (s *Subscriber) startSubscription(pubsub *rtpubsub.PubsubItem, comp *compst
 		AdapterStreamer: streamer,
 		ConnectionID:    comp.ConnectionID,
 		Postman:         postman,
+		CompStore:       s.compStore,
+		Scheduler:       s.scheduler,
 	})
 }
 
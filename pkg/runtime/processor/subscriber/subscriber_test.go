@@ -193,6 +193,135 @@ func TestSubscriptionLifecycle(t *testing.T) {
 	mockPubSub3.AssertNumberOfCalls(t, "Subscribe", 3)
 }
 
+func TestPauseResumeSubscription(t *testing.T) {
+	mockPubSub := new(daprt.InMemoryPubsub)
+	mockPubSub.On("Init", mock.Anything).Return(nil)
+	mockPubSub.On("Subscribe", mock.AnythingOfType("pubsub.SubscribeRequest"), mock.AnythingOfType("pubsub.Handler")).Return(nil)
+	mockPubSub.On("unsubscribed", mock.Anything).Return(nil)
+	require.NoError(t, mockPubSub.Init(t.Context(), contribpubsub.Metadata{}))
+
+	compStore := compstore.New()
+	compStore.AddPubSub(TestPubsubName, &rtpubsub.PubsubItem{Component: mockPubSub})
+	compStore.SetProgramaticSubscriptions(
+		rtpubsub.Subscription{
+			PubsubName: TestPubsubName,
+			Topic:      "topic1",
+			Rules:      []*rtpubsub.Rule{{Path: "/"}},
+		},
+		rtpubsub.Subscription{
+			PubsubName: TestPubsubName,
+			Topic:      "topic2",
+			Rules:      []*rtpubsub.Rule{{Path: "/"}},
+		},
+	)
+
+	subs := New(Options{
+		CompStore:  compStore,
+		IsHTTP:     true,
+		Resiliency: resiliency.New(logger.NewLogger("test")),
+		Namespace:  "ns1",
+		AppID:      TestRuntimeConfigID,
+		Channels:   new(channels.Channels).WithAppChannel(new(channelt.MockAppChannel)),
+	})
+	subs.hasInitProg = true
+	t.Cleanup(subs.StopAllSubscriptionsForever)
+
+	var gotTopics atomic.Pointer[[]string]
+	mockPubSub.SetOnSubscribedTopicsChanged(func(topics []string) {
+		gotTopics.Store(&topics)
+	})
+	assertSubscribedTopics := func(t *testing.T, topics ...string) {
+		t.Helper()
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.ElementsMatch(c, topics, *gotTopics.Load())
+		}, time.Second, 10*time.Millisecond)
+	}
+	subscribeCalls := func(topic string) int {
+		var n int
+		for _, call := range mockPubSub.Calls {
+			if call.Method == "Subscribe" && call.Arguments.Get(0).(contribpubsub.SubscribeRequest).Topic == topic {
+				n++
+			}
+		}
+		return n
+	}
+
+	states := func() map[string]SubscriptionState {
+		got := make(map[string]SubscriptionState)
+		for _, status := range subs.ListSubscriptionStatuses() {
+			assert.Equal(t, TestPubsubName, status.PubsubName)
+			assert.Equal(t, "PROGRAMMATIC", status.Type)
+			got[status.Topic] = status.State
+		}
+		return got
+	}
+
+	assert.Equal(t, map[string]SubscriptionState{
+		"topic1": SubscriptionStateInactive,
+		"topic2": SubscriptionStateInactive,
+	}, states())
+
+	require.NoError(t, subs.StartAppSubscriptions())
+	assertSubscribedTopics(t, "topic1", "topic2")
+	assert.Equal(t, map[string]SubscriptionState{
+		"topic1": SubscriptionStateActive,
+		"topic2": SubscriptionStateActive,
+	}, states())
+
+	t.Run("unknown subscriptions are not found", func(t *testing.T) {
+		require.Error(t, subs.PauseSubscription(TestPubsubName, "topic3"))
+		require.Error(t, subs.PauseSubscription("notfound", "topic1"))
+		require.Error(t, subs.ResumeSubscription(TestPubsubName, "topic3"))
+	})
+
+	t.Run("pause stops the subscription", func(t *testing.T) {
+		require.NoError(t, subs.PauseSubscription(TestPubsubName, "topic1"))
+		require.NoError(t, subs.PauseSubscription(TestPubsubName, "topic1"))
+		assertSubscribedTopics(t, "topic2")
+		assert.Equal(t, map[string]SubscriptionState{
+			"topic1": SubscriptionStatePaused,
+			"topic2": SubscriptionStateActive,
+		}, states())
+	})
+
+	t.Run("paused subscriptions are not started with the app subscriptions", func(t *testing.T) {
+		subs.StopAppSubscriptions()
+		assertSubscribedTopics(t)
+		require.NoError(t, subs.StartAppSubscriptions())
+		assertSubscribedTopics(t, "topic2")
+		assert.Equal(t, 1, subscribeCalls("topic1"))
+		assert.Equal(t, SubscriptionStatePaused, states()["topic1"])
+	})
+
+	t.Run("paused subscriptions are not started on pubsub reload", func(t *testing.T) {
+		require.NoError(t, subs.ReloadPubSub(TestPubsubName))
+		assert.Equal(t, 1, subscribeCalls("topic1"))
+		assert.Equal(t, 3, subscribeCalls("topic2"))
+		assert.Equal(t, SubscriptionStatePaused, states()["topic1"])
+	})
+
+	t.Run("resume starts the subscription", func(t *testing.T) {
+		require.NoError(t, subs.ResumeSubscription(TestPubsubName, "topic1"))
+		require.NoError(t, subs.ResumeSubscription(TestPubsubName, "topic1"))
+		assert.Equal(t, 2, subscribeCalls("topic1"))
+		assert.Equal(t, map[string]SubscriptionState{
+			"topic1": SubscriptionStateActive,
+			"topic2": SubscriptionStateActive,
+		}, states())
+	})
+
+	t.Run("resume does not start subscriptions of a stopped app", func(t *testing.T) {
+		require.NoError(t, subs.PauseSubscription(TestPubsubName, "topic2"))
+		subs.StopAppSubscriptions()
+		require.NoError(t, subs.ResumeSubscription(TestPubsubName, "topic2"))
+		assert.Equal(t, 3, subscribeCalls("topic2"))
+		assert.Equal(t, map[string]SubscriptionState{
+			"topic1": SubscriptionStateInactive,
+			"topic2": SubscriptionStateInactive,
+		}, states())
+	})
+}
+
 func Test_initProgrammaticSubscriptions(t *testing.T) {
 	t.Run("get topic routes but no pubsubs are registered", func(t *testing.T) {
 		compStore := compstore.New()
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/utils"
 )
@@ -34,36 +35,9 @@ func (p *Processor) AddPendingSubscription(ctx context.Context, subscriptions ..
 	}
 
 	for i := range scopedSubs {
-		comp := scopedSubs[i]
-		sub := rtpubsub.Subscription{
-			PubsubName:      comp.Spec.Pubsubname,
-			Topic:           comp.Spec.Topic,
-			DeadLetterTopic: comp.Spec.DeadLetterTopic,
-			Metadata:        comp.Spec.Metadata,
-			Scopes:          comp.Scopes,
-			BulkSubscribe: &rtpubsub.BulkSubscribe{
-				Enabled:            comp.Spec.BulkSubscribe.Enabled,
-				MaxMessagesCount:   comp.Spec.BulkSubscribe.MaxMessagesCount,
-				MaxAwaitDurationMs: comp.Spec.BulkSubscribe.MaxAwaitDurationMs,
-			},
-		}
-		for _, rule := range comp.Spec.Routes.Rules {
-			erule, err := rtpubsub.CreateRoutingRule(rule.Match, rule.Path)
-			if err != nil {
-				p.errorSubscriptions(ctx, err)
-				return false
-			}
-			sub.Rules = append(sub.Rules, erule)
-		}
-		if len(comp.Spec.Routes.Default) > 0 {
-			sub.Rules = append(sub.Rules, &rtpubsub.Rule{
-				Path: comp.Spec.Routes.Default,
-			})
-		}
-
-		p.compStore.AddDeclarativeSubscription(&comp, sub)
-		if err := p.subscriber.ReloadDeclaredAppSubscription(comp.Name, comp.Spec.Pubsubname); err != nil {
-			p.compStore.DeleteDeclarativeSubscription(comp.Name)
+		err := p.addSubscription(scopedSubs[i])
+		p.reportSubscription(scopedSubs[i], operatorv1.EventType_EVENT_INIT, err)
+		if err != nil {
 			p.errorSubscriptions(ctx, err)
 			return false
 		}
@@ -72,6 +46,64 @@ func (p *Processor) AddPendingSubscription(ctx context.Context, subscriptions ..
 	return true
 }
 
+// addSubscription adds a declarative subscription and reloads the subscriptions
+// of its pub/sub. Must be called with the lock held.
+func (p *Processor) addSubscription(comp subapi.Subscription) error {
+	sub := rtpubsub.Subscription{
+		PubsubName:      comp.Spec.Pubsubname,
+		Topic:           comp.Spec.Topic,
+		DeadLetterTopic: comp.Spec.DeadLetterTopic,
+		Metadata:        comp.Spec.Metadata,
+		Scopes:          comp.Scopes,
+		BulkSubscribe: &rtpubsub.BulkSubscribe{
+			Enabled:            comp.Spec.BulkSubscribe.Enabled,
+			MaxMessagesCount:   comp.Spec.BulkSubscribe.MaxMessagesCount,
+			MaxAwaitDurationMs: comp.Spec.BulkSubscribe.MaxAwaitDurationMs,
+		},
+	}
+	for _, rule := range comp.Spec.Routes.Rules {
+		erule, err := rtpubsub.CreateRoutingRule(rule.Match, rule.Path)
+		if err != nil {
+			return err
+		}
+		sub.Rules = append(sub.Rules, erule)
+	}
+	if len(comp.Spec.Routes.Default) > 0 {
+		sub.Rules = append(sub.Rules, &rtpubsub.Rule{
+			Path: comp.Spec.Routes.Default,
+		})
+	}
+	if o := comp.Spec.Ordering; o != nil {
+		ordering, err := rtpubsub.CreateOrdering(o.Key, o.Expression, o.MaxConcurrency)
+		if err != nil {
+			return err
+		}
+		sub.Ordering = ordering
+	}
+	for _, rt := range comp.Spec.RetryTopics {
+		retryTopic, err := rtpubsub.CreateRetryTopic(rt.Topic, rt.Delay)
+		if err != nil {
+			return err
+		}
+		sub.RetryTopics = append(sub.RetryTopics, retryTopic)
+	}
+	if d := comp.Spec.Deduplication; d != nil {
+		deduplication, err := rtpubsub.CreateDeduplication(d.StateStore, d.TTL)
+		if err != nil {
+			return err
+		}
+		sub.Deduplication = deduplication
+	}
+
+	p.compStore.AddDeclarativeSubscription(&comp, sub)
+	if err := p.subscriber.ReloadDeclaredAppSubscription(comp.Name, comp.Spec.Pubsubname); err != nil {
+		p.compStore.DeleteDeclarativeSubscription(comp.Name)
+		return err
+	}
+
+	return nil
+}
+
 func (p *Processor) scopeFilterSubscriptions(subs []subapi.Subscription) []subapi.Subscription {
 	scopedSubs := make([]subapi.Subscription, 0, len(subs))
 	for _, sub := range subs {
@@ -90,10 +122,15 @@ func (p *Processor) CloseSubscription(ctx context.Context, sub *subapi.Subscript
 		return nil
 	}
 	p.compStore.DeleteDeclarativeSubscription(sub.Name)
-	if err := p.subscriber.ReloadDeclaredAppSubscription(sub.Name, sub.Spec.Pubsubname); err != nil {
-		return err
-	}
-	return nil
+	err := p.subscriber.ReloadDeclaredAppSubscription(sub.Name, sub.Spec.Pubsubname)
+	p.reportSubscription(*sub, operatorv1.EventType_EVENT_CLOSE, err)
+	return err
+}
+
+// reportSubscription reports the result of adding, or closing, a declarative
+// subscription to the operator.
+func (p *Processor) reportSubscription(sub subapi.Subscription, eventType operatorv1.EventType, err error) {
+	p.reportStatus(operatorv1.ResourceType_RESOURCE_SUBSCRIPTION, eventType, sub.Name, sub.Generation, err)
 }
 
 func (p *Processor) processSubscriptions(ctx context.Context) error {
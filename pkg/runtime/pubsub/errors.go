@@ -40,3 +40,22 @@ type NotAllowedError struct {
 func (e NotAllowedError) Error() string {
 	return fmt.Sprintf(messages.ErrPubsubForbidden, e.Topic, e.ID)
 }
+
+// pubsub.SchemaValidationError is returned by the runtime when the payload of
+// an event does not match the schema of its topic.
+type SchemaValidationError struct {
+	Topic   string
+	EntryID string
+	Err     error
+}
+
+func (e SchemaValidationError) Error() string {
+	if len(e.EntryID) > 0 {
+		return fmt.Sprintf("entry %s does not match the schema of topic %s: %s", e.EntryID, e.Topic, e.Err)
+	}
+	return fmt.Sprintf("event does not match the schema of topic %s: %s", e.Topic, e.Err)
+}
+
+func (e SchemaValidationError) Unwrap() error {
+	return e.Err
+}
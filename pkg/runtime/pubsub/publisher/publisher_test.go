@@ -26,6 +26,7 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	"github.com/dapr/kit/logger"
 )
@@ -375,6 +376,81 @@ func TestPublish(t *testing.T) {
 	})
 }
 
+func TestPublishSchemaValidation(t *testing.T) {
+	schemas, err := schema.FromMetadata(map[string]string{
+		"jsonSchema.orders": `{"type":"object","required":["orderId"]}`,
+	})
+	require.NoError(t, err)
+
+	compStore := compstore.New()
+	compStore.AddPubSub(TestPubsubName, &rtpubsub.PubsubItem{
+		Component: &mockPublishPubSub{},
+		Schemas:   schemas,
+	})
+	adapter := New(Options{
+		GetPubSubFn: compStore.GetPubSub,
+		Resiliency:  resiliency.New(logger.NewLogger("test")),
+	})
+	ps := WithSchemaValidation(adapter, compStore.GetPubSub)
+
+	t.Run("publish valid event", func(t *testing.T) {
+		require.NoError(t, ps.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "orders",
+			Data:       []byte(`{"id":"1","data":{"orderId":"1"}}`),
+		}))
+	})
+
+	t.Run("publish invalid event", func(t *testing.T) {
+		err := ps.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "orders",
+			Data:       []byte(`{"id":"1","data":{}}`),
+		})
+		require.ErrorAs(t, err, new(rtpubsub.SchemaValidationError))
+	})
+
+	t.Run("publish invalid raw payload", func(t *testing.T) {
+		err := ps.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "orders",
+			Data:       []byte(`{}`),
+			Metadata:   map[string]string{"rawPayload": "true"},
+		})
+		require.ErrorAs(t, err, new(rtpubsub.SchemaValidationError))
+	})
+
+	t.Run("publish to topic without schema", func(t *testing.T) {
+		require.NoError(t, ps.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "other",
+			Data:       []byte(`{"id":"1","data":{}}`),
+		}))
+	})
+
+	t.Run("bulk publish with invalid entry", func(t *testing.T) {
+		_, err := ps.BulkPublish(t.Context(), &contribpubsub.BulkPublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "orders",
+			Entries: []contribpubsub.BulkMessageEntry{
+				{EntryId: "1", Event: []byte(`{"id":"1","data":{"orderId":"1"}}`)},
+				{EntryId: "2", Event: []byte(`{"id":"2","data":{}}`)},
+			},
+		})
+		var schemaErr rtpubsub.SchemaValidationError
+		require.ErrorAs(t, err, &schemaErr)
+		assert.Equal(t, "2", schemaErr.EntryID)
+	})
+
+	t.Run("republished event is not validated", func(t *testing.T) {
+		require.NoError(t, adapter.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "orders",
+			Data:       []byte(`{"id":"1","data":{}}`),
+		}))
+	})
+}
+
 func TestNamespacedPublisher(t *testing.T) {
 	compStore := compstore.New()
 	compStore.AddPubSub(TestPubsubName, &rtpubsub.PubsubItem{
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/kit/ptr"
+)
+
+const (
+	// MetadataKeyDeliverAt is the publish metadata key holding an RFC3339
+	// timestamp at which the message should be delivered to the pub/sub.
+	MetadataKeyDeliverAt = "deliverAt"
+
+	// MetadataKeyDelay is the publish metadata key holding a duration (e.g.
+	// "30m") after which the message should be delivered to the pub/sub.
+	MetadataKeyDelay = "delay"
+
+	// scheduledJobPrefix is the prefix of Scheduler job names which hold
+	// delayed publish requests. The jobs API rejects app job names with this
+	// prefix so that they cannot collide with delayed publish requests.
+	scheduledJobPrefix = "__dapr.pubsub.scheduled__"
+)
+
+// DeliverAt returns the time at which a publish request should be delivered,
+// based on the `deliverAt` or `delay` metadata keys. Returns false if neither
+// key is set, in which case the message should be published immediately.
+func DeliverAt(metadata map[string]string, now time.Time) (time.Time, bool, error) {
+	deliverAt, okAt := metadata[MetadataKeyDeliverAt]
+	delay, okDelay := metadata[MetadataKeyDelay]
+
+	switch {
+	case okAt && okDelay:
+		return time.Time{}, false, fmt.Errorf("only one of %q or %q metadata may be set", MetadataKeyDeliverAt, MetadataKeyDelay)
+	case okAt:
+		t, err := time.Parse(time.RFC3339, deliverAt)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid %q metadata, must be RFC3339: %w", MetadataKeyDeliverAt, err)
+		}
+		return t, true, nil
+	case okDelay:
+		d, err := time.ParseDuration(delay)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid %q metadata: %w", MetadataKeyDelay, err)
+		}
+		if d < 0 {
+			return time.Time{}, false, fmt.Errorf("invalid %q metadata, must not be negative: %s", MetadataKeyDelay, delay)
+		}
+		return now.Add(d), true, nil
+	default:
+		return time.Time{}, false, nil
+	}
+}
+
+// ScheduledJobName returns the Scheduler job name used to hold a delayed
+// publish request with the given ID on the given pub/sub.
+func ScheduledJobName(pubsubName, id string) (string, error) {
+	if len(id) == 0 {
+		return "", errors.New("scheduled message ID must not be empty")
+	}
+	// The pub/sub name ends at the first "__" of the job name, so it must not
+	// contain one itself.
+	if strings.Contains(pubsubName, "__") || strings.Contains(pubsubName, "||") {
+		return "", errors.New("pub/sub name must not contain '__' or '||' to schedule messages")
+	}
+	if strings.Contains(id, "||") {
+		return "", errors.New("scheduled message ID must not contain '||'")
+	}
+	return scheduledJobPrefix + pubsubName + "__" + id, nil
+}
+
+// IsScheduledJob returns true if the given job name belongs to a delayed
+// publish request.
+func IsScheduledJob(name string) bool {
+	return strings.HasPrefix(name, scheduledJobPrefix)
+}
+
+// Schedule stores a publish request as a Scheduler job of the app, under the
+// given ID. When the job is triggered at deliverAt, the sidecar publishes the
+// request itself, so delayed delivery works for every pub/sub component.
+// If overwrite is true, a pending request with the same ID is replaced.
+func Schedule(ctx context.Context, client schedulerv1pb.SchedulerClient, appID, namespace, id string, req *contribpubsub.PublishRequest, deliverAt time.Time, overwrite bool) error {
+	name, err := ScheduledJobName(req.PubsubName, id)
+	if err != nil {
+		return err
+	}
+
+	data, err := EncodeScheduled(req)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ScheduleJob(ctx, &schedulerv1pb.ScheduleJobRequest{
+		Name:      name,
+		Metadata:  ScheduledJobMetadata(appID, namespace),
+		Overwrite: overwrite,
+		Job: &schedulerv1pb.Job{
+			DueTime: ptr.Of(deliverAt.UTC().Format(time.RFC3339)),
+			Data:    data,
+		},
+	}, grpc.WaitForReady(true))
+	return err
+}
+
+// ScheduledJobMetadata returns the metadata of the Scheduler jobs which hold
+// the delayed publish requests of an app.
+func ScheduledJobMetadata(appID, namespace string) *schedulerv1pb.JobMetadata {
+	return &schedulerv1pb.JobMetadata{
+		AppId:     appID,
+		Namespace: namespace,
+		Target: &schedulerv1pb.JobTargetMetadata{
+			Type: &schedulerv1pb.JobTargetMetadata_Job{
+				Job: new(schedulerv1pb.TargetJob),
+			},
+		},
+	}
+}
+
+// EncodeScheduled encodes a publish request so that it can be stored as the
+// data of a Scheduler job. Delivery metadata is stripped so that the message
+// is published immediately when the job is triggered.
+func EncodeScheduled(req *contribpubsub.PublishRequest) (*anypb.Any, error) {
+	metadata := make(map[string]string, len(req.Metadata))
+	for k, v := range req.Metadata {
+		if k == MetadataKeyDeliverAt || k == MetadataKeyDelay {
+			continue
+		}
+		metadata[k] = v
+	}
+
+	var contentType string
+	if req.ContentType != nil {
+		contentType = *req.ContentType
+	}
+
+	return anypb.New(&runtimev1pb.PublishEventRequest{
+		PubsubName:      req.PubsubName,
+		Topic:           req.Topic,
+		Data:            req.Data,
+		DataContentType: contentType,
+		Metadata:        metadata,
+	})
+}
+
+// DecodeScheduled decodes a publish request previously encoded with
+// EncodeScheduled.
+func DecodeScheduled(data *anypb.Any) (*contribpubsub.PublishRequest, error) {
+	var event runtimev1pb.PublishEventRequest
+	if err := data.UnmarshalTo(&event); err != nil {
+		return nil, fmt.Errorf("failed to decode scheduled publish request: %w", err)
+	}
+
+	req := &contribpubsub.PublishRequest{
+		PubsubName: event.GetPubsubName(),
+		Topic:      event.GetTopic(),
+		Data:       event.GetData(),
+		Metadata:   event.GetMetadata(),
+	}
+	if ct := event.GetDataContentType(); len(ct) > 0 {
+		req.ContentType = &ct
+	}
+
+	return req, nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publisher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestDeliverAt(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("no delivery metadata", func(t *testing.T) {
+		_, ok, err := DeliverAt(map[string]string{"foo": "bar"}, now)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("delay", func(t *testing.T) {
+		at, ok, err := DeliverAt(map[string]string{MetadataKeyDelay: "30m"}, now)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, now.Add(30*time.Minute), at)
+	})
+
+	t.Run("deliverAt", func(t *testing.T) {
+		at, ok, err := DeliverAt(map[string]string{MetadataKeyDeliverAt: "2025-01-02T15:04:05Z"}, now)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC), at)
+	})
+
+	t.Run("both set", func(t *testing.T) {
+		_, _, err := DeliverAt(map[string]string{
+			MetadataKeyDelay:     "30m",
+			MetadataKeyDeliverAt: "2025-01-02T15:04:05Z",
+		}, now)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		_, _, err := DeliverAt(map[string]string{MetadataKeyDelay: "soon"}, now)
+		require.Error(t, err)
+		_, _, err = DeliverAt(map[string]string{MetadataKeyDelay: "-1m"}, now)
+		require.Error(t, err)
+		_, _, err = DeliverAt(map[string]string{MetadataKeyDeliverAt: "tomorrow"}, now)
+		require.Error(t, err)
+	})
+}
+
+func TestScheduledJobName(t *testing.T) {
+	name, err := ScheduledJobName("mypubsub", "abc")
+	require.NoError(t, err)
+	assert.True(t, IsScheduledJob(name))
+	assert.False(t, IsScheduledJob("abc"))
+
+	_, err = ScheduledJobName("mypubsub", "")
+	require.Error(t, err)
+	_, err = ScheduledJobName("mypubsub", "a||b")
+	require.Error(t, err)
+
+	// "a__b" with ID "c" would collide with "a" with ID "b__c".
+	_, err = ScheduledJobName("a__b", "c")
+	require.ErrorContains(t, err, "pub/sub name")
+	name, err = ScheduledJobName("a", "b__c")
+	require.NoError(t, err)
+	assert.True(t, IsScheduledJob(name))
+}
+
+func TestEncodeDecodeScheduled(t *testing.T) {
+	req := &contribpubsub.PublishRequest{
+		PubsubName:  "mypubsub",
+		Topic:       "mytopic",
+		Data:        []byte("hello"),
+		ContentType: ptr.Of("text/plain"),
+		Metadata: map[string]string{
+			MetadataKeyDelay: "10s",
+			"rawPayload":     "true",
+		},
+	}
+
+	data, err := EncodeScheduled(req)
+	require.NoError(t, err)
+
+	got, err := DecodeScheduled(data)
+	require.NoError(t, err)
+	assert.Equal(t, "mypubsub", got.PubsubName)
+	assert.Equal(t, "mytopic", got.Topic)
+	assert.Equal(t, []byte("hello"), got.Data)
+	assert.Equal(t, ptr.Of("text/plain"), got.ContentType)
+	assert.Equal(t, map[string]string{"rawPayload": "true"}, got.Metadata)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publisher
+
+import (
+	"context"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+type validating struct {
+	adapter     rtpubsub.Adapter
+	getpubsubFn GetPubSubFn
+}
+
+// WithSchemaValidation returns an adapter which validates messages against the
+// schema of their topic before publishing them with the given adapter. It is
+// used for messages published by the app. Messages republished by the
+// sidecar, such as to dead letter or retry topics, are not validated again.
+func WithSchemaValidation(adapter rtpubsub.Adapter, getPubSubFn GetPubSubFn) rtpubsub.Adapter {
+	return &validating{
+		adapter:     adapter,
+		getpubsubFn: getPubSubFn,
+	}
+}
+
+func (v *validating) Publish(ctx context.Context, req *contribpubsub.PublishRequest) error {
+	if pubsub, ok := v.getpubsubFn(req.PubsubName); ok {
+		if err := rtpubsub.ValidatePublishSchema(pubsub, req); err != nil {
+			return err
+		}
+	}
+	return v.adapter.Publish(ctx, req)
+}
+
+func (v *validating) BulkPublish(ctx context.Context, req *contribpubsub.BulkPublishRequest) (contribpubsub.BulkPublishResponse, error) {
+	if pubsub, ok := v.getpubsubFn(req.PubsubName); ok {
+		if err := rtpubsub.ValidateBulkPublishSchema(pubsub, req); err != nil {
+			return contribpubsub.BulkPublishResponse{}, err
+		}
+	}
+	return v.adapter.BulkPublish(ctx, req)
+}
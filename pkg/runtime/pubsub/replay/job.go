@@ -0,0 +1,337 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"maps"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/expr"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+// Status is the state of a replay job.
+type Status string
+
+const (
+	StatusRunning   Status = "RUNNING"
+	StatusCompleted Status = "COMPLETED"
+	StatusCancelled Status = "CANCELLED"
+	StatusFailed    Status = "FAILED"
+)
+
+// contentTypeMetadataKey is the metadata key the subscription records the
+// content type of consumed events under.
+const contentTypeMetadataKey = "content-type"
+
+var (
+	errCancelled  = errors.New("replay job cancelled")
+	errCompleted  = errors.New("replay job completed")
+	errNotRunning = errors.New("replay job is not running")
+)
+
+// Progress reports the state of a replay job.
+type Progress struct {
+	ID              string     `json:"id"`
+	PubsubName      string     `json:"pubsubName"`
+	DeadLetterTopic string     `json:"deadLetterTopic"`
+	Filter          string     `json:"filter,omitempty"`
+	Status          Status     `json:"status"`
+	Received        int64      `json:"received"`
+	Replayed        int64      `json:"replayed"`
+	Skipped         int64      `json:"skipped"`
+	Failed          int64      `json:"failed"`
+	StartTime       time.Time  `json:"startTime"`
+	EndTime         *time.Time `json:"endTime,omitempty"`
+	Error           string     `json:"error,omitempty"`
+}
+
+type job struct {
+	req       Request
+	filter    *expr.Expr
+	limiter   *rate.Limiter
+	adapter   rtpubsub.Adapter
+	pubsub    *rtpubsub.PubsubItem
+	namespace string
+
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+
+	lock     sync.Mutex
+	progress Progress
+	stopped  bool
+	pending  int64
+	reserved int64
+	skipped  map[string]struct{}
+	inflight sync.WaitGroup
+	activity chan struct{}
+}
+
+// subscribe subscribes to the dead-letter topic of the job.
+func (j *job) subscribe() error {
+	topic := j.req.DeadLetterTopic
+	if j.pubsub.NamespaceScoped {
+		topic = j.namespace + topic
+	}
+
+	return j.pubsub.Component.Subscribe(j.ctx, contribpubsub.SubscribeRequest{
+		Topic: topic,
+	}, j.handle)
+}
+
+// run blocks until the job is completed, cancelled, or has failed.
+func (j *job) run() {
+	j.waitIdle()
+
+	// Wait for in-flight events to be settled before reporting the result.
+	j.lock.Lock()
+	j.stopped = true
+	j.lock.Unlock()
+	j.inflight.Wait()
+
+	j.finish(context.Cause(j.ctx))
+}
+
+// waitIdle completes the job once no event has been received, and no event
+// is in-flight, for the idle timeout.
+func (j *job) waitIdle() {
+	timer := time.NewTimer(j.req.IdleTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-j.ctx.Done():
+			return
+		case <-j.activity:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(j.req.IdleTimeout)
+		case <-timer.C:
+			j.lock.Lock()
+			idle := j.pending == 0
+			j.lock.Unlock()
+			if idle {
+				log.Infof("Replay job %s received no events for %s, completing", j.progress.ID, j.req.IdleTimeout)
+				j.cancel(errCompleted)
+				return
+			}
+			timer.Reset(j.req.IdleTimeout)
+		}
+	}
+}
+
+func (j *job) touch() {
+	select {
+	case j.activity <- struct{}{}:
+	default:
+	}
+}
+
+// handle is the subscription handler of the dead-letter topic. Returning an
+// error leaves the event on the dead-letter topic.
+func (j *job) handle(_ context.Context, msg *contribpubsub.NewMessage) error {
+	j.lock.Lock()
+	if j.stopped || j.ctx.Err() != nil {
+		j.lock.Unlock()
+		return errNotRunning
+	}
+	j.inflight.Add(1)
+	j.pending++
+	j.progress.Received++
+	j.lock.Unlock()
+	defer func() {
+		j.lock.Lock()
+		j.pending--
+		j.lock.Unlock()
+		j.inflight.Done()
+		j.touch()
+	}()
+
+	var cloudEvent map[string]any
+	_ = json.Unmarshal(msg.Data, &cloudEvent)
+	id, _ := cloudEvent[contribpubsub.IDField].(string)
+
+	originalTopic := msg.Metadata[rtpubsub.MetadataKeyDeadLetterOriginalTopic]
+	if len(originalTopic) == 0 {
+		originalTopic, _ = cloudEvent[contribpubsub.TopicField].(string)
+	}
+
+	if len(originalTopic) == 0 || !j.matches(cloudEvent) {
+		return j.skip(msg, skipKey(id, msg.Data))
+	}
+
+	j.lock.Lock()
+	if j.req.MaxEvents > 0 && j.reserved >= j.req.MaxEvents {
+		j.lock.Unlock()
+		return errNotRunning
+	}
+	j.reserved++
+	j.lock.Unlock()
+
+	err := j.limiter.Wait(j.ctx)
+	if err == nil {
+		err = j.adapter.Publish(j.ctx, &contribpubsub.PublishRequest{
+			PubsubName:  j.req.PubsubName,
+			Topic:       originalTopic,
+			Data:        msg.Data,
+			ContentType: contentType(msg),
+			Metadata:    republishMetadata(msg.Metadata, true),
+		})
+	}
+
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if err != nil {
+		j.reserved--
+		if j.ctx.Err() == nil {
+			j.progress.Failed++
+			log.Warnf("Replay job %s failed to replay event %s to topic %s: %s", j.progress.ID, id, originalTopic, err)
+		}
+		return err
+	}
+
+	j.progress.Replayed++
+	if j.req.MaxEvents > 0 && j.progress.Replayed >= j.req.MaxEvents {
+		j.cancel(errCompleted)
+	}
+	return nil
+}
+
+// matches returns true if the event matches the filter of the job. Events
+// which are not CloudEvents only match if the job has no filter.
+func (j *job) matches(cloudEvent map[string]any) bool {
+	if j.filter == nil {
+		return true
+	}
+	if cloudEvent == nil {
+		return false
+	}
+
+	res, err := j.filter.Eval(map[string]any{"event": cloudEvent})
+	if err != nil {
+		log.Debugf("Replay job %s failed to evaluate filter for event %v: %s", j.progress.ID, cloudEvent[contribpubsub.IDField], err)
+		return false
+	}
+	match, _ := res.(bool)
+	return match
+}
+
+// skipKey returns the key an event which is not replayed is tracked by: its
+// CloudEvent ID or, for events without one such as raw payloads, the hash of
+// its payload.
+func skipKey(id string, data []byte) string {
+	if len(id) > 0 {
+		return "id/" + id
+	}
+	sum := sha256.Sum256(data)
+	return "sha256/" + hex.EncodeToString(sum[:])
+}
+
+// skip puts an event which is not replayed back on the dead-letter topic.
+// Once an event which has already been skipped is received again, every
+// event of the dead-letter topic has been seen and the job completes. Events
+// without an ID and with identical payloads can complete the job early, which
+// leaves the remaining events on the dead-letter topic.
+func (j *job) skip(msg *contribpubsub.NewMessage, key string) error {
+	j.lock.Lock()
+	_, seen := j.skipped[key]
+	j.skipped[key] = struct{}{}
+	j.lock.Unlock()
+	if seen {
+		log.Infof("Replay job %s scanned all events of dead letter topic %s, completing", j.progress.ID, j.req.DeadLetterTopic)
+		j.cancel(errCompleted)
+		return errNotRunning
+	}
+
+	err := j.adapter.Publish(j.ctx, &contribpubsub.PublishRequest{
+		PubsubName:  j.req.PubsubName,
+		Topic:       j.req.DeadLetterTopic,
+		Data:        msg.Data,
+		ContentType: contentType(msg),
+		Metadata:    republishMetadata(msg.Metadata, false),
+	})
+
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if err != nil {
+		delete(j.skipped, key)
+		j.progress.Failed++
+		return err
+	}
+	j.progress.Skipped++
+	return nil
+}
+
+func (j *job) finish(cause error) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	now := time.Now().UTC()
+	j.progress.EndTime = &now
+	switch {
+	case errors.Is(cause, errCompleted):
+		j.progress.Status = StatusCompleted
+	case errors.Is(cause, errCancelled):
+		j.progress.Status = StatusCancelled
+	default:
+		j.progress.Status = StatusFailed
+		if cause != nil {
+			j.progress.Error = cause.Error()
+		}
+	}
+
+	log.Infof("Replay job %s of dead letter topic %s finished with status %s: replayed %d, skipped %d, failed %d",
+		j.progress.ID, j.req.DeadLetterTopic, j.progress.Status, j.progress.Replayed, j.progress.Skipped, j.progress.Failed)
+}
+
+func (j *job) snapshot() *Progress {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	p := j.progress
+	return &p
+}
+
+func contentType(msg *contribpubsub.NewMessage) *string {
+	if msg.ContentType != nil {
+		return msg.ContentType
+	}
+	if ct, ok := msg.Metadata[contentTypeMetadataKey]; ok {
+		return &ct
+	}
+	return nil
+}
+
+// republishMetadata returns the metadata to publish a consumed event with,
+// dropping the keys added by the runtime when it was consumed.
+func republishMetadata(md map[string]string, replay bool) map[string]string {
+	md = maps.Clone(md)
+	delete(md, contentTypeMetadataKey)
+	delete(md, rtpubsub.MetadataKeyPubSub)
+	if replay {
+		delete(md, rtpubsub.MetadataKeyDeadLetterOriginalTopic)
+	}
+	return md
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replay implements jobs which drain the events of a dead-letter
+// topic back to the topics they were originally published to.
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+
+	"github.com/dapr/dapr/pkg/expr"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/kit/logger"
+)
+
+// DefaultIdleTimeout is the duration after which a replay job completes if no
+// event is received from the dead-letter topic.
+const DefaultIdleTimeout = 30 * time.Second
+
+const (
+	// finishedJobTTL is how long the progress of a finished replay job is kept.
+	finishedJobTTL = time.Hour
+	// maxFinishedJobs is the number of finished replay jobs whose progress is
+	// kept, the oldest being evicted first.
+	maxFinishedJobs = 100
+)
+
+// ErrNotFound is returned when a replay job does not exist.
+var ErrNotFound = errors.New("replay job not found")
+
+var log = logger.NewLogger("dapr.runtime.pubsub.replay")
+
+type GetPubSubFn func(name string) (*rtpubsub.PubsubItem, bool)
+
+type Options struct {
+	AppID       string
+	Namespace   string
+	Adapter     rtpubsub.Adapter
+	GetPubSubFn GetPubSubFn
+}
+
+// Request describes a replay job to start.
+type Request struct {
+	// PubsubName is the pub/sub component of the dead-letter topic.
+	PubsubName string `json:"pubsubName"`
+	// DeadLetterTopic is the topic the events are replayed from.
+	DeadLetterTopic string `json:"deadLetterTopic"`
+	// Filter is an optional CEL expression over the CloudEvent, as `event`.
+	// Events which do not match are put back on the dead-letter topic.
+	Filter string `json:"filter,omitempty"`
+	// RatePerSecond limits the number of events replayed per second. Zero
+	// means no limit.
+	RatePerSecond float64 `json:"ratePerSecond,omitempty"`
+	// MaxEvents is the number of events after which the job completes. Zero
+	// means no limit.
+	MaxEvents int64 `json:"maxEvents,omitempty"`
+	// IdleTimeout is the duration after which the job completes if no event
+	// is received. Defaults to DefaultIdleTimeout.
+	IdleTimeout time.Duration `json:"-"`
+}
+
+// Manager runs the replay jobs of the sidecar. Jobs, and their progress, are
+// kept in memory and do not survive a restart of the sidecar. Finished jobs
+// are evicted after finishedJobTTL, or once more than maxFinishedJobs have
+// finished.
+type Manager struct {
+	appID       string
+	namespace   string
+	adapter     rtpubsub.Adapter
+	getPubSubFn GetPubSubFn
+	finishedTTL time.Duration
+	maxFinished int
+
+	lock   sync.RWMutex
+	jobs   map[string]*job
+	wg     sync.WaitGroup
+	closed bool
+}
+
+func New(opts Options) *Manager {
+	return &Manager{
+		appID:       opts.AppID,
+		namespace:   opts.Namespace,
+		adapter:     opts.Adapter,
+		getPubSubFn: opts.GetPubSubFn,
+		finishedTTL: finishedJobTTL,
+		maxFinished: maxFinishedJobs,
+		jobs:        make(map[string]*job),
+	}
+}
+
+// Start validates the request and starts a replay job in the background,
+// returning its initial progress.
+func (m *Manager) Start(req Request) (*Progress, error) {
+	if len(req.DeadLetterTopic) == 0 {
+		return nil, errors.New("dead letter topic must not be empty")
+	}
+	if req.RatePerSecond < 0 {
+		return nil, fmt.Errorf("ratePerSecond must not be negative, got %v", req.RatePerSecond)
+	}
+	if req.MaxEvents < 0 {
+		return nil, fmt.Errorf("maxEvents must not be negative, got %d", req.MaxEvents)
+	}
+	if req.IdleTimeout < 0 {
+		return nil, fmt.Errorf("idleTimeout must not be negative, got %s", req.IdleTimeout)
+	}
+	if req.IdleTimeout == 0 {
+		req.IdleTimeout = DefaultIdleTimeout
+	}
+
+	ps, ok := m.getPubSubFn(req.PubsubName)
+	if !ok {
+		return nil, rtpubsub.NotFoundError{PubsubName: req.PubsubName}
+	}
+	if !rtpubsub.IsOperationAllowed(req.DeadLetterTopic, ps, ps.ScopedSubscriptions) {
+		return nil, rtpubsub.NotAllowedError{Topic: req.DeadLetterTopic, ID: m.appID}
+	}
+
+	var filter *expr.Expr
+	if f := strings.TrimSpace(req.Filter); len(f) > 0 {
+		filter = &expr.Expr{}
+		if err := filter.DecodeString(f); err != nil {
+			return nil, fmt.Errorf("invalid filter expression: %w", err)
+		}
+	}
+
+	limit := rate.Inf
+	if req.RatePerSecond > 0 {
+		limit = rate.Limit(req.RatePerSecond)
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	j := &job{
+		req:       req,
+		filter:    filter,
+		limiter:   rate.NewLimiter(limit, 1),
+		adapter:   m.adapter,
+		pubsub:    ps,
+		namespace: m.namespace,
+		ctx:       ctx,
+		cancel:    cancel,
+		skipped:   make(map[string]struct{}),
+		activity:  make(chan struct{}, 1),
+		progress: Progress{
+			ID:              id.String(),
+			PubsubName:      req.PubsubName,
+			DeadLetterTopic: req.DeadLetterTopic,
+			Filter:          req.Filter,
+			Status:          StatusRunning,
+			StartTime:       time.Now().UTC(),
+		},
+	}
+
+	if err = j.subscribe(); err != nil {
+		cancel(err)
+		return nil, fmt.Errorf("failed to subscribe to dead letter topic %s: %w", req.DeadLetterTopic, err)
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.closed {
+		cancel(errCancelled)
+		return nil, errors.New("replay manager is closed")
+	}
+
+	m.evict(time.Now())
+	m.jobs[j.progress.ID] = j
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		j.run()
+		m.lock.Lock()
+		m.evict(time.Now())
+		m.lock.Unlock()
+	}()
+
+	return j.snapshot(), nil
+}
+
+// evict removes the jobs which finished more than finishedTTL ago, and the
+// oldest finished jobs beyond maxFinished. Must be called with the lock held.
+func (m *Manager) evict(now time.Time) {
+	finished := make([]*Progress, 0, len(m.jobs))
+	for id, j := range m.jobs {
+		progress := j.snapshot()
+		switch {
+		case progress.EndTime == nil:
+		case now.Sub(*progress.EndTime) >= m.finishedTTL:
+			delete(m.jobs, id)
+		default:
+			finished = append(finished, progress)
+		}
+	}
+
+	if len(finished) <= m.maxFinished {
+		return
+	}
+	slices.SortFunc(finished, func(a, b *Progress) int {
+		return a.EndTime.Compare(*b.EndTime)
+	})
+	for _, progress := range finished[:len(finished)-m.maxFinished] {
+		delete(m.jobs, progress.ID)
+	}
+}
+
+// Get returns the progress of the replay job with the given ID.
+func (m *Manager) Get(pubsubName, id string) (*Progress, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	j, ok := m.jobs[id]
+	if !ok || j.req.PubsubName != pubsubName {
+		return nil, ErrNotFound
+	}
+	return j.snapshot(), nil
+}
+
+// List returns the progress of all replay jobs of the given pub/sub
+// component, oldest first.
+func (m *Manager) List(pubsubName string) []*Progress {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	list := make([]*Progress, 0, len(m.jobs))
+	for _, j := range m.jobs {
+		if j.req.PubsubName == pubsubName {
+			list = append(list, j.snapshot())
+		}
+	}
+	slices.SortFunc(list, func(a, b *Progress) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+	return list
+}
+
+// Stop cancels the replay job with the given ID. Events which have not yet
+// been replayed are left on the dead-letter topic.
+func (m *Manager) Stop(pubsubName, id string) error {
+	m.lock.RLock()
+	j, ok := m.jobs[id]
+	m.lock.RUnlock()
+	if !ok || j.req.PubsubName != pubsubName {
+		return ErrNotFound
+	}
+	j.cancel(errCancelled)
+	return nil
+}
+
+// Close cancels all running replay jobs and waits for them to stop.
+func (m *Manager) Close() error {
+	m.lock.Lock()
+	m.closed = true
+	for _, j := range m.jobs {
+		j.cancel(errCancelled)
+	}
+	m.lock.Unlock()
+	m.wg.Wait()
+	return nil
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	inmemory "github.com/dapr/components-contrib/pubsub/in-memory"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher/fake"
+	"github.com/dapr/kit/logger"
+)
+
+type testBus struct {
+	comp contribpubsub.PubSub
+
+	lock      sync.Mutex
+	published []*contribpubsub.PublishRequest
+}
+
+// newTestManager returns a replay manager backed by an in-memory pub/sub
+// component. Events published to the dead letter topic are sent to the
+// component, while events replayed to other topics are recorded.
+func newTestManager(t *testing.T) (*Manager, *testBus) {
+	t.Helper()
+
+	comp := inmemory.New(logger.NewLogger("test"))
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+	t.Cleanup(func() { comp.Close() })
+
+	bus := &testBus{comp: comp}
+	adapter := fake.New().WithPublishFn(func(ctx context.Context, req *contribpubsub.PublishRequest) error {
+		if req.Topic == "orders-dlq" {
+			// The in-memory component does not allow publishing from within a
+			// subscription handler of the same topic.
+			go comp.Publish(context.Background(), req)
+			return nil
+		}
+		bus.lock.Lock()
+		defer bus.lock.Unlock()
+		bus.published = append(bus.published, req)
+		return nil
+	})
+
+	m := New(Options{
+		AppID:   "myapp",
+		Adapter: adapter,
+		GetPubSubFn: func(name string) (*rtpubsub.PubsubItem, bool) {
+			if name != "mypubsub" {
+				return nil, false
+			}
+			return &rtpubsub.PubsubItem{Component: comp}, true
+		},
+	})
+	t.Cleanup(func() { require.NoError(t, m.Close()) })
+
+	return m, bus
+}
+
+func (b *testBus) deadLetter(t *testing.T, id, kind string) {
+	t.Helper()
+	data, err := json.Marshal(map[string]any{
+		contribpubsub.IDField:    id,
+		contribpubsub.TopicField: "orders",
+		contribpubsub.DataField:  map[string]any{"kind": kind},
+	})
+	require.NoError(t, err)
+	require.NoError(t, b.comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+		PubsubName: "mypubsub",
+		Topic:      "orders-dlq",
+		Data:       data,
+	}))
+}
+
+func (b *testBus) replayedIDs(t *testing.T) []string {
+	t.Helper()
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	ids := make([]string, 0, len(b.published))
+	for _, req := range b.published {
+		assert.Equal(t, "orders", req.Topic)
+		var event map[string]any
+		require.NoError(t, json.Unmarshal(req.Data, &event))
+		ids = append(ids, event[contribpubsub.IDField].(string))
+	}
+	return ids
+}
+
+func waitForStatus(t *testing.T, m *Manager, id string, status Status) *Progress {
+	t.Helper()
+	var progress *Progress
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		var err error
+		progress, err = m.Get("mypubsub", id)
+		require.NoError(c, err)
+		assert.Equal(c, status, progress.Status)
+	}, time.Second*10, time.Millisecond*10)
+	return progress
+}
+
+func TestStart(t *testing.T) {
+	t.Run("invalid requests", func(t *testing.T) {
+		m, _ := newTestManager(t)
+
+		_, err := m.Start(Request{PubsubName: "mypubsub"})
+		require.Error(t, err)
+
+		_, err = m.Start(Request{PubsubName: "notfound", DeadLetterTopic: "orders-dlq"})
+		require.ErrorAs(t, err, new(rtpubsub.NotFoundError))
+
+		_, err = m.Start(Request{PubsubName: "mypubsub", DeadLetterTopic: "orders-dlq", Filter: "event.("})
+		require.Error(t, err)
+
+		_, err = m.Start(Request{PubsubName: "mypubsub", DeadLetterTopic: "orders-dlq", RatePerSecond: -1})
+		require.Error(t, err)
+
+		assert.Empty(t, m.List("mypubsub"))
+	})
+
+	t.Run("replays events to original topic", func(t *testing.T) {
+		m, bus := newTestManager(t)
+
+		progress, err := m.Start(Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "orders-dlq",
+			IdleTimeout:     time.Millisecond * 300,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, StatusRunning, progress.Status)
+
+		bus.deadLetter(t, "1", "a")
+		bus.deadLetter(t, "2", "b")
+
+		progress = waitForStatus(t, m, progress.ID, StatusCompleted)
+		assert.Equal(t, int64(2), progress.Received)
+		assert.Equal(t, int64(2), progress.Replayed)
+		assert.NotNil(t, progress.EndTime)
+		assert.ElementsMatch(t, []string{"1", "2"}, bus.replayedIDs(t))
+	})
+
+	t.Run("filter puts unmatched events back", func(t *testing.T) {
+		m, bus := newTestManager(t)
+
+		progress, err := m.Start(Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "orders-dlq",
+			Filter:          `event.data.kind == "a"`,
+			IdleTimeout:     time.Second * 5,
+		})
+		require.NoError(t, err)
+
+		bus.deadLetter(t, "1", "a")
+		bus.deadLetter(t, "2", "b")
+		bus.deadLetter(t, "3", "a")
+
+		// Event 2 is put back on the dead letter topic, and completes the job
+		// once it is received again.
+		progress = waitForStatus(t, m, progress.ID, StatusCompleted)
+		assert.Equal(t, int64(2), progress.Replayed)
+		assert.Equal(t, int64(1), progress.Skipped)
+		assert.ElementsMatch(t, []string{"1", "3"}, bus.replayedIDs(t))
+	})
+
+	t.Run("filter completes with events without an ID", func(t *testing.T) {
+		m, bus := newTestManager(t)
+
+		progress, err := m.Start(Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "orders-dlq",
+			Filter:          `event.data.kind == "a"`,
+			IdleTimeout:     time.Hour,
+		})
+		require.NoError(t, err)
+
+		// A raw payload never matches the filter, and is tracked by its hash
+		// once put back on the dead letter topic.
+		require.NoError(t, bus.comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: "mypubsub",
+			Topic:      "orders-dlq",
+			Data:       []byte("raw payload"),
+		}))
+
+		progress = waitForStatus(t, m, progress.ID, StatusCompleted)
+		assert.Equal(t, int64(1), progress.Skipped)
+		assert.Empty(t, bus.replayedIDs(t))
+	})
+
+	t.Run("max events", func(t *testing.T) {
+		m, bus := newTestManager(t)
+
+		progress, err := m.Start(Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "orders-dlq",
+			MaxEvents:       1,
+			IdleTimeout:     time.Second * 5,
+		})
+		require.NoError(t, err)
+
+		bus.deadLetter(t, "1", "a")
+
+		progress = waitForStatus(t, m, progress.ID, StatusCompleted)
+		assert.Equal(t, int64(1), progress.Replayed)
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		m, bus := newTestManager(t)
+
+		start := time.Now()
+		progress, err := m.Start(Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "orders-dlq",
+			RatePerSecond:   10,
+			MaxEvents:       3,
+			IdleTimeout:     time.Second * 5,
+		})
+		require.NoError(t, err)
+
+		bus.deadLetter(t, "1", "a")
+		bus.deadLetter(t, "2", "a")
+		bus.deadLetter(t, "3", "a")
+
+		progress = waitForStatus(t, m, progress.ID, StatusCompleted)
+		assert.Equal(t, int64(3), progress.Replayed)
+		assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*150)
+	})
+}
+
+func TestStop(t *testing.T) {
+	m, _ := newTestManager(t)
+
+	progress, err := m.Start(Request{
+		PubsubName:      "mypubsub",
+		DeadLetterTopic: "orders-dlq",
+		IdleTimeout:     time.Hour,
+	})
+	require.NoError(t, err)
+
+	require.ErrorIs(t, m.Stop("otherpubsub", progress.ID), ErrNotFound)
+	require.ErrorIs(t, m.Stop("mypubsub", "notfound"), ErrNotFound)
+	require.NoError(t, m.Stop("mypubsub", progress.ID))
+
+	waitForStatus(t, m, progress.ID, StatusCancelled)
+
+	list := m.List("mypubsub")
+	require.Len(t, list, 1)
+	assert.Equal(t, progress.ID, list[0].ID)
+	assert.Empty(t, m.List("otherpubsub"))
+
+	_, err = m.Get("mypubsub", "notfound")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestEvict(t *testing.T) {
+	t.Run("oldest finished jobs beyond the cap are evicted", func(t *testing.T) {
+		m, _ := newTestManager(t)
+		m.maxFinished = 1
+
+		ids := make([]string, 2)
+		for i := range ids {
+			progress, err := m.Start(Request{
+				PubsubName:      "mypubsub",
+				DeadLetterTopic: "orders-dlq",
+				IdleTimeout:     time.Millisecond * 50,
+			})
+			require.NoError(t, err)
+			ids[i] = progress.ID
+			waitForStatus(t, m, progress.ID, StatusCompleted)
+		}
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			_, err := m.Get("mypubsub", ids[0])
+			assert.ErrorIs(c, err, ErrNotFound)
+		}, time.Second*5, time.Millisecond*10)
+		_, err := m.Get("mypubsub", ids[1])
+		require.NoError(t, err)
+	})
+
+	t.Run("finished jobs are evicted after the TTL", func(t *testing.T) {
+		m, _ := newTestManager(t)
+		m.finishedTTL = 0
+
+		progress, err := m.Start(Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "orders-dlq",
+			IdleTimeout:     time.Millisecond * 50,
+		})
+		require.NoError(t, err)
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			_, err := m.Get("mypubsub", progress.ID)
+			assert.ErrorIs(c, err, ErrNotFound)
+		}, time.Second*5, time.Millisecond*10)
+		assert.Empty(t, m.List("mypubsub"))
+	})
+}
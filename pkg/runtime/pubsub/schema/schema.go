@@ -0,0 +1,248 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schema validates the payload of pub/sub events against the JSON
+// Schemas and protobuf message types configured for their topic.
+package schema
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+)
+
+const (
+	// JSONSchemaPrefix is the prefix of the component metadata keys holding
+	// the JSON Schema of a topic, as in `jsonSchema.orders`.
+	JSONSchemaPrefix = "jsonSchema."
+	// ProtoMessagePrefix is the prefix of the component metadata keys holding
+	// the fully qualified protobuf message type of a topic, as in
+	// `protoMessage.orders`.
+	ProtoMessagePrefix = "protoMessage."
+	// ProtoDescriptorSet is the component metadata key holding the base64
+	// encoded FileDescriptorSet the protobuf message types are resolved from,
+	// as produced by `protoc --include_imports --descriptor_set_out`.
+	ProtoDescriptorSet = "protoDescriptorSet"
+)
+
+// errExternalRef is returned when a JSON Schema references another document,
+// which is not loaded by the runtime.
+var errExternalRef = errors.New("references to external schemas are not supported")
+
+// Validator validates event payloads against the schemas configured for the
+// topics of a pub/sub component. A nil Validator accepts every payload.
+type Validator struct {
+	topics map[string]validateFn
+}
+
+type validateFn func(payload any) error
+
+// FromMetadata returns the Validator for the schemas configured in the given
+// component metadata, or nil if no schema is configured.
+func FromMetadata(md map[string]string) (*Validator, error) {
+	var (
+		jsonSchemas   = make(map[string]string)
+		protoMessages = make(map[string]string)
+		descriptorSet string
+	)
+	for k, v := range md {
+		switch {
+		case len(k) > len(JSONSchemaPrefix) && strings.EqualFold(k[:len(JSONSchemaPrefix)], JSONSchemaPrefix):
+			jsonSchemas[k[len(JSONSchemaPrefix):]] = v
+		case len(k) > len(ProtoMessagePrefix) && strings.EqualFold(k[:len(ProtoMessagePrefix)], ProtoMessagePrefix):
+			protoMessages[k[len(ProtoMessagePrefix):]] = strings.TrimSpace(v)
+		case strings.EqualFold(k, ProtoDescriptorSet):
+			descriptorSet = strings.TrimSpace(v)
+		}
+	}
+
+	if len(jsonSchemas) == 0 && len(protoMessages) == 0 {
+		return nil, nil
+	}
+
+	v := &Validator{topics: make(map[string]validateFn, len(jsonSchemas)+len(protoMessages))}
+	for topic, doc := range jsonSchemas {
+		if _, ok := protoMessages[topic]; ok {
+			return nil, fmt.Errorf("topic %s must not have both a JSON Schema and a protobuf message type", topic)
+		}
+		fn, err := compileJSONSchema(topic, doc)
+		if err != nil {
+			return nil, err
+		}
+		v.topics[topic] = fn
+	}
+
+	if len(protoMessages) > 0 {
+		files, err := parseDescriptorSet(descriptorSet)
+		if err != nil {
+			return nil, err
+		}
+		for topic, name := range protoMessages {
+			fn, err := protoMessage(files, topic, name)
+			if err != nil {
+				return nil, err
+			}
+			v.topics[topic] = fn
+		}
+	}
+
+	return v, nil
+}
+
+// HasSchema returns true if a schema is configured for the given topic.
+func (v *Validator) HasSchema(topic string) bool {
+	if v == nil {
+		return false
+	}
+	_, ok := v.topics[topic]
+	return ok
+}
+
+// ValidateCloudEvent validates the data of the given CloudEvent against the
+// schema of the topic. The data is read from the `data_base64` field if
+// present, or else from the `data` field.
+func (v *Validator) ValidateCloudEvent(topic string, cloudEvent map[string]any) error {
+	fn, ok := v.lookup(topic)
+	if !ok {
+		return nil
+	}
+
+	if b64, ok := cloudEvent[contribpubsub.DataBase64Field].(string); ok {
+		data, err := base64.StdEncoding.DecodeString(b64)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s: %w", contribpubsub.DataBase64Field, err)
+		}
+		return fn(data)
+	}
+
+	return fn(cloudEvent[contribpubsub.DataField])
+}
+
+// ValidatePayload validates the given raw payload, which is not wrapped in a
+// CloudEvent, against the schema of the topic.
+func (v *Validator) ValidatePayload(topic string, data []byte) error {
+	fn, ok := v.lookup(topic)
+	if !ok {
+		return nil
+	}
+	return fn(data)
+}
+
+func (v *Validator) lookup(topic string) (validateFn, bool) {
+	if v == nil {
+		return nil, false
+	}
+	fn, ok := v.topics[topic]
+	return fn, ok
+}
+
+func compileJSONSchema(topic, doc string) (validateFn, error) {
+	url := "dapr://schemas/" + topic + ".json"
+	c := jsonschema.NewCompiler()
+	c.LoadURL = func(string) (io.ReadCloser, error) {
+		return nil, errExternalRef
+	}
+	if err := c.AddResource(url, strings.NewReader(doc)); err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema for topic %s: %w", topic, err)
+	}
+	schema, err := c.Compile(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema for topic %s: %w", topic, err)
+	}
+
+	return func(payload any) error {
+		// Raw and binary payloads are decoded as JSON before validation.
+		if data, ok := payload.([]byte); ok {
+			dec := json.NewDecoder(bytes.NewReader(data))
+			dec.UseNumber()
+			if err := dec.Decode(&payload); err != nil {
+				return fmt.Errorf("payload is not valid JSON: %w", err)
+			}
+		}
+		return schema.Validate(payload)
+	}, nil
+}
+
+func parseDescriptorSet(encoded string) (*protoregistry.Files, error) {
+	if len(encoded) == 0 {
+		return nil, fmt.Errorf("metadata %s is required to validate protobuf message types", ProtoDescriptorSet)
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", ProtoDescriptorSet, err)
+	}
+
+	var fds descriptorpb.FileDescriptorSet
+	if err = proto.Unmarshal(raw, &fds); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", ProtoDescriptorSet, err)
+	}
+
+	files, err := protodesc.NewFiles(&fds)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ProtoDescriptorSet, err)
+	}
+
+	return files, nil
+}
+
+func protoMessage(files *protoregistry.Files, topic, name string) (validateFn, error) {
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf("protobuf message type %s of topic %s is not found in %s: %w", name, topic, ProtoDescriptorSet, err)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s of topic %s is not a protobuf message type", name, topic)
+	}
+
+	return func(payload any) error {
+		msg := dynamicpb.NewMessage(md)
+		switch p := payload.(type) {
+		case []byte:
+			if err := proto.Unmarshal(p, msg); err != nil {
+				return fmt.Errorf("payload is not a valid %s message: %w", name, err)
+			}
+		case string:
+			if err := proto.Unmarshal([]byte(p), msg); err != nil {
+				return fmt.Errorf("payload is not a valid %s message: %w", name, err)
+			}
+		default:
+			// JSON payloads are validated against the JSON mapping of the
+			// message type.
+			data, err := json.Marshal(p)
+			if err != nil {
+				return fmt.Errorf("payload is not a valid %s message: %w", name, err)
+			}
+			if err = protojson.Unmarshal(data, msg); err != nil {
+				return fmt.Errorf("payload is not a valid %s message: %w", name, err)
+			}
+		}
+		return nil
+	}, nil
+}
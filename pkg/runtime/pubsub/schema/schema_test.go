@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+)
+
+const orderSchema = `{
+	"type": "object",
+	"properties": {"orderId": {"type": "string"}},
+	"required": ["orderId"]
+}`
+
+// descriptorSet returns the base64 encoded FileDescriptorSet of the given
+// file and its imports.
+func descriptorSet(t *testing.T, fd protoreflect.FileDescriptor) string {
+	t.Helper()
+	var (
+		fds  descriptorpb.FileDescriptorSet
+		seen = make(map[string]bool)
+		add  func(protoreflect.FileDescriptor)
+	)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := range imports.Len() {
+			add(imports.Get(i).FileDescriptor)
+		}
+		fds.File = append(fds.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(fd)
+
+	b, err := proto.Marshal(&fds)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func TestFromMetadata(t *testing.T) {
+	fds := descriptorSet(t, commonv1pb.File_dapr_proto_common_v1_common_proto)
+
+	t.Run("no schemas", func(t *testing.T) {
+		v, err := FromMetadata(map[string]string{"consumerID": "myapp"})
+		require.NoError(t, err)
+		assert.Nil(t, v)
+		assert.False(t, v.HasSchema("orders"))
+		require.NoError(t, v.ValidatePayload("orders", []byte("foo")))
+	})
+
+	t.Run("schemas per topic", func(t *testing.T) {
+		v, err := FromMetadata(map[string]string{
+			"jsonSchema.orders":   orderSchema,
+			"ProtoMessage.etags":  "dapr.proto.common.v1.Etag",
+			"protoDescriptorSet":  fds,
+			"jsonSchema.":         "ignored",
+			"protoMessagePrefixX": "ignored",
+		})
+		require.NoError(t, err)
+		assert.True(t, v.HasSchema("orders"))
+		assert.True(t, v.HasSchema("etags"))
+		assert.False(t, v.HasSchema("other"))
+	})
+
+	tests := map[string]map[string]string{
+		"invalid JSON Schema": {
+			"jsonSchema.orders": `{"type": 1}`,
+		},
+		"external JSON Schema reference": {
+			"jsonSchema.orders": `{"$ref": "https://example.com/order.json"}`,
+		},
+		"JSON Schema and protobuf message type": {
+			"jsonSchema.orders":   orderSchema,
+			"protoMessage.orders": "dapr.proto.common.v1.Etag",
+			"protoDescriptorSet":  fds,
+		},
+		"missing descriptor set": {
+			"protoMessage.orders": "dapr.proto.common.v1.Etag",
+		},
+		"invalid descriptor set": {
+			"protoMessage.orders": "dapr.proto.common.v1.Etag",
+			"protoDescriptorSet":  "not base64",
+		},
+		"unknown message type": {
+			"protoMessage.orders": "dapr.proto.common.v1.Unknown",
+			"protoDescriptorSet":  fds,
+		},
+	}
+	for name, md := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := FromMetadata(md)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestValidateJSONSchema(t *testing.T) {
+	v, err := FromMetadata(map[string]string{"jsonSchema.orders": orderSchema})
+	require.NoError(t, err)
+
+	cloudEvent := func(data string) map[string]any {
+		var ce map[string]any
+		require.NoError(t, json.Unmarshal([]byte(`{"id":"1","data":`+data+`}`), &ce))
+		return ce
+	}
+
+	require.NoError(t, v.ValidateCloudEvent("orders", cloudEvent(`{"orderId":"1"}`)))
+	require.Error(t, v.ValidateCloudEvent("orders", cloudEvent(`{"orderId":1}`)))
+	require.Error(t, v.ValidateCloudEvent("orders", cloudEvent(`"foo"`)))
+	require.NoError(t, v.ValidateCloudEvent("other", cloudEvent(`"foo"`)))
+
+	require.NoError(t, v.ValidateCloudEvent("orders", map[string]any{
+		"data_base64": base64.StdEncoding.EncodeToString([]byte(`{"orderId":"1"}`)),
+	}))
+	require.Error(t, v.ValidateCloudEvent("orders", map[string]any{
+		"data_base64": base64.StdEncoding.EncodeToString([]byte(`{}`)),
+	}))
+
+	require.NoError(t, v.ValidatePayload("orders", []byte(`{"orderId":"1"}`)))
+	require.Error(t, v.ValidatePayload("orders", []byte(`{"orderId":"1"`)))
+	require.Error(t, v.ValidatePayload("orders", []byte(`{}`)))
+}
+
+func TestValidateProtoMessage(t *testing.T) {
+	v, err := FromMetadata(map[string]string{
+		"protoMessage.etags": "dapr.proto.common.v1.Etag",
+		"protoDescriptorSet": descriptorSet(t, commonv1pb.File_dapr_proto_common_v1_common_proto),
+	})
+	require.NoError(t, err)
+
+	valid, err := proto.Marshal(&commonv1pb.Etag{Value: "1"})
+	require.NoError(t, err)
+
+	require.NoError(t, v.ValidatePayload("etags", valid))
+	require.Error(t, v.ValidatePayload("etags", []byte{0xff, 0xff}))
+
+	require.NoError(t, v.ValidateCloudEvent("etags", map[string]any{
+		"data_base64": base64.StdEncoding.EncodeToString(valid),
+	}))
+	require.NoError(t, v.ValidateCloudEvent("etags", map[string]any{
+		"data": map[string]any{"value": "1"},
+	}))
+	require.Error(t, v.ValidateCloudEvent("etags", map[string]any{
+		"data": map[string]any{"unknown": "1"},
+	}))
+}
@@ -1,6 +1,9 @@
 package pubsub
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type Subscription struct {
 	PubsubName      string            `json:"pubsubname"`
@@ -10,6 +13,29 @@ type Subscription struct {
 	Rules           []*Rule           `json:"rules,omitempty"`
 	Scopes          []string          `json:"scopes"`
 	BulkSubscribe   *BulkSubscribe    `json:"bulkSubscribe"`
+	Ordering        *Ordering         `json:"ordering,omitempty"`
+	RetryTopics     []RetryTopic      `json:"retryTopics,omitempty"`
+	Deduplication   *Deduplication    `json:"deduplication,omitempty"`
+}
+
+// Deduplication configures the recording of processed event IDs, so that
+// redelivered events are not delivered to the app again.
+type Deduplication struct {
+	StateStore string        `json:"stateStore"`
+	TTL        time.Duration `json:"ttl"`
+}
+
+// RetryTopic is a non-blocking retry tier of a subscription.
+type RetryTopic struct {
+	Topic string        `json:"topic"`
+	Delay time.Duration `json:"delay"`
+}
+
+// Ordering configures per-key ordered delivery of messages to the app.
+type Ordering struct {
+	Key            string `json:"key,omitempty"`
+	Expression     Expr   `json:"expression,omitempty"`
+	MaxConcurrency int32  `json:"maxConcurrency,omitempty"`
 }
 
 type BulkSubscribe struct {
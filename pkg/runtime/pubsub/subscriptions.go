@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
@@ -42,6 +43,20 @@ const (
 	APIVersionV2alpha1 = "dapr.io/v2alpha1"
 
 	MetadataKeyPubSub = "pubsubName"
+
+	// MetadataKeyDeadLetterOriginalTopic records, on events sent to a dead
+	// letter topic, the topic the event was originally consumed from.
+	MetadataKeyDeadLetterOriginalTopic = "deadLetterOriginalTopic"
+
+	// Streaming subscriptions configure ordered delivery through these
+	// subscription metadata keys, which are not passed to the component.
+	MetadataKeyOrderingKey            = "orderingKey"
+	MetadataKeyOrderingExpression     = "orderingKeyExpression"
+	MetadataKeyOrderingMaxConcurrency = "orderingMaxConcurrency"
+
+	// DefaultDeduplicationTTL is the duration processed event IDs are
+	// remembered for if the subscription does not set one.
+	DefaultDeduplicationTTL = 24 * time.Hour
 )
 
 var (
@@ -319,6 +334,77 @@ func CreateRoutingRule(match, path string) (*Rule, error) {
 	}, nil
 }
 
+// CreateOrdering returns the ordered delivery configuration for a
+// subscription, compiling the optional CEL key expression. Returns nil if
+// neither a key nor an expression is given.
+func CreateOrdering(key, expression string, maxConcurrency int32) (*Ordering, error) {
+	key = strings.TrimSpace(key)
+	expression = strings.TrimSpace(expression)
+	if key == "" && expression == "" {
+		return nil, nil
+	}
+
+	if maxConcurrency < 0 {
+		return nil, fmt.Errorf("ordering maxConcurrency must not be negative, got %d", maxConcurrency)
+	}
+
+	ordering := &Ordering{
+		Key:            key,
+		MaxConcurrency: maxConcurrency,
+	}
+
+	if expression != "" {
+		e := &expr.Expr{}
+		if err := e.DecodeString(expression); err != nil {
+			return nil, fmt.Errorf("invalid ordering key expression: %w", err)
+		}
+		ordering.Expression = e
+	}
+
+	return ordering, nil
+}
+
+// CreateRetryTopic returns a non-blocking retry tier of a subscription,
+// parsing the given delay.
+func CreateRetryTopic(topic, delay string) (RetryTopic, error) {
+	if len(strings.TrimSpace(topic)) == 0 {
+		return RetryTopic{}, errors.New("retry topic name must not be empty")
+	}
+
+	d, err := time.ParseDuration(delay)
+	if err != nil {
+		return RetryTopic{}, fmt.Errorf("invalid delay for retry topic %s: %w", topic, err)
+	}
+	if d < 0 {
+		return RetryTopic{}, fmt.Errorf("delay for retry topic %s must not be negative: %s", topic, delay)
+	}
+
+	return RetryTopic{Topic: topic, Delay: d}, nil
+}
+
+// CreateDeduplication returns the deduplication configuration for a
+// subscription, parsing the given TTL. An empty TTL defaults to
+// DefaultDeduplicationTTL.
+func CreateDeduplication(stateStore, ttl string) (*Deduplication, error) {
+	if len(strings.TrimSpace(stateStore)) == 0 {
+		return nil, errors.New("deduplication state store name must not be empty")
+	}
+
+	d := DefaultDeduplicationTTL
+	if len(ttl) > 0 {
+		var err error
+		d, err = time.ParseDuration(ttl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid deduplication ttl: %w", err)
+		}
+		if d < time.Second {
+			return nil, fmt.Errorf("deduplication ttl must be at least 1s: %s", ttl)
+		}
+	}
+
+	return &Deduplication{StateStore: stateStore, TTL: d}, nil
+}
+
 func GRPCEnvelopeFromSubscriptionMessage(ctx context.Context, msg *SubscribedMessage, log logger.Logger, tracingSpec *config.TracingSpec) (context.Context, *runtimev1pb.TopicEventRequest, trace.Span, error) {
 	cloudEvent := msg.CloudEvent
 
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"encoding/json"
+
+	contribMetadata "github.com/dapr/components-contrib/metadata"
+	contribPubsub "github.com/dapr/components-contrib/pubsub"
+)
+
+// ValidatePublishSchema validates the payload of a message to publish against
+// the schema of its topic, if any. The data is either a raw payload, or a
+// CloudEvent, as set by the `rawPayload` metadata.
+func ValidatePublishSchema(ps *PubsubItem, req *contribPubsub.PublishRequest) error {
+	if !ps.Schemas.HasSchema(req.Topic) {
+		return nil
+	}
+
+	if err := validateSchema(ps, req.Topic, req.Data, req.Metadata); err != nil {
+		return SchemaValidationError{Topic: req.Topic, Err: err}
+	}
+	return nil
+}
+
+// ValidateBulkPublishSchema validates the payload of every entry of a bulk
+// message to publish against the schema of its topic, if any.
+func ValidateBulkPublishSchema(ps *PubsubItem, req *contribPubsub.BulkPublishRequest) error {
+	if !ps.Schemas.HasSchema(req.Topic) {
+		return nil
+	}
+
+	for _, entry := range req.Entries {
+		md := req.Metadata
+		if len(entry.Metadata) > 0 {
+			md = entry.Metadata
+		}
+		if err := validateSchema(ps, req.Topic, entry.Event, md); err != nil {
+			return SchemaValidationError{Topic: req.Topic, EntryID: entry.EntryId, Err: err}
+		}
+	}
+	return nil
+}
+
+func validateSchema(ps *PubsubItem, topic string, data []byte, md map[string]string) error {
+	if rawPayload, _ := contribMetadata.IsRawPayload(md); rawPayload {
+		return ps.Schemas.ValidatePayload(topic, data)
+	}
+
+	var cloudEvent map[string]any
+	if err := json.Unmarshal(data, &cloudEvent); err != nil {
+		return err
+	}
+	return ps.Schemas.ValidateCloudEvent(topic, cloudEvent)
+}
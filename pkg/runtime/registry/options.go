@@ -19,6 +19,7 @@ import (
 	"github.com/dapr/dapr/pkg/components/conversation"
 	"github.com/dapr/dapr/pkg/components/crypto"
 	"github.com/dapr/dapr/pkg/components/lock"
+	"github.com/dapr/dapr/pkg/components/middleware/grpc"
 	"github.com/dapr/dapr/pkg/components/middleware/http"
 	"github.com/dapr/dapr/pkg/components/nameresolution"
 	"github.com/dapr/dapr/pkg/components/pubsub"
@@ -36,6 +37,7 @@ type Options struct {
 	nameResolution     *nameresolution.Registry
 	binding            *bindings.Registry
 	httpMiddleware     *http.Registry
+	grpcMiddleware     *grpc.Registry
 	crypto             *crypto.Registry
 	conversation       *conversation.Registry
 	componentsCallback ComponentsCallback
@@ -52,6 +54,7 @@ func NewOptions() *Options {
 		nameResolution: nameresolution.DefaultRegistry,
 		binding:        bindings.DefaultRegistry,
 		httpMiddleware: http.DefaultRegistry,
+		grpcMiddleware: grpc.DefaultRegistry,
 		crypto:         crypto.DefaultRegistry,
 		conversation:   conversation.DefaultRegistry,
 	}
@@ -105,6 +108,12 @@ func (o *Options) WithHTTPMiddlewares(registry *http.Registry) *Options {
 	return o
 }
 
+// WithGRPCMiddlewares adds gRPC middleware components to the runtime.
+func (o *Options) WithGRPCMiddlewares(registry *grpc.Registry) *Options {
+	o.grpcMiddleware = registry
+	return o
+}
+
 // WithCryptoProviders adds crypto components to the runtime.
 func (o *Options) WithCryptoProviders(registry *crypto.Registry) *Options {
 	o.crypto = registry
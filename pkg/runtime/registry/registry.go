@@ -22,6 +22,7 @@ import (
 	"github.com/dapr/dapr/pkg/components/conversation"
 	"github.com/dapr/dapr/pkg/components/crypto"
 	"github.com/dapr/dapr/pkg/components/lock"
+	"github.com/dapr/dapr/pkg/components/middleware/grpc"
 	"github.com/dapr/dapr/pkg/components/middleware/http"
 	"github.com/dapr/dapr/pkg/components/nameresolution"
 	"github.com/dapr/dapr/pkg/components/pubsub"
@@ -51,6 +52,7 @@ type Registry struct {
 	nameResolution *nameresolution.Registry
 	binding        *bindings.Registry
 	httpMiddleware *http.Registry
+	grpcMiddleware *grpc.Registry
 	crypto         *crypto.Registry
 	conversations  *conversation.Registry
 	componentCb    ComponentsCallback
@@ -67,6 +69,7 @@ func New(opts *Options) *Registry {
 		nameResolution: opts.nameResolution,
 		binding:        opts.binding,
 		httpMiddleware: opts.httpMiddleware,
+		grpcMiddleware: opts.grpcMiddleware,
 		crypto:         opts.crypto,
 		conversations:  opts.conversation,
 		componentCb:    opts.componentsCallback,
@@ -106,6 +109,10 @@ func (r *Registry) HTTPMiddlewares() *http.Registry {
 	return r.httpMiddleware
 }
 
+func (r *Registry) GRPCMiddlewares() *grpc.Registry {
+	return r.grpcMiddleware
+}
+
 func (r *Registry) Crypto() *crypto.Registry {
 	return r.crypto
 }
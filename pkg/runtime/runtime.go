@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	nethttp "net/http"
 	"os"
 	"reflect"
 	"runtime"
@@ -65,6 +66,8 @@ import (
 	"github.com/dapr/dapr/pkg/internal/loader/kubernetes"
 	"github.com/dapr/dapr/pkg/messaging"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/middleware"
+	middlewaregrpc "github.com/dapr/dapr/pkg/middleware/grpc"
 	middlewarehttp "github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/operator/client"
@@ -80,9 +83,11 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/processor"
 	"github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/replay"
 	"github.com/dapr/dapr/pkg/runtime/pubsub/streamer"
 	"github.com/dapr/dapr/pkg/runtime/registry"
 	"github.com/dapr/dapr/pkg/runtime/scheduler"
+	rtstatus "github.com/dapr/dapr/pkg/runtime/status"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/dapr/pkg/security"
 	"github.com/dapr/dapr/utils"
@@ -116,9 +121,11 @@ type DaprRuntime struct {
 	appHealthReady        func(context.Context) error // Invoked the first time the app health becomes ready
 	appHealthLock         sync.Mutex
 	httpMiddleware        *middlewarehttp.HTTP
+	grpcMiddleware        *middlewaregrpc.GRPC
 	compStore             *compstore.ComponentStore
 	pubsubAdapter         pubsub.Adapter
 	pubsubAdapterStreamer pubsub.AdapterStreamer
+	pubsubReplayer        *replay.Manager
 	outbox                outbox.Outbox
 	meta                  *meta.Meta
 	processor             *processor.Processor
@@ -188,7 +195,9 @@ func newDaprRuntime(ctx context.Context,
 	}
 
 	appAPIToken := security.GetAppToken()
-	grpc := createGRPCManager(sec, runtimeConfig, globalConfig, appAPIToken)
+	grpcMiddleware := middlewaregrpc.New()
+	grpcMiddlewareApp := grpcMiddleware.BuildPipelineFromSpec("app", globalConfig.Spec.AppGRPCPipelineSpec)
+	grpc := createGRPCManager(sec, runtimeConfig, globalConfig, appAPIToken, grpcMiddlewareApp)
 
 	authz := authorizer.New(authorizer.Options{
 		ID:           runtimeConfig.id,
@@ -220,6 +229,12 @@ func newDaprRuntime(ctx context.Context,
 	pubsubAdapterStreamer := streamer.New(ctx, streamer.Options{
 		TracingSpec: globalConfig.Spec.TracingSpec,
 	})
+	pubsubReplayer := replay.New(replay.Options{
+		AppID:       runtimeConfig.id,
+		Namespace:   namespace,
+		Adapter:     pubsubAdapter,
+		GetPubSubFn: compStore.GetPubSub,
+	})
 	outbox := pubsub.NewOutbox(pubsub.OptionsOutbox{
 		Publisher:             pubsubAdapter,
 		GetPubsubFn:           compStore.GetPubSubComponent,
@@ -234,6 +249,7 @@ func newDaprRuntime(ctx context.Context,
 		Port:      runtimeConfig.internalGRPCPort,
 		// TODO: @joshvanl
 		PlacementAddresses: strings.Split(strings.TrimPrefix(runtimeConfig.actorsService, "placement:"), ","),
+		PlacementWeight:    runtimeConfig.placementWeight,
 		HealthEndpoint:     channels.AppHTTPEndpoint(),
 		Resiliency:         resiliencyProvider,
 		Security:           sec,
@@ -244,6 +260,17 @@ func newDaprRuntime(ctx context.Context,
 		Mode:               runtimeConfig.mode,
 	})
 
+	// Results of loading resources are reported to the operator, which
+	// aggregates them into the status of the resources.
+	var statusReporter *rtstatus.Reporter
+	if operatorClient != nil {
+		statusReporter = rtstatus.New(rtstatus.Options{
+			Client:    operatorClient,
+			Namespace: namespace,
+			PodName:   podName,
+		})
+	}
+
 	processor := processor.New(processor.Options{
 		ID:              runtimeConfig.id,
 		Namespace:       namespace,
@@ -261,25 +288,35 @@ func newDaprRuntime(ctx context.Context,
 		GRPC:            grpc,
 		Channels:        channels,
 		MiddlewareHTTP:  httpMiddleware,
+		MiddlewareGRPC:  grpcMiddleware,
 		Security:        sec,
 		Outbox:          outbox,
 		Adapter:         pubsubAdapter,
 		AdapterStreamer: pubsubAdapterStreamer,
 		Reporter:        runtimeConfig.registry.Reporter(),
+		Status:          statusReporter,
 	})
 
 	var reloader *hotreload.Reloader
 	switch runtimeConfig.mode {
 	case modes.KubernetesMode:
+		var configName string
+		if len(runtimeConfig.config) > 0 {
+			configName = runtimeConfig.config[0]
+		}
+		reloadableResiliency, _ := resiliencyProvider.(*resiliency.Reloadable)
 		reloader = hotreload.NewOperator(hotreload.OptionsReloaderOperator{
 			PodName:        podName,
 			Namespace:      namespace,
+			AppID:          runtimeConfig.id,
 			Client:         operatorClient,
 			Config:         globalConfig,
 			ComponentStore: compStore,
 			Authorizer:     authz,
 			Processor:      processor,
 			Healthz:        runtimeConfig.healthz,
+			Resiliency:     reloadableResiliency,
+			ConfigName:     configName,
 		})
 	case modes.StandaloneMode:
 		reloader, err = hotreload.NewDisk(hotreload.OptionsReloaderDisk{
@@ -318,12 +355,14 @@ func newDaprRuntime(ctx context.Context,
 		Addresses:        runtimeConfig.schedulerAddress,
 		Security:         sec,
 		WFEngine:         wfe,
+		Publisher:        pubsubAdapter,
 		Healthz:          runtimeConfig.healthz,
 		SchedulerStreams: runtimeConfig.schedulerStreams,
 	})
 	if err != nil {
 		return nil, err
 	}
+	processor.Subscriber().SetScheduler(jobsManager.Client())
 
 	rt := &DaprRuntime{
 		runtimeConfig:         runtimeConfig,
@@ -336,6 +375,7 @@ func newDaprRuntime(ctx context.Context,
 		compStore:             compStore,
 		pubsubAdapter:         pubsubAdapter,
 		pubsubAdapterStreamer: pubsubAdapterStreamer,
+		pubsubReplayer:        pubsubReplayer,
 		outbox:                outbox,
 		meta:                  meta,
 		operatorClient:        operatorClient,
@@ -351,6 +391,7 @@ func newDaprRuntime(ctx context.Context,
 		isAppHealthy:          make(chan struct{}),
 		clock:                 new(clock.RealClock),
 		httpMiddleware:        httpMiddleware,
+		grpcMiddleware:        grpcMiddleware,
 		actors:                actors,
 		wfengine:              wfe,
 	}
@@ -404,7 +445,14 @@ func newDaprRuntime(ctx context.Context,
 		},
 	)
 
+	if statusReporter != nil {
+		if err := rt.runnerCloser.Add(statusReporter.Run); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := rt.runnerCloser.AddCloser(
+		rt.pubsubReplayer,
 		func() error {
 			log.Info("Dapr is shutting down")
 			comps := rt.compStore.ListComponents()
@@ -666,7 +714,7 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 		Universal:             a.daprUniversal,
 		Logger:                logger.NewLogger("dapr.grpc.api"),
 		Channels:              a.channels,
-		PubSubAdapter:         a.pubsubAdapter,
+		PubSubAdapter:         publisher.WithSchemaValidation(a.pubsubAdapter, a.compStore.GetPubSub),
 		PubSubAdapterStreamer: a.pubsubAdapterStreamer,
 		Outbox:                a.outbox,
 		DirectMessaging:       a.directMessaging,
@@ -873,6 +921,7 @@ func (a *DaprRuntime) initDirectMessaging(resolver nr.Resolver) {
 		ReadBufferSize:     a.runtimeConfig.readBufferSize,
 		Resiliency:         a.resiliency,
 		CompStore:          a.compStore,
+		ServiceInvocation:  a.globalConfig.GetServiceInvocationSpec(),
 	})
 	a.runnerCloser.AddCloser(a.directMessaging)
 }
@@ -895,7 +944,9 @@ func (a *DaprRuntime) startHTTPServer() error {
 		Universal:             a.daprUniversal,
 		Channels:              a.channels,
 		DirectMessaging:       a.directMessaging,
-		PubSubAdapter:         a.pubsubAdapter,
+		PubSubAdapter:         publisher.WithSchemaValidation(a.pubsubAdapter, a.compStore.GetPubSub),
+		PubSubReplayer:        a.pubsubReplayer,
+		Subscriber:            a.processor.Subscriber(),
 		Outbox:                a.outbox,
 		SendToOutputBindingFn: a.processor.Binding().SendToOutputBinding,
 		TracingSpec:           a.globalConfig.GetTracingSpec(),
@@ -923,13 +974,18 @@ func (a *DaprRuntime) startHTTPServer() error {
 		APILogHealthChecks:      !a.globalConfig.GetAPILoggingSpec().OmitHealthChecks,
 	}
 
+	// Serve the gRPC API on the HTTP server too, to clients which use the
+	// Connect or gRPC-Web protocols.
+	connectPath, connectHandler := a.grpcAPIServer.ConnectHandler()
+
 	server := http.NewServer(http.NewServerOpts{
-		API:         a.daprHTTPAPI,
-		Config:      serverConf,
-		TracingSpec: a.globalConfig.GetTracingSpec(),
-		MetricSpec:  a.globalConfig.GetMetricsSpec(),
-		Middleware:  a.httpMiddleware.BuildPipelineFromSpec("server", a.globalConfig.Spec.HTTPPipelineSpec),
-		APISpec:     a.globalConfig.GetAPISpec(),
+		API:             a.daprHTTPAPI,
+		Config:          serverConf,
+		TracingSpec:     a.globalConfig.GetTracingSpec(),
+		MetricSpec:      a.globalConfig.GetMetricsSpec(),
+		Middleware:      a.httpMiddleware.BuildPipelineFromSpec("server", a.globalConfig.Spec.HTTPPipelineSpec),
+		APISpec:         a.globalConfig.GetAPISpec(),
+		ConnectHandlers: map[string]nethttp.Handler{connectPath: connectHandler},
 	})
 	if err := server.StartNonBlocking(); err != nil {
 		return err
@@ -972,6 +1028,7 @@ func (a *DaprRuntime) startGRPCAPIServer(api grpc.API, port int) error {
 		Proxy:          a.proxy,
 		WorkflowEngine: a.wfengine,
 		Healthz:        a.runtimeConfig.healthz,
+		Middleware:     a.grpcMiddleware.BuildPipelineFromSpec("server", a.globalConfig.Spec.GRPCPipelineSpec),
 	})
 
 	if err := a.grpcAPIServer.StartNonBlocking(); err != nil {
@@ -1407,7 +1464,7 @@ func featureTypeToString(features interface{}) []string {
 	return featureStr
 }
 
-func createGRPCManager(sec security.Handler, runtimeConfig *internalConfig, globalConfig *config.Configuration, appAPIToken string) *manager.Manager {
+func createGRPCManager(sec security.Handler, runtimeConfig *internalConfig, globalConfig *config.Configuration, appAPIToken string, appMiddleware middleware.GRPC) *manager.Manager {
 	grpcAppChannelConfig := &manager.AppChannelConfig{}
 	if globalConfig != nil {
 		grpcAppChannelConfig.TracingSpec = globalConfig.GetTracingSpec()
@@ -1422,6 +1479,7 @@ func createGRPCManager(sec security.Handler, runtimeConfig *internalConfig, glob
 	}
 
 	grpcAppChannelConfig.AppAPIToken = appAPIToken
+	grpcAppChannelConfig.Middleware = appMiddleware
 	m := manager.NewManager(sec, runtimeConfig.mode, grpcAppChannelConfig)
 	m.StartCollector()
 	return m
@@ -22,6 +22,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/kit/concurrency"
 	"github.com/dapr/kit/logger"
@@ -35,10 +36,11 @@ type Options struct {
 	AppTarget  bool
 	ActorTypes []string
 
-	Clients  []schedulerv1pb.SchedulerClient
-	Actors   actors.Interface
-	Channels *channels.Channels
-	WFEngine wfengine.Interface
+	Clients   []schedulerv1pb.SchedulerClient
+	Actors    actors.Interface
+	Channels  *channels.Channels
+	WFEngine  wfengine.Interface
+	Publisher rtpubsub.Adapter
 }
 
 // Cluster manages connections to multiple schedulers.
@@ -48,10 +50,11 @@ type Cluster struct {
 	appTarget  bool
 	actorTypes []string
 
-	clients  []schedulerv1pb.SchedulerClient
-	actors   actors.Interface
-	channels *channels.Channels
-	wfengine wfengine.Interface
+	clients   []schedulerv1pb.SchedulerClient
+	actors    actors.Interface
+	channels  *channels.Channels
+	wfengine  wfengine.Interface
+	publisher rtpubsub.Adapter
 }
 
 func New(opts Options) *Cluster {
@@ -64,6 +67,7 @@ func New(opts Options) *Cluster {
 		actors:     opts.Actors,
 		channels:   opts.Channels,
 		wfengine:   opts.WFEngine,
+		publisher:  opts.Publisher,
 	}
 }
 
@@ -121,11 +125,12 @@ func (c *Cluster) watchJobs(ctx context.Context) error {
 	router, _ := c.actors.Router(ctx)
 	for i := range c.clients {
 		connectors[i] = &connector{
-			req:      req,
-			client:   c.clients[i],
-			channels: c.channels,
-			actors:   router,
-			wfengine: c.wfengine,
+			req:       req,
+			client:    c.clients[i],
+			channels:  c.channels,
+			actors:    router,
+			wfengine:  c.wfengine,
+			publisher: c.publisher,
 		}
 		runners[i] = connectors[i].run
 	}
@@ -20,15 +20,17 @@ import (
 	"github.com/dapr/dapr/pkg/actors/router"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 )
 
 type connector struct {
-	req      *schedulerv1pb.WatchJobsRequest
-	client   schedulerv1pb.SchedulerClient
-	channels *channels.Channels
-	actors   router.Interface
-	wfengine wfengine.Interface
+	req       *schedulerv1pb.WatchJobsRequest
+	client    schedulerv1pb.SchedulerClient
+	channels  *channels.Channels
+	actors    router.Interface
+	wfengine  wfengine.Interface
+	publisher rtpubsub.Adapter
 }
 
 // run starts the scheduler connector.
@@ -60,11 +62,12 @@ func (c *connector) run(ctx context.Context) error {
 	log.Infof("Scheduler stream connected for %s", c.req.GetInitial().GetAcceptJobTypes())
 
 	err = (&streamer{
-		stream:   stream,
-		resultCh: make(chan *schedulerv1pb.WatchJobsRequest),
-		channels: c.channels,
-		actors:   c.actors,
-		wfengine: c.wfengine,
+		stream:    stream,
+		resultCh:  make(chan *schedulerv1pb.WatchJobsRequest),
+		channels:  c.channels,
+		actors:    c.actors,
+		wfengine:  c.wfengine,
+		publisher: c.publisher,
 	}).run(ctx)
 
 	if err == nil {
@@ -20,6 +20,7 @@ import (
 	"io"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -30,6 +31,8 @@ import (
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/kit/concurrency"
 )
@@ -38,9 +41,10 @@ type streamer struct {
 	stream   schedulerv1pb.Scheduler_WatchJobsClient
 	resultCh chan *schedulerv1pb.WatchJobsRequest
 
-	actors   router.Interface
-	channels *channels.Channels
-	wfengine wfengine.Interface
+	actors    router.Interface
+	channels  *channels.Channels
+	wfengine  wfengine.Interface
+	publisher rtpubsub.Adapter
 
 	wg       sync.WaitGroup
 	inflight atomic.Int64
@@ -121,6 +125,14 @@ func (s *streamer) handleJob(ctx context.Context, job *schedulerv1pb.WatchJobsRe
 
 	switch t := meta.GetTarget(); t.GetType().(type) {
 	case *schedulerv1pb.JobTargetMetadata_Job:
+		if publisher.IsScheduledJob(job.GetName()) {
+			if err := s.publishScheduled(ctx, job); err != nil {
+				log.Errorf("failed to publish scheduled message: %s", err)
+				return schedulerv1pb.WatchJobsRequestResultStatus_FAILED
+			}
+			return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS
+		}
+
 		if err := s.invokeApp(ctx, job); err != nil {
 			log.Errorf("failed to invoke schedule app job: %s", err)
 			return schedulerv1pb.WatchJobsRequestResultStatus_FAILED
@@ -198,6 +210,29 @@ func (s *streamer) invokeApp(ctx context.Context, job *schedulerv1pb.WatchJobsRe
 	}
 }
 
+// publishScheduled publishes a delayed pub/sub message which was stored as
+// the data of the given job.
+func (s *streamer) publishScheduled(ctx context.Context, job *schedulerv1pb.WatchJobsResponse) error {
+	if s.publisher == nil {
+		return errors.New("received scheduled message, but pub/sub publisher is not initialized")
+	}
+
+	req, err := publisher.DecodeScheduled(job.GetData())
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	err = s.publisher.Publish(ctx, req)
+	diag.DefaultComponentMonitoring.PubsubEgressEvent(ctx, req.PubsubName, req.Topic, err == nil, diag.ElapsedSince(start))
+	if err != nil {
+		return fmt.Errorf("error publishing scheduled message to topic %s: %w", req.Topic, err)
+	}
+
+	log.Debugf("Published scheduled message %s to topic %s", job.GetName(), req.Topic)
+	return nil
+}
+
 // invokeActorReminder calls the actor ID with the given reminder data.
 func (s *streamer) invokeActorReminder(ctx context.Context, job *schedulerv1pb.WatchJobsResponse) error {
 	if s.actors == nil {
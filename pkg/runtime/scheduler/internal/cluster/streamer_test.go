@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
+	publisherfake "github.com/dapr/dapr/pkg/runtime/pubsub/publisher/fake"
+	"github.com/dapr/kit/ptr"
+)
+
+func scheduledJob(t *testing.T) *schedulerv1pb.WatchJobsResponse {
+	t.Helper()
+
+	name, err := publisher.ScheduledJobName("mypubsub", "abc")
+	require.NoError(t, err)
+	data, err := publisher.EncodeScheduled(&contribpubsub.PublishRequest{
+		PubsubName:  "mypubsub",
+		Topic:       "mytopic",
+		Data:        []byte("hello"),
+		ContentType: ptr.Of("text/plain"),
+		Metadata:    map[string]string{"delay": "1m", "foo": "bar"},
+	})
+	require.NoError(t, err)
+
+	return &schedulerv1pb.WatchJobsResponse{
+		Name: name,
+		Data: data,
+		Metadata: &schedulerv1pb.JobMetadata{
+			Target: &schedulerv1pb.JobTargetMetadata{
+				Type: &schedulerv1pb.JobTargetMetadata_Job{Job: new(schedulerv1pb.TargetJob)},
+			},
+		},
+	}
+}
+
+func TestPublishScheduled(t *testing.T) {
+	t.Run("publishes the message of the job", func(t *testing.T) {
+		var published *contribpubsub.PublishRequest
+		s := &streamer{
+			publisher: publisherfake.New().WithPublishFn(func(_ context.Context, req *contribpubsub.PublishRequest) error {
+				published = req
+				return nil
+			}),
+		}
+
+		result := s.handleJob(t.Context(), scheduledJob(t))
+		assert.Equal(t, schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS, result)
+		require.NotNil(t, published)
+		assert.Equal(t, "mypubsub", published.PubsubName)
+		assert.Equal(t, "mytopic", published.Topic)
+		assert.Equal(t, []byte("hello"), published.Data)
+		assert.Equal(t, "text/plain", *published.ContentType)
+		assert.Equal(t, map[string]string{"foo": "bar"}, published.Metadata)
+	})
+
+	t.Run("fails the job if the message cannot be published", func(t *testing.T) {
+		s := &streamer{
+			publisher: publisherfake.New().WithPublishFn(func(context.Context, *contribpubsub.PublishRequest) error {
+				return errors.New("broker unavailable")
+			}),
+		}
+
+		result := s.handleJob(t.Context(), scheduledJob(t))
+		assert.Equal(t, schedulerv1pb.WatchJobsRequestResultStatus_FAILED, result)
+	})
+
+	t.Run("fails the job without a publisher", func(t *testing.T) {
+		s := new(streamer)
+
+		result := s.handleJob(t.Context(), scheduledJob(t))
+		assert.Equal(t, schedulerv1pb.WatchJobsRequestResultStatus_FAILED, result)
+	})
+}
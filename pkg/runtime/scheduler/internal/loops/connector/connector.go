@@ -21,6 +21,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/cluster"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/loops"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
@@ -34,9 +35,10 @@ type Options struct {
 	Namespace string
 	AppID     string
 
-	Actors   actors.Interface
-	Channels *channels.Channels
-	WFEngine wfengine.Interface
+	Actors    actors.Interface
+	Channels  *channels.Channels
+	WFEngine  wfengine.Interface
+	Publisher rtpubsub.Adapter
 }
 
 type connector struct {
@@ -45,6 +47,7 @@ type connector struct {
 	actors    actors.Interface
 	channels  *channels.Channels
 	wfEngine  wfengine.Interface
+	publisher rtpubsub.Adapter
 
 	currentAppRunning bool
 	currentActorTypes []string
@@ -60,6 +63,7 @@ func New(opts Options) loop.Interface[loops.Event] {
 		actors:    opts.Actors,
 		channels:  opts.Channels,
 		wfEngine:  opts.WFEngine,
+		publisher: opts.Publisher,
 	})
 }
 
@@ -125,6 +129,7 @@ func (c *connector) maybeClientConnect(ctx context.Context) {
 		Actors:    c.actors,
 		Channels:  c.channels,
 		WFEngine:  c.wfEngine,
+		Publisher: c.publisher,
 
 		AppTarget:  c.currentAppRunning,
 		ActorTypes: c.currentActorTypes,
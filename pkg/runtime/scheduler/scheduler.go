@@ -20,6 +20,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/clients"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/clients/wrapper"
@@ -40,6 +41,7 @@ type Options struct {
 	Actors           actors.Interface
 	Channels         *channels.Channels
 	WFEngine         wfengine.Interface
+	Publisher        rtpubsub.Adapter
 	Addresses        []string
 	Security         security.Handler
 	Healthz          healthz.Healthz
@@ -61,6 +63,7 @@ func New(opts Options) (*Scheduler, error) {
 		Actors:    opts.Actors,
 		Channels:  opts.Channels,
 		WFEngine:  opts.WFEngine,
+		Publisher: opts.Publisher,
 	})
 
 	if opts.SchedulerStreams < 1 {
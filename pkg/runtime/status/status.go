@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"k8s.io/utils/clock"
+
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.status")
+
+const (
+	// refreshInterval is the interval at which the latest results are sent
+	// again, which detects broken streams.
+	refreshInterval = time.Minute
+
+	// retryInterval is the interval after which a failed stream is re-opened.
+	retryInterval = 5 * time.Second
+)
+
+type Options struct {
+	Client    operatorv1pb.OperatorClient
+	Namespace string
+	PodName   string
+	Clock     clock.WithTicker
+}
+
+// Reporter reports the results of loading resources to the operator, which
+// aggregates them into the status of the resources. A nil Reporter discards
+// all results.
+type Reporter struct {
+	client    operatorv1pb.OperatorClient
+	namespace string
+	podName   string
+	clock     clock.WithTicker
+
+	lock      sync.Mutex
+	results   map[key]*operatorv1pb.ResourceResult
+	pending   []*operatorv1pb.ResourceResult
+	connected bool
+	disabled  bool
+	notifyCh  chan struct{}
+}
+
+type key struct {
+	typ  operatorv1pb.ResourceType
+	name string
+}
+
+func New(opts Options) *Reporter {
+	cl := opts.Clock
+	if cl == nil {
+		cl = clock.RealClock{}
+	}
+
+	return &Reporter{
+		client:    opts.Client,
+		namespace: opts.Namespace,
+		podName:   opts.PodName,
+		clock:     cl,
+		results:   make(map[key]*operatorv1pb.ResourceResult),
+		notifyCh:  make(chan struct{}, 1),
+	}
+}
+
+// Report queues the result of loading, or closing, a resource to be sent to
+// the operator.
+func (r *Reporter) Report(result *operatorv1pb.ResourceResult) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.disabled {
+		return
+	}
+
+	k := key{typ: result.GetResourceType(), name: result.GetName()}
+	if result.GetEventType() == operatorv1pb.EventType_EVENT_CLOSE {
+		delete(r.results, k)
+	} else {
+		r.results[k] = result
+	}
+
+	// Results are only queued while connected, as all the latest results are
+	// sent when a stream is opened.
+	if r.connected {
+		r.pending = append(r.pending, result)
+		select {
+		case r.notifyCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Run streams the reported results to the operator until the context is
+// cancelled, re-opening the stream if it fails.
+func (r *Reporter) Run(ctx context.Context) error {
+	for {
+		err := r.stream(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if grpcstatus.Code(err) == codes.Unimplemented {
+			log.Info("Operator does not support resource status reporting, resource status will not be reported")
+			r.lock.Lock()
+			r.disabled = true
+			r.results = nil
+			r.lock.Unlock()
+			<-ctx.Done()
+			return nil
+		}
+
+		log.Warnf("Error reporting resource status to operator, retrying in %s: %s", retryInterval, err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.clock.After(retryInterval):
+		}
+	}
+}
+
+func (r *Reporter) stream(ctx context.Context) error {
+	stream, err := r.client.ReportResourceStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The operator discards the results of previous streams, so the latest
+	// result of every resource is sent again.
+	r.lock.Lock()
+	send := r.snapshotLocked()
+	r.pending = nil
+	r.connected = true
+	r.lock.Unlock()
+
+	defer func() {
+		r.lock.Lock()
+		r.pending = nil
+		r.connected = false
+		r.lock.Unlock()
+	}()
+
+	ticker := r.clock.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, result := range send {
+			err = stream.Send(&operatorv1pb.ReportResourceStatusRequest{
+				Namespace: r.namespace,
+				PodName:   r.podName,
+				Result:    result,
+			})
+			if errors.Is(err, io.EOF) {
+				// The actual error is returned by receiving the response.
+				if _, err = stream.CloseAndRecv(); err == nil {
+					err = errors.New("stream closed by operator")
+				}
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.notifyCh:
+			r.lock.Lock()
+			send = r.pending
+			r.pending = nil
+			r.lock.Unlock()
+		case <-ticker.C():
+			r.lock.Lock()
+			send = r.snapshotLocked()
+			r.lock.Unlock()
+		}
+	}
+}
+
+func (r *Reporter) snapshotLocked() []*operatorv1pb.ResourceResult {
+	results := make([]*operatorv1pb.ResourceResult, 0, len(r.results))
+	for _, result := range r.results {
+		results = append(results, result)
+	}
+	return results
+}
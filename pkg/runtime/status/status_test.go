@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+)
+
+type fakeOperator struct {
+	operatorv1pb.OperatorClient
+	streams chan *fakeStream
+}
+
+func (f *fakeOperator) ReportResourceStatus(context.Context, ...grpc.CallOption) (operatorv1pb.Operator_ReportResourceStatusClient, error) {
+	s := &fakeStream{sent: make(chan *operatorv1pb.ReportResourceStatusRequest, 10)}
+	f.streams <- s
+	return s, nil
+}
+
+type fakeStream struct {
+	grpc.ClientStream
+	sent chan *operatorv1pb.ReportResourceStatusRequest
+
+	lock   sync.Mutex
+	closed error
+}
+
+func (f *fakeStream) Send(req *operatorv1pb.ReportResourceStatusRequest) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.closed != nil {
+		return io.EOF
+	}
+	f.sent <- req
+	return nil
+}
+
+func (f *fakeStream) CloseAndRecv() (*emptypb.Empty, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return nil, f.closed
+}
+
+// close makes the operator end the stream with the given error.
+func (f *fakeStream) close(err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.closed = err
+}
+
+func result(name string, eventType operatorv1pb.EventType) *operatorv1pb.ResourceResult {
+	return &operatorv1pb.ResourceResult{
+		ResourceType: operatorv1pb.ResourceType_RESOURCE_COMPONENT,
+		EventType:    eventType,
+		Name:         name,
+		Condition:    operatorv1pb.ResourceConditionStatus_STATUS_SUCCESS,
+	}
+}
+
+func runReporter(t *testing.T, r *Reporter) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error)
+	go func() { errCh <- r.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+}
+
+func receive(t *testing.T, s *fakeStream) *operatorv1pb.ReportResourceStatusRequest {
+	t.Helper()
+
+	select {
+	case req := <-s.sent:
+		return req
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "timed out waiting for result")
+		return nil
+	}
+}
+
+func TestReporter(t *testing.T) {
+	t.Run("nil reporter discards results", func(t *testing.T) {
+		var r *Reporter
+		r.Report(result("comp1", operatorv1pb.EventType_EVENT_INIT))
+	})
+
+	t.Run("sends the latest results on every stream", func(t *testing.T) {
+		op := &fakeOperator{streams: make(chan *fakeStream, 1)}
+		clock := clocktesting.NewFakeClock(time.Now())
+		r := New(Options{Client: op, Namespace: "default", PodName: "pod1", Clock: clock})
+
+		r.Report(result("comp1", operatorv1pb.EventType_EVENT_INIT))
+		runReporter(t, r)
+
+		s := <-op.streams
+		req := receive(t, s)
+		assert.Equal(t, "default", req.GetNamespace())
+		assert.Equal(t, "pod1", req.GetPodName())
+		assert.Equal(t, "comp1", req.GetResult().GetName())
+
+		r.Report(result("comp2", operatorv1pb.EventType_EVENT_INIT))
+		assert.Equal(t, "comp2", receive(t, s).GetResult().GetName())
+		r.Report(result("comp1", operatorv1pb.EventType_EVENT_CLOSE))
+		req = receive(t, s)
+		assert.Equal(t, "comp1", req.GetResult().GetName())
+		assert.Equal(t, operatorv1pb.EventType_EVENT_CLOSE, req.GetResult().GetEventType())
+
+		// Breaking the stream re-opens it, which only sends the results of the
+		// resources which are still loaded.
+		s.close(grpcstatus.Error(codes.Unavailable, "operator went away"))
+		r.Report(result("comp3", operatorv1pb.EventType_EVENT_INIT))
+		assert.Eventually(t, func() bool {
+			clock.Step(retryInterval)
+			return len(op.streams) > 0
+		}, time.Second*5, time.Millisecond*10)
+
+		s = <-op.streams
+		names := []string{receive(t, s).GetResult().GetName(), receive(t, s).GetResult().GetName()}
+		assert.ElementsMatch(t, []string{"comp2", "comp3"}, names)
+	})
+
+	t.Run("stops reporting if the operator does not support it", func(t *testing.T) {
+		op := &fakeOperator{streams: make(chan *fakeStream, 1)}
+		r := New(Options{Client: op, Namespace: "default", PodName: "pod1"})
+		r.Report(result("comp1", operatorv1pb.EventType_EVENT_INIT))
+		runReporter(t, r)
+
+		s := <-op.streams
+		receive(t, s)
+		s.close(grpcstatus.Error(codes.Unimplemented, "unknown method"))
+		r.Report(result("comp2", operatorv1pb.EventType_EVENT_INIT))
+
+		assert.Eventually(t, func() bool {
+			r.lock.Lock()
+			defer r.lock.Unlock()
+			return r.disabled
+		}, time.Second*5, time.Millisecond*10)
+		r.Report(result("comp3", operatorv1pb.EventType_EVENT_INIT))
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		assert.Empty(t, r.results)
+	})
+}
@@ -106,6 +106,13 @@ func (s *Subscription) bulkSubscribeTopic(ctx context.Context, policyDef *resili
 			}
 			entryIdIndexMap[message.EntryId] = i
 			if rawPayload {
+				if schemaErr := s.pubsub.Schemas.ValidatePayload(topic, message.Event); schemaErr != nil {
+					log.Errorf("entry %s in pubsub %s and topic %s does not match the topic schema: %s", message.EntryId, psName, topic, schemaErr)
+					bulkResponses[i].Error = rtpubsub.SchemaValidationError{Topic: topic, EntryID: message.EntryId, Err: schemaErr}
+					bulkResponses[i].EntryId = message.EntryId
+					hasAnyError = true
+					continue
+				}
 				rPath, routeErr := s.getRouteIfProcessable(ctx, &bulkSubCallData, route, &(msg.Entries[i]), i, string(message.Event))
 				if routeErr != nil {
 					hasAnyError = true
@@ -141,6 +148,13 @@ func (s *Subscription) bulkSubscribeTopic(ctx context.Context, policyDef *resili
 					bulkResponses[i].Error = nil
 					continue
 				}
+				if schemaErr := s.pubsub.Schemas.ValidateCloudEvent(topic, cloudEvent); schemaErr != nil {
+					log.Errorf("entry %s in pubsub %s and topic %s does not match the topic schema: %s", message.EntryId, psName, topic, schemaErr)
+					bulkResponses[i].Error = rtpubsub.SchemaValidationError{Topic: topic, EntryID: message.EntryId, Err: schemaErr}
+					bulkResponses[i].EntryId = message.EntryId
+					hasAnyError = true
+					continue
+				}
 				rPath, routeErr := s.getRouteIfProcessable(ctx, &bulkSubCallData, route, &(msg.Entries[i]), i, cloudEvent)
 				if routeErr != nil {
 					hasAnyError = true
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	contribmetadata "github.com/dapr/components-contrib/metadata"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+// deduper records the IDs of events which have been processed by the app in
+// a state store, so that redelivered events can be acknowledged without
+// being delivered again. IDs expire from the state store after the
+// configured TTL, if the state store supports TTLs.
+type deduper struct {
+	compStore  *compstore.ComponentStore
+	stateStore string
+	ttl        string
+	keyPrefix  string
+}
+
+func newDeduper(compStore *compstore.ComponentStore, deduplication *rtpubsub.Deduplication, appID, pubsubName, topic string) *deduper {
+	return &deduper{
+		compStore:  compStore,
+		stateStore: deduplication.StateStore,
+		ttl:        strconv.FormatInt(int64(deduplication.TTL/time.Second), 10),
+		keyPrefix:  appID + "||dedupe||" + pubsubName + "||" + topic + "||",
+	}
+}
+
+func (d *deduper) store() (state.Store, error) {
+	store, ok := d.compStore.GetStateStore(d.stateStore)
+	if !ok {
+		return nil, fmt.Errorf("deduplication state store %s is not found", d.stateStore)
+	}
+	return store, nil
+}
+
+// processed returns true if the event with the given ID has already been
+// processed by the app. A nil deduper, or an empty ID, is never processed.
+func (d *deduper) processed(ctx context.Context, id string) (bool, error) {
+	if d == nil || len(id) == 0 {
+		return false, nil
+	}
+
+	store, err := d.store()
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := store.Get(ctx, &state.GetRequest{Key: d.keyPrefix + id})
+	if err != nil {
+		return false, err
+	}
+
+	return resp != nil && len(resp.Data) > 0, nil
+}
+
+// record marks the event with the given ID as processed by the app.
+func (d *deduper) record(ctx context.Context, id string) error {
+	if d == nil || len(id) == 0 {
+		return nil
+	}
+
+	store, err := d.store()
+	if err != nil {
+		return err
+	}
+
+	return store.Set(ctx, &state.SetRequest{
+		Key:   d.keyPrefix + id,
+		Value: time.Now().UTC().Format(time.RFC3339),
+		Metadata: map[string]string{
+			contribmetadata.TTLInSecondsMetadataKey: d.ttl,
+		},
+	})
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestDeduper(t *testing.T) {
+	t.Run("nil deduper never reports processed", func(t *testing.T) {
+		var d *deduper
+		processed, err := d.processed(t.Context(), "1")
+		require.NoError(t, err)
+		assert.False(t, processed)
+		require.NoError(t, d.record(t.Context(), "1"))
+	})
+
+	t.Run("records processed events", func(t *testing.T) {
+		store := daprt.NewFakeStateStore()
+		compStore := compstore.New()
+		compStore.AddStateStore("dedupe", store)
+
+		d := newDeduper(compStore, &rtpubsub.Deduplication{StateStore: "dedupe", TTL: time.Hour}, "myapp", "mypubsub", "orders")
+		assert.Equal(t, "3600", d.ttl)
+
+		processed, err := d.processed(t.Context(), "1")
+		require.NoError(t, err)
+		assert.False(t, processed)
+
+		require.NoError(t, d.record(t.Context(), "1"))
+
+		processed, err = d.processed(t.Context(), "1")
+		require.NoError(t, err)
+		assert.True(t, processed)
+
+		processed, err = d.processed(t.Context(), "2")
+		require.NoError(t, err)
+		assert.False(t, processed)
+
+		other := newDeduper(compStore, &rtpubsub.Deduplication{StateStore: "dedupe", TTL: time.Hour}, "myapp", "mypubsub", "payments")
+		processed, err = other.processed(t.Context(), "1")
+		require.NoError(t, err)
+		assert.False(t, processed)
+	})
+
+	t.Run("events without an ID are not deduplicated", func(t *testing.T) {
+		compStore := compstore.New()
+		compStore.AddStateStore("dedupe", daprt.NewFakeStateStore())
+		d := newDeduper(compStore, &rtpubsub.Deduplication{StateStore: "dedupe", TTL: time.Hour}, "myapp", "mypubsub", "orders")
+
+		require.NoError(t, d.record(t.Context(), ""))
+		processed, err := d.processed(t.Context(), "")
+		require.NoError(t, err)
+		assert.False(t, processed)
+	})
+
+	t.Run("missing state store", func(t *testing.T) {
+		d := newDeduper(compstore.New(), &rtpubsub.Deduplication{StateStore: "dedupe", TTL: time.Hour}, "myapp", "mypubsub", "orders")
+		_, err := d.processed(t.Context(), "1")
+		require.Error(t, err)
+		require.Error(t, d.record(t.Context(), "1"))
+	})
+}
+
+func TestCreateDeduplication(t *testing.T) {
+	d, err := rtpubsub.CreateDeduplication("dedupe", "")
+	require.NoError(t, err)
+	assert.Equal(t, rtpubsub.DefaultDeduplicationTTL, d.TTL)
+
+	d, err = rtpubsub.CreateDeduplication("dedupe", "1h")
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, d.TTL)
+
+	_, err = rtpubsub.CreateDeduplication("", "1h")
+	require.Error(t, err)
+	_, err = rtpubsub.CreateDeduplication("dedupe", "forever")
+	require.Error(t, err)
+	_, err = rtpubsub.CreateDeduplication("dedupe", "10ms")
+	require.Error(t, err)
+}
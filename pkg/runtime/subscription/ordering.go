@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+// orderer serializes the delivery of messages which share the same ordering
+// key, in the order in which they are received by the subscription. Messages
+// with different keys are delivered in parallel, optionally bounded by a
+// maximum concurrency.
+type orderer struct {
+	ordering *rtpubsub.Ordering
+
+	// tails holds, per key, the channel of the last received message. Each
+	// channel is closed once its message has been delivered, which unblocks
+	// the next message of the same key.
+	lock  sync.Mutex
+	tails map[string]chan struct{}
+	sem   chan struct{}
+}
+
+func newOrderer(ordering *rtpubsub.Ordering) *orderer {
+	o := &orderer{
+		ordering: ordering,
+		tails:    make(map[string]chan struct{}),
+	}
+	if ordering.MaxConcurrency > 0 {
+		o.sem = make(chan struct{}, ordering.MaxConcurrency)
+	}
+	return o
+}
+
+// key returns the ordering key of the given cloud event. An empty key means
+// the message is not subject to ordering.
+func (o *orderer) key(cloudEvent map[string]any) (string, error) {
+	if o.ordering.Expression != nil {
+		res, err := o.ordering.Expression.Eval(map[string]any{
+			"event": cloudEvent,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to evaluate ordering key expression %s: %w", o.ordering.Expression, err)
+		}
+		if res == nil {
+			return "", nil
+		}
+		return fmt.Sprint(res), nil
+	}
+
+	v, ok := cloudEvent[o.ordering.Key]
+	if !ok || v == nil {
+		return "", nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+// acquire blocks until all previously received messages with the same
+// ordering key as the given cloud event have been delivered, and a
+// concurrency slot is available. The returned func must be called once the
+// message has been delivered. A nil orderer does not block.
+func (o *orderer) acquire(ctx context.Context, cloudEvent map[string]any) (func(), error) {
+	if o == nil {
+		return func() {}, nil
+	}
+
+	key, err := o.key(cloudEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var done chan struct{}
+	if len(key) > 0 {
+		o.lock.Lock()
+		prev := o.tails[key]
+		done = make(chan struct{})
+		o.tails[key] = done
+		o.lock.Unlock()
+
+		if prev != nil {
+			select {
+			case <-prev:
+			case <-ctx.Done():
+				// The next message of the key must still wait for the
+				// message before this one to be delivered.
+				go func() {
+					<-prev
+					o.release(key, done)
+				}()
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	if o.sem != nil {
+		select {
+		case o.sem <- struct{}{}:
+		case <-ctx.Done():
+			o.release(key, done)
+			return nil, ctx.Err()
+		}
+	}
+
+	return func() {
+		if o.sem != nil {
+			<-o.sem
+		}
+		o.release(key, done)
+	}, nil
+}
+
+func (o *orderer) release(key string, done chan struct{}) {
+	if done == nil {
+		return
+	}
+
+	close(done)
+	o.lock.Lock()
+	if o.tails[key] == done {
+		delete(o.tails, key)
+	}
+	o.lock.Unlock()
+}
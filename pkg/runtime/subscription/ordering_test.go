@@ -0,0 +1,206 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+func TestOrdererKey(t *testing.T) {
+	t.Run("attribute key", func(t *testing.T) {
+		o := newOrderer(&rtpubsub.Ordering{Key: "subject"})
+		key, err := o.key(map[string]any{"subject": "order-1"})
+		require.NoError(t, err)
+		assert.Equal(t, "order-1", key)
+
+		key, err = o.key(map[string]any{"id": "1"})
+		require.NoError(t, err)
+		assert.Empty(t, key)
+	})
+
+	t.Run("expression key", func(t *testing.T) {
+		ordering, err := rtpubsub.CreateOrdering("subject", `event.data.customer`, 0)
+		require.NoError(t, err)
+		o := newOrderer(ordering)
+		key, err := o.key(map[string]any{
+			"subject": "order-1",
+			"data":    map[string]any{"customer": "bob"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "bob", key)
+	})
+
+	t.Run("no ordering", func(t *testing.T) {
+		ordering, err := rtpubsub.CreateOrdering("", "", 0)
+		require.NoError(t, err)
+		assert.Nil(t, ordering)
+	})
+}
+
+func TestOrdererAcquire(t *testing.T) {
+	t.Run("nil orderer does not block", func(t *testing.T) {
+		var o *orderer
+		release, err := o.acquire(t.Context(), map[string]any{"subject": "a"})
+		require.NoError(t, err)
+		release()
+	})
+
+	t.Run("same key is delivered in sequence", func(t *testing.T) {
+		o := newOrderer(&rtpubsub.Ordering{Key: "subject"})
+
+		release1, err := o.acquire(t.Context(), map[string]any{"subject": "a"})
+		require.NoError(t, err)
+
+		acquired := make(chan struct{})
+		go func() {
+			release2, err := o.acquire(context.Background(), map[string]any{"subject": "a"})
+			assert.NoError(t, err)
+			close(acquired)
+			release2()
+		}()
+
+		select {
+		case <-acquired:
+			t.Fatal("second message with same key acquired before first was released")
+		case <-time.After(time.Millisecond * 100):
+		}
+
+		release1()
+
+		select {
+		case <-acquired:
+		case <-time.After(time.Second * 5):
+			t.Fatal("second message was not released")
+		}
+
+		assert.Eventually(t, func() bool {
+			o.lock.Lock()
+			defer o.lock.Unlock()
+			return len(o.tails) == 0
+		}, time.Second, time.Millisecond*10)
+	})
+
+	t.Run("different keys are delivered in parallel", func(t *testing.T) {
+		o := newOrderer(&rtpubsub.Ordering{Key: "subject"})
+
+		release1, err := o.acquire(t.Context(), map[string]any{"subject": "a"})
+		require.NoError(t, err)
+		release2, err := o.acquire(t.Context(), map[string]any{"subject": "b"})
+		require.NoError(t, err)
+		release1()
+		release2()
+	})
+
+	t.Run("max concurrency bounds parallel keys", func(t *testing.T) {
+		o := newOrderer(&rtpubsub.Ordering{Key: "subject", MaxConcurrency: 2})
+
+		var inflight, maxInflight atomic.Int64
+		var wg sync.WaitGroup
+		for _, key := range []string{"a", "b", "c", "d", "e", "f"} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				release, err := o.acquire(context.Background(), map[string]any{"subject": key})
+				if !assert.NoError(t, err) {
+					return
+				}
+				n := inflight.Add(1)
+				for {
+					m := maxInflight.Load()
+					if n <= m || maxInflight.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond * 20)
+				inflight.Add(-1)
+				release()
+			}()
+		}
+		wg.Wait()
+
+		assert.LessOrEqual(t, maxInflight.Load(), int64(2))
+	})
+
+	t.Run("cancelled context unblocks waiting message", func(t *testing.T) {
+		o := newOrderer(&rtpubsub.Ordering{Key: "subject"})
+
+		release1, err := o.acquire(t.Context(), map[string]any{"subject": "a"})
+		require.NoError(t, err)
+		defer release1()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		_, err = o.acquire(ctx, map[string]any{"subject": "a"})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("cancelled message does not let the next one overtake the previous", func(t *testing.T) {
+		o := newOrderer(&rtpubsub.Ordering{Key: "subject"})
+
+		release1, err := o.acquire(t.Context(), map[string]any{"subject": "a"})
+		require.NoError(t, err)
+		o.lock.Lock()
+		first := o.tails["a"]
+		o.lock.Unlock()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		errCh := make(chan error)
+		go func() {
+			_, err := o.acquire(ctx, map[string]any{"subject": "a"})
+			errCh <- err
+		}()
+
+		// Wait for the second message to be queued behind the first before
+		// queuing the third.
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			o.lock.Lock()
+			defer o.lock.Unlock()
+			assert.NotEqual(c, first, o.tails["a"])
+		}, time.Second*5, time.Millisecond*10)
+
+		acquired := make(chan struct{})
+		go func() {
+			release3, err := o.acquire(context.Background(), map[string]any{"subject": "a"})
+			assert.NoError(t, err)
+			close(acquired)
+			release3()
+		}()
+
+		cancel()
+		require.ErrorIs(t, <-errCh, context.Canceled)
+
+		select {
+		case <-acquired:
+			t.Fatal("third message acquired before the first was released")
+		case <-time.After(time.Millisecond * 100):
+		}
+
+		release1()
+
+		select {
+		case <-acquired:
+		case <-time.After(time.Second * 5):
+			t.Fatal("third message was not released")
+		}
+	})
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	contribContenttype "github.com/dapr/components-contrib/contenttype"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
+	"github.com/dapr/kit/ptr"
+)
+
+const (
+	// CloudEvent extension attributes set on events which have been
+	// republished to a retry topic. They are only set by the sidecar: the
+	// retry tier of an event is derived from the topic it was received on, and
+	// the attributes are stripped from events received on the topic of the
+	// subscription.
+	RetryAttemptField       = "daprretryattempt"
+	RetryOriginalTopicField = "daprretrytopic"
+)
+
+// retryTier returns the retry tier of the subscription an event received on
+// the given topic belongs to, starting at 1. Returns 0 if the topic is the
+// topic of the subscription.
+func (s *Subscription) retryTier(topic string) int {
+	for i, tier := range s.route.RetryTopics {
+		if tier.Topic == topic {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// setRetryAttributes replaces the retry attributes of an event with the ones
+// of the retry tier it was received on, so that producers cannot set them.
+// Returns true if the event was changed.
+func setRetryAttributes(cloudEvent map[string]any, tier int, topic string) bool {
+	_, hasAttempt := cloudEvent[RetryAttemptField]
+	_, hasTopic := cloudEvent[RetryOriginalTopicField]
+	delete(cloudEvent, RetryAttemptField)
+	delete(cloudEvent, RetryOriginalTopicField)
+	if tier > 0 {
+		cloudEvent[RetryAttemptField] = tier
+		cloudEvent[RetryOriginalTopicField] = topic
+		return true
+	}
+	return hasAttempt || hasTopic
+}
+
+// setBinaryRetryAttributes replaces the retry attributes of the metadata of a
+// binary mode event with the ones of the reconstructed event.
+func setBinaryRetryAttributes(metadata map[string]string, cloudEvent map[string]any) {
+	for _, field := range []string{RetryAttemptField, RetryOriginalTopicField} {
+		for k := range metadata {
+			if strings.EqualFold(k, BinaryCloudEventHeaderPrefix+field) {
+				delete(metadata, k)
+			}
+		}
+		if v, ok := cloudEvent[field]; ok {
+			metadata[BinaryCloudEventHeaderPrefix+field] = fmt.Sprint(v)
+		}
+	}
+}
+
+// sendToRetryTopic republishes an event which failed on the given retry tier
+// to the next retry tier of the subscription. Events are published to the
+// retry topic once its delay has elapsed, by scheduling the publish with the
+// Scheduler, so that the event is acknowledged right away rather than holding
+// the subscription. Returns false if every retry tier has already been tried,
+// in which case the event should be dead-lettered.
+func (s *Subscription) sendToRetryTopic(ctx context.Context, name string, msg *contribpubsub.NewMessage, cloudEvent map[string]any, tier int) (bool, error) {
+	if tier >= len(s.route.RetryTopics) {
+		return false, nil
+	}
+
+	next := s.route.RetryTopics[tier]
+
+	event := maps.Clone(cloudEvent)
+	setRetryAttributes(event, tier+1, s.topic)
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return true, fmt.Errorf("error serializing event for retry topic %s: %w", next.Topic, err)
+	}
+
+	metadata := maps.Clone(msg.Metadata)
+	delete(metadata, ContentTypeMetadataKey)
+
+	req := &contribpubsub.PublishRequest{
+		Data:        data,
+		PubsubName:  name,
+		Topic:       next.Topic,
+		Metadata:    metadata,
+		ContentType: ptr.Of(contribContenttype.CloudEventContentType),
+	}
+
+	if next.Delay > 0 {
+		err = s.scheduleRetry(ctx, req, cloudEvent, next.Delay)
+	} else {
+		err = s.adapter.Publish(ctx, req)
+	}
+	if err != nil {
+		log.Errorf("error sending message to retry topic, origin topic: %s retry topic %s err: %s", s.topic, next.Topic, err)
+		return true, err
+	}
+
+	log.Debugf("Sent event %v to retry topic %s (attempt %d), origin topic: %s", cloudEvent[contribpubsub.IDField], next.Topic, tier+1, s.topic)
+	return true, nil
+}
+
+// scheduleRetry schedules the publish of an event to a retry topic once the
+// delay of the retry topic has elapsed.
+func (s *Subscription) scheduleRetry(ctx context.Context, req *contribpubsub.PublishRequest, cloudEvent map[string]any, delay time.Duration) error {
+	if s.scheduler == nil {
+		return errors.New("retry topics with a delay require the Scheduler service")
+	}
+
+	// Retry topics are specific to the subscription, so the ID of the job is
+	// unique per event and retry tier. A redelivered event replaces the pending
+	// retry of the same tier.
+	id, _ := cloudEvent[contribpubsub.IDField].(string)
+	if len(id) == 0 {
+		randomID, err := uuid.NewRandom()
+		if err != nil {
+			return err
+		}
+		id = randomID.String()
+	}
+
+	return publisher.Schedule(ctx, s.scheduler, s.appID, s.namespace, req.Topic+"__"+id, req, time.Now().Add(delay), true)
+}
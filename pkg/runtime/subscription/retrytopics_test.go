@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher/fake"
+)
+
+// fakeScheduler records the jobs scheduled through it. Calls to other methods
+// of the Scheduler client panic.
+type fakeScheduler struct {
+	schedulerv1pb.SchedulerClient
+
+	scheduled []*schedulerv1pb.ScheduleJobRequest
+}
+
+func (f *fakeScheduler) Addresses() []string {
+	return nil
+}
+
+func (f *fakeScheduler) ScheduleJob(_ context.Context, req *schedulerv1pb.ScheduleJobRequest, _ ...grpc.CallOption) (*schedulerv1pb.ScheduleJobResponse, error) {
+	f.scheduled = append(f.scheduled, req)
+	return new(schedulerv1pb.ScheduleJobResponse), nil
+}
+
+func TestSendToRetryTopic(t *testing.T) {
+	var published []*contribpubsub.PublishRequest
+	scheduler := new(fakeScheduler)
+	s := &Subscription{
+		appID:     "myapp",
+		namespace: "ns1",
+		topic:     "orders",
+		route: rtpubsub.Subscription{
+			RetryTopics: []rtpubsub.RetryTopic{
+				{Topic: "orders-retry-now"},
+				{Topic: "orders-retry-10m", Delay: time.Minute * 10},
+			},
+		},
+		adapter: fake.New().WithPublishFn(func(_ context.Context, req *contribpubsub.PublishRequest) error {
+			published = append(published, req)
+			return nil
+		}),
+		scheduler: scheduler,
+	}
+
+	msg := &contribpubsub.NewMessage{
+		Topic:    "orders",
+		Metadata: map[string]string{ContentTypeMetadataKey: "application/json", "foo": "bar"},
+	}
+	cloudEvent := map[string]any{
+		contribpubsub.IDField: "1",
+		"data":                "hello",
+	}
+
+	t.Run("retry topic without delay is published to", func(t *testing.T) {
+		sent, err := s.sendToRetryTopic(t.Context(), "mypubsub", msg, cloudEvent, 0)
+		require.NoError(t, err)
+		assert.True(t, sent)
+		require.Len(t, published, 1)
+		assert.Empty(t, scheduler.scheduled)
+		assert.Equal(t, "orders-retry-now", published[0].Topic)
+		assert.Equal(t, map[string]string{"foo": "bar"}, published[0].Metadata)
+
+		var event map[string]any
+		require.NoError(t, json.Unmarshal(published[0].Data, &event))
+		assert.InDelta(t, 1, event[RetryAttemptField], 0)
+		assert.Equal(t, "orders", event[RetryOriginalTopicField])
+		assert.NotContains(t, cloudEvent, RetryAttemptField)
+	})
+
+	t.Run("retry topic with delay is scheduled", func(t *testing.T) {
+		start := time.Now()
+		sent, err := s.sendToRetryTopic(t.Context(), "mypubsub", msg, cloudEvent, 1)
+		require.NoError(t, err)
+		assert.True(t, sent)
+		assert.Len(t, published, 1)
+		require.Len(t, scheduler.scheduled, 1)
+
+		job := scheduler.scheduled[0]
+		name, err := publisher.ScheduledJobName("mypubsub", "orders-retry-10m__1")
+		require.NoError(t, err)
+		assert.Equal(t, name, job.GetName())
+		assert.True(t, job.GetOverwrite())
+		assert.Equal(t, "myapp", job.GetMetadata().GetAppId())
+		assert.Equal(t, "ns1", job.GetMetadata().GetNamespace())
+
+		dueTime, err := time.Parse(time.RFC3339, job.GetJob().GetDueTime())
+		require.NoError(t, err)
+		assert.WithinDuration(t, start.Add(time.Minute*10), dueTime, time.Second*2)
+
+		req, err := publisher.DecodeScheduled(job.GetJob().GetData())
+		require.NoError(t, err)
+		assert.Equal(t, "orders-retry-10m", req.Topic)
+		var event map[string]any
+		require.NoError(t, json.Unmarshal(req.Data, &event))
+		assert.InDelta(t, 2, event[RetryAttemptField], 0)
+	})
+
+	t.Run("retry topics exhausted", func(t *testing.T) {
+		sent, err := s.sendToRetryTopic(t.Context(), "mypubsub", msg, cloudEvent, 2)
+		require.NoError(t, err)
+		assert.False(t, sent)
+		assert.Len(t, published, 1)
+		assert.Len(t, scheduler.scheduled, 1)
+	})
+
+	t.Run("delay requires the Scheduler", func(t *testing.T) {
+		s.scheduler = nil
+		sent, err := s.sendToRetryTopic(t.Context(), "mypubsub", msg, cloudEvent, 1)
+		require.Error(t, err)
+		assert.True(t, sent)
+	})
+}
+
+func TestRetryTier(t *testing.T) {
+	s := &Subscription{
+		topic: "orders",
+		route: rtpubsub.Subscription{
+			RetryTopics: []rtpubsub.RetryTopic{
+				{Topic: "orders-retry-1m", Delay: time.Minute},
+				{Topic: "orders-retry-10m", Delay: time.Minute * 10},
+			},
+		},
+	}
+
+	assert.Equal(t, 0, s.retryTier("orders"))
+	assert.Equal(t, 1, s.retryTier("orders-retry-1m"))
+	assert.Equal(t, 2, s.retryTier("orders-retry-10m"))
+	assert.Equal(t, 0, s.retryTier("other"))
+}
+
+func TestSetRetryAttributes(t *testing.T) {
+	t.Run("forged attributes are stripped", func(t *testing.T) {
+		event := map[string]any{
+			contribpubsub.IDField:   "1",
+			RetryAttemptField:       5,
+			RetryOriginalTopicField: "payments",
+		}
+		assert.True(t, setRetryAttributes(event, 0, "orders"))
+		assert.Equal(t, map[string]any{contribpubsub.IDField: "1"}, event)
+	})
+
+	t.Run("attributes are set from the retry tier", func(t *testing.T) {
+		event := map[string]any{
+			RetryAttemptField:       5,
+			RetryOriginalTopicField: "payments",
+		}
+		assert.True(t, setRetryAttributes(event, 1, "orders"))
+		assert.Equal(t, 1, event[RetryAttemptField])
+		assert.Equal(t, "orders", event[RetryOriginalTopicField])
+	})
+
+	t.Run("event without attributes is unchanged", func(t *testing.T) {
+		event := map[string]any{contribpubsub.IDField: "1"}
+		assert.False(t, setRetryAttributes(event, 0, "orders"))
+	})
+
+	t.Run("binary mode metadata", func(t *testing.T) {
+		metadata := map[string]string{
+			"Ce_DaprRetryAttempt": "5",
+			"ce_id":               "1",
+		}
+		setBinaryRetryAttributes(metadata, map[string]any{RetryAttemptField: 1, RetryOriginalTopicField: "orders"})
+		assert.Equal(t, map[string]string{
+			"ce_id":                         "1",
+			"ce_" + RetryAttemptField:       "1",
+			"ce_" + RetryOriginalTopicField: "orders",
+		}, metadata)
+	})
+}
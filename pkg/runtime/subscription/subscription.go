@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -30,8 +31,10 @@ import (
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman"
 	"github.com/dapr/kit/logger"
 )
@@ -50,6 +53,8 @@ type Options struct {
 	AdapterStreamer rtpubsub.AdapterStreamer
 	ConnectionID    rtpubsub.ConnectionID
 	Postman         postman.Interface
+	CompStore       *compstore.ComponentStore
+	Scheduler       schedclient.Interface
 }
 
 type Subscription struct {
@@ -66,6 +71,7 @@ type Subscription struct {
 
 	adapterStreamer rtpubsub.AdapterStreamer
 	adapter         rtpubsub.Adapter
+	scheduler       schedclient.Interface
 
 	cancel   func(cause error)
 	closed   atomic.Bool
@@ -73,6 +79,8 @@ type Subscription struct {
 	inflight atomic.Int64
 
 	postman postman.Interface
+	orderer *orderer
+	deduper *deduper
 }
 
 var log = logger.NewLogger("dapr.runtime.processor.subscription")
@@ -106,6 +114,7 @@ func New(opts Options) (*Subscription, error) {
 		connectionID:    opts.ConnectionID,
 		adapterStreamer: opts.AdapterStreamer,
 		postman:         opts.Postman,
+		scheduler:       opts.Scheduler,
 	}
 
 	name := s.pubsubName
@@ -116,6 +125,15 @@ func New(opts Options) (*Subscription, error) {
 	namespaced := s.pubsub.NamespaceScoped
 
 	if route.BulkSubscribe != nil && route.BulkSubscribe.Enabled {
+		if route.Ordering != nil {
+			log.Warnf("Ordered delivery is not supported for bulk subscriptions, ignoring ordering for topic %s", s.topic)
+		}
+		if len(route.RetryTopics) > 0 {
+			log.Warnf("Retry topics are not supported for bulk subscriptions, ignoring retry topics for topic %s", s.topic)
+		}
+		if route.Deduplication != nil {
+			log.Warnf("Deduplication is not supported for bulk subscriptions, ignoring deduplication for topic %s", s.topic)
+		}
 		err := s.bulkSubscribeTopic(ctx, policyDef)
 		if err != nil {
 			cancel(nil)
@@ -124,15 +142,30 @@ func New(opts Options) (*Subscription, error) {
 		return s, nil
 	}
 
+	if route.Ordering != nil {
+		s.orderer = newOrderer(route.Ordering)
+	}
+
+	if len(route.RetryTopics) > 0 {
+		if rawPayload, _ := metadata.IsRawPayload(routeMetadata); rawPayload {
+			log.Warnf("Retry topics are not supported for raw payload subscriptions, failed events on topic %s will not be retried", s.topic)
+		}
+	}
+
+	if route.Deduplication != nil {
+		if rawPayload, _ := metadata.IsRawPayload(routeMetadata); rawPayload {
+			log.Warnf("Deduplication is not supported for raw payload subscriptions, events on topic %s will not be deduplicated", s.topic)
+		} else {
+			s.deduper = newDeduper(opts.CompStore, route.Deduplication, s.appID, name, s.topic)
+		}
+	}
+
 	subscribeTopic := s.topic
 	if namespaced {
 		subscribeTopic = s.namespace + s.topic
 	}
 
-	err := s.pubsub.Component.Subscribe(ctx, contribpubsub.SubscribeRequest{
-		Topic:    subscribeTopic,
-		Metadata: routeMetadata,
-	}, func(ctx context.Context, msg *contribpubsub.NewMessage) error {
+	handler := func(ctx context.Context, msg *contribpubsub.NewMessage) error {
 		s.wg.Add(1)
 		s.inflight.Add(1)
 		defer func() {
@@ -255,6 +288,25 @@ func New(opts Options) (*Subscription, error) {
 			}
 		}
 
+		// Events received on a retry topic are delivered as events of the topic
+		// of the subscription.
+		retryTier := s.retryTier(msgTopic)
+		if setRetryAttributes(cloudEvent, retryTier, s.topic) {
+			if rawPayload || !contribContenttype.IsBinaryContentType(contentType) {
+				data, err = json.Marshal(cloudEvent)
+				if err != nil {
+					log.Errorf("error serializing cloud event in pubsub %s and topic %s: %s", name, msgTopic, err)
+					diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+					return err
+				}
+			} else {
+				setBinaryRetryAttributes(msg.Metadata, cloudEvent)
+			}
+		}
+		if retryTier > 0 {
+			msgTopic = s.topic
+		}
+
 		if contribpubsub.HasExpired(cloudEvent) {
 			log.Warnf("dropping expired pub/sub event %v as of %v", cloudEvent[contribpubsub.IDField], cloudEvent[contribpubsub.ExpirationField])
 			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), "", msgTopic, 0)
@@ -265,6 +317,22 @@ func New(opts Options) (*Subscription, error) {
 			return nil
 		}
 
+		// Events which do not match the schema of the topic are not delivered
+		// to the app. They are sent to the dead letter topic if configured, or
+		// else left to the component to redeliver.
+		if err = s.pubsub.Schemas.ValidateCloudEvent(msgTopic, cloudEvent); err != nil {
+			log.Errorf("event %v in pubsub %s and topic %s does not match the topic schema: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, err)
+			if route.DeadLetterTopic != "" {
+				if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic); dlqErr == nil {
+					// dlq has been configured and message is successfully sent to dlq.
+					diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), "", msgTopic, 0)
+					return nil
+				}
+			}
+			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+			return rtpubsub.SchemaValidationError{Topic: msgTopic, Err: err}
+		}
+
 		routePath, shouldProcess, err := findMatchingRoute(route.Rules, cloudEvent)
 		if err != nil {
 			log.Errorf("error finding matching route for event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, err)
@@ -298,6 +366,34 @@ func New(opts Options) (*Subscription, error) {
 			PubSub:       name,
 			SubscriberID: s.connectionID,
 		}
+		// Wait for all previously received messages with the same ordering key
+		// to be delivered before delivering this one.
+		release, err := s.orderer.acquire(ctx, cloudEvent)
+		if err != nil {
+			log.Errorf("error ordering event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, err)
+			if route.DeadLetterTopic != "" {
+				if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic); dlqErr == nil {
+					// dlq has been configured and message is successfully sent to dlq.
+					diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), "", msgTopic, 0)
+					return nil
+				}
+			}
+			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+			return err
+		}
+		defer release()
+
+		// Acknowledge events which have already been processed by the app
+		// without delivering them again.
+		eventID, _ := cloudEvent[contribpubsub.IDField].(string)
+		if duplicate, dErr := s.deduper.processed(ctx, eventID); dErr != nil {
+			log.Warnf("failed to look up processed event %s in pubsub %s and topic %s, delivering event: %s", eventID, name, msgTopic, dErr)
+		} else if duplicate {
+			log.Debugf("Acknowledging duplicate event %s in pubsub %s and topic %s without delivering it", eventID, name, msgTopic)
+			diag.DefaultComponentMonitoring.PubsubIngressDuplicateEvent(ctx, name, msgTopic)
+			return nil
+		}
+
 		policyRunner := resiliency.NewRunner[any](context.Background(), policyDef)
 		_, err = policyRunner(func(ctx context.Context) (any, error) {
 			pErr := s.postman.Deliver(ctx, sm)
@@ -320,8 +416,23 @@ func New(opts Options) (*Subscription, error) {
 			}
 			return nil, pErr
 		})
+		if err == nil {
+			if dErr := s.deduper.record(ctx, eventID); dErr != nil {
+				log.Warnf("failed to record processed event %s in pubsub %s and topic %s: %s", eventID, name, msgTopic, dErr)
+			}
+		}
 		// when runtime shutting down, don't send to DLQ
 		if err != nil && err != context.Canceled {
+			// Republish the msg to the next retry topic, so that it does not block
+			// the subscription while waiting to be retried.
+			if len(route.RetryTopics) > 0 && !rawPayload {
+				sent, rErr := s.sendToRetryTopic(ctx, name, msg, cloudEvent, retryTier)
+				if sent && rErr == nil {
+					diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+					return nil
+				}
+			}
+
 			// Sending msg to dead letter queue.
 			// If no DLQ is configured, return error for backwards compatibility (component-level retry).
 			if route.DeadLetterTopic != "" {
@@ -335,15 +446,43 @@ func New(opts Options) (*Subscription, error) {
 			return err
 		}
 		return err
-	})
+	}
+
+	err := s.pubsub.Component.Subscribe(ctx, contribpubsub.SubscribeRequest{
+		Topic:    subscribeTopic,
+		Metadata: routeMetadata,
+	}, handler)
 	if err != nil {
 		cancel(nil)
 		return nil, fmt.Errorf("failed to subscribe to topic %s: %w", s.topic, err)
 	}
 
+	// Events republished to retry topics are consumed by this same
+	// subscription. They are published to the retry topics once their delay
+	// has elapsed.
+	for _, retryTopic := range route.RetryTopics {
+		topic := retryTopic.Topic
+		if namespaced {
+			topic = s.namespace + topic
+		}
+		err = s.pubsub.Component.Subscribe(ctx, contribpubsub.SubscribeRequest{
+			Topic:    topic,
+			Metadata: routeMetadata,
+		}, handler)
+		if err != nil {
+			cancel(nil)
+			return nil, fmt.Errorf("failed to subscribe to retry topic %s: %w", retryTopic.Topic, err)
+		}
+	}
+
 	return s, nil
 }
 
+// Topic returns the topic of the subscription.
+func (s *Subscription) Topic() string {
+	return s.topic
+}
+
 func (s *Subscription) Stop(err ...error) {
 	s.closed.Store(true)
 	inflight := s.inflight.Load() > 0
@@ -367,11 +506,19 @@ func (s *Subscription) Stop(err ...error) {
 }
 
 func (s *Subscription) sendToDeadLetter(ctx context.Context, name string, msg *contribpubsub.NewMessage, deadLetterTopic string) error {
+	// Record the topic of the subscription, so that the event can be replayed
+	// to it from the dead letter topic.
+	metadata := maps.Clone(msg.Metadata)
+	if metadata == nil {
+		metadata = make(map[string]string, 1)
+	}
+	metadata[rtpubsub.MetadataKeyDeadLetterOriginalTopic] = s.topic
+
 	req := &contribpubsub.PublishRequest{
 		Data:        msg.Data,
 		PubsubName:  name,
 		Topic:       deadLetterTopic,
-		Metadata:    msg.Metadata,
+		Metadata:    metadata,
 		ContentType: msg.ContentType,
 	}
 
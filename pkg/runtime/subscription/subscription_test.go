@@ -14,6 +14,7 @@ limitations under the License.
 package subscription
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 	"testing"
@@ -28,6 +29,8 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher/fake"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman/http"
 )
 
@@ -101,3 +104,101 @@ func TestTracingOnNewPublishedMessage(t *testing.T) {
 		}
 	})
 }
+
+func TestSchemaValidationOnNewPublishedMessage(t *testing.T) {
+	schemas, err := schema.FromMetadata(map[string]string{
+		"jsonSchema.topic0": `{"type":"object","required":["orderId"]}`,
+	})
+	require.NoError(t, err)
+
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	resp := contribpubsub.AppResponse{
+		Status: contribpubsub.Success,
+	}
+	respB, _ := json.Marshal(resp)
+	fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
+		WithRawDataBytes(respB).
+		WithContentType("application/json")
+	defer fakeResp.Close()
+
+	mockAppChannel := new(channelt.MockAppChannel)
+	mockAppChannel.Init()
+	mockAppChannel.On("InvokeMethod", mock.MatchedBy(matchContextInterface), mock.Anything).Return(fakeResp, nil)
+
+	var deadLettered []*contribpubsub.PublishRequest
+	ps, err := New(Options{
+		Resiliency: resiliency.New(log),
+		Postman: http.New(http.Options{
+			Channels: new(channels.Channels).WithAppChannel(mockAppChannel),
+		}),
+		PubSub: &runtimePubsub.PubsubItem{Component: comp, Schemas: schemas},
+		Adapter: fake.New().WithPublishFn(func(_ context.Context, req *contribpubsub.PublishRequest) error {
+			deadLettered = append(deadLettered, req)
+			return nil
+		}),
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "topic0",
+		Route: runtimePubsub.Subscription{
+			Rules: []*runtimePubsub.Rule{
+				{Path: "orders"},
+			},
+			DeadLetterTopic: "topic1",
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { ps.Stop() })
+
+	publish := func(data string) {
+		require.NoError(t, comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: "testpubsub",
+			Topic:      "topic0",
+			Data:       []byte(`{"id":"1","specversion":"1.0","datacontenttype":"application/json","data":` + data + `}`),
+		}))
+	}
+
+	publish(`{"orderId":"1"}`)
+	mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+	assert.Empty(t, deadLettered)
+
+	publish(`{"order":"1"}`)
+	mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+	require.Len(t, deadLettered, 1)
+	assert.Equal(t, "topic1", deadLettered[0].Topic)
+	assert.Equal(t, "topic0", deadLettered[0].Metadata[runtimePubsub.MetadataKeyDeadLetterOriginalTopic])
+
+	t.Run("invalid event is retried without dead letter topic", func(t *testing.T) {
+		comp := &mockSubscribePubSub{}
+		require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+		ps, err := New(Options{
+			Resiliency: resiliency.New(log),
+			Postman: http.New(http.Options{
+				Channels: new(channels.Channels).WithAppChannel(mockAppChannel),
+			}),
+			PubSub:     &runtimePubsub.PubsubItem{Component: comp, Schemas: schemas},
+			Adapter:    fake.New(),
+			AppID:      TestRuntimeConfigID,
+			PubSubName: "testpubsub",
+			Topic:      "topic0",
+			Route: runtimePubsub.Subscription{
+				Rules: []*runtimePubsub.Rule{
+					{Path: "orders"},
+				},
+			},
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { ps.Stop() })
+
+		handler := comp.handlers["topic0"]
+		require.NotNil(t, handler)
+		err = handler(t.Context(), &contribpubsub.NewMessage{
+			Topic: "topic0",
+			Data:  []byte(`{"id":"2","specversion":"1.0","datacontenttype":"application/json","data":{"order":"1"}}`),
+		})
+		require.ErrorAs(t, err, new(runtimePubsub.SchemaValidationError))
+		mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+		assert.Len(t, deadLettered, 1)
+	})
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/spiffe/go-spiffe/v2/spiffegrpc/grpccredentials"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
+	"github.com/spiffe/go-spiffe/v2/svid/jwtsvid"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
@@ -144,6 +146,20 @@ type Options struct {
 
 	// JwtAudiences is the list of JWT audiences to be included in the certificate request.
 	JwtAudiences []string
+
+	// WorkloadAPIAddress is the address of a SPIFFE Workload API, for example
+	// `unix:///run/spire/sockets/agent.sock`. When set, the X.509 SVID and
+	// trust bundles of this workload are fetched and rotated from the Workload
+	// API instead of Sentry. Cannot be used with trust anchors.
+	WorkloadAPIAddress string
+}
+
+// svidSource is a source of the SVIDs of this workload.
+type svidSource interface {
+	Run(context.Context) error
+	Ready(context.Context) error
+	X509SVIDSource() x509svid.Source
+	JWTSVIDSource() jwtsvid.Source
 }
 
 type provider struct {
@@ -159,7 +175,7 @@ type security struct {
 	controlPlaneNamespace   string
 
 	trustAnchors trustanchors.Interface
-	spiffe       *spiffe.SPIFFE
+	spiffe       svidSource
 	id           spiffeid.ID
 	mtls         bool
 
@@ -186,9 +202,27 @@ func New(ctx context.Context, opts Options) (Provider, error) {
 	// Always request certificates from Sentry if mTLS is enabled or running in
 	// Kubernetes. In Kubernetes, Daprd always communicates mTLS with the control
 	// plane.
-	var spf *spiffe.SPIFFE
+	var spf svidSource
 	var trustAnchors trustanchors.Interface
-	if opts.MTLSEnabled || opts.Mode == modes.KubernetesMode {
+	switch {
+	case len(opts.WorkloadAPIAddress) > 0 && (opts.MTLSEnabled || opts.Mode == modes.KubernetesMode):
+		if len(opts.TrustAnchors) > 0 || opts.TrustAnchorsFile != nil || opts.OverrideTrustAnchors != nil {
+			return nil, errors.New("trust anchors cannot be used with the SPIFFE workload API")
+		}
+
+		ns := CurrentNamespace()
+		if opts.OverrideRequestNamespace != nil {
+			ns = *opts.OverrideRequestNamespace
+		}
+		wapi := newWorkloadAPI(workloadAPIOptions{
+			Address:             opts.WorkloadAPIAddress,
+			AppID:               opts.AppID,
+			Namespace:           ns,
+			WriteIdentityToFile: opts.WriteIdentityToFile,
+		})
+		spf, trustAnchors = wapi, wapi
+
+	case opts.MTLSEnabled || opts.Mode == modes.KubernetesMode:
 		trustAnchors = opts.OverrideTrustAnchors
 		if trustAnchors == nil {
 			if len(opts.TrustAnchors) > 0 && opts.TrustAnchorsFile != nil {
@@ -244,7 +278,8 @@ func New(ctx context.Context, opts Options) (Provider, error) {
 			WriteIdentityToFile: opts.WriteIdentityToFile,
 			TrustAnchors:        trustAnchors,
 		})
-	} else {
+
+	default:
 		log.Warn("mTLS is disabled. Skipping certificate request and tls validation")
 	}
 
@@ -279,9 +314,8 @@ func (p *provider) Run(ctx context.Context) error {
 		return nil
 	}
 
-	return concurrency.NewRunnerManager(
+	runners := []concurrency.Runner{
 		p.sec.spiffe.Run,
-		p.sec.trustAnchors.Run,
 		func(ctx context.Context) error {
 			if err := p.sec.spiffe.Ready(ctx); err != nil {
 				return err
@@ -297,7 +331,15 @@ func (p *provider) Run(ctx context.Context) error {
 			<-ctx.Done()
 			return nil
 		},
-	).Run(ctx)
+	}
+
+	// The workload API identity source is also the trust anchors, so only
+	// needs running once.
+	if _, ok := p.sec.spiffe.(*workloadAPI); !ok {
+		runners = append(runners, p.sec.trustAnchors.Run)
+	}
+
+	return concurrency.NewRunnerManager(runners...).Run(ctx)
 }
 
 // Handler returns a ready handler from the security provider. Blocks until
@@ -483,7 +525,8 @@ func (s *security) WithSVIDContext(ctx context.Context) context.Context {
 		return ctx
 	}
 
-	return spiffecontext.WithSpiffe(ctx, s.spiffe)
+	ctx = spiffecontext.WithX509(ctx, s.spiffe.X509SVIDSource())
+	return spiffecontext.WithJWT(ctx, s.spiffe.JWTSVIDSource())
 }
 
 func (s *security) IdentityDir() *string {
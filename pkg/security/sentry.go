@@ -15,6 +15,8 @@ package security
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/pem"
 	"errors"
 	"fmt"
@@ -129,6 +131,10 @@ func newRequestFn(opts Options, trustAnchors trustanchors.Interface, cptd spiffe
 			req.TrustDomain = *trustDomain
 		}
 
+		if anchors, taErr := trustAnchors.CurrentTrustAnchors(ctx); taErr == nil {
+			req.TrustAnchorsVersion = TrustAnchorsVersion(anchors)
+		}
+
 		resp, err := sentryv1pb.NewCAClient(conn).SignCertificate(ctx, req)
 		if err != nil {
 			diagnostics.DefaultMonitoring.MTLSWorkLoadCertRotationFailed("sign")
@@ -178,6 +184,13 @@ func newRequestFn(opts Options, trustAnchors trustanchors.Interface, cptd spiffe
 	return fn, nil
 }
 
+// TrustAnchorsVersion returns the version of the PEM-encoded trust anchors
+// reported to Sentry when requesting a certificate.
+func TrustAnchorsVersion(trustAnchors []byte) string {
+	sum := sha256.Sum256(trustAnchors)
+	return hex.EncodeToString(sum[:])
+}
+
 // isControlPlaneService returns true if the app ID corresponds to a Dapr
 // control plane service.
 func isControlPlaneService(id string) bool {
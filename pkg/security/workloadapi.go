@@ -0,0 +1,392 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/spiffe/go-spiffe/v2/bundle/jwtbundle"
+	"github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/svid/jwtsvid"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
+	"github.com/spiffe/go-spiffe/v2/workloadapi"
+
+	"github.com/dapr/kit/concurrency"
+	"github.com/dapr/kit/concurrency/dir"
+)
+
+var (
+	errWorkloadAPIClosed   = errors.New("workload API identity source is closed")
+	errNoWorkloadAPIBundle = errors.New("no bundle received from the workload API")
+)
+
+type workloadAPIOptions struct {
+	// Address is the address of the Workload API, for example
+	// `unix:///run/spire/sockets/agent.sock`.
+	Address string
+
+	// AppID and Namespace are the expected app ID and namespace of the SVID.
+	// The SVID is rejected if its SPIFFE ID doesn't match
+	// `spiffe://<td>/ns/<namespace>/<app-id>`. Not checked if AppID is empty.
+	AppID     string
+	Namespace string
+
+	// WriteIdentityToFile is the optional directory to write the SVID and trust
+	// bundle to.
+	WriteIdentityToFile *string
+}
+
+// workloadAPI is an identity source which fetches and rotates the X.509 SVID
+// and trust bundles of this workload from a SPIFFE Workload API, rather than
+// requesting them from Sentry. It implements both the SVID source and the
+// trust anchors consumed by the security handler.
+type workloadAPI struct {
+	address   string
+	appID     string
+	namespace string
+	dir       *dir.Dir
+
+	client *workloadapi.Client
+
+	svid        *x509svid.SVID
+	x509Bundles *x509bundle.Set
+	jwtBundles  *jwtbundle.Set
+	rootPEM     []byte
+
+	// subs is a list of channels to notify when the trust bundles are updated.
+	subs []chan struct{}
+
+	lock      sync.RWMutex
+	running   atomic.Bool
+	readyOnce sync.Once
+	readyCh   chan struct{}
+	closeCh   chan struct{}
+}
+
+func newWorkloadAPI(opts workloadAPIOptions) *workloadAPI {
+	var sdir *dir.Dir
+	if opts.WriteIdentityToFile != nil {
+		sdir = dir.New(dir.Options{
+			Log:    log,
+			Target: *opts.WriteIdentityToFile,
+		})
+	}
+
+	return &workloadAPI{
+		address:   opts.Address,
+		appID:     opts.AppID,
+		namespace: opts.Namespace,
+		dir:       sdir,
+		readyCh:   make(chan struct{}),
+		closeCh:   make(chan struct{}),
+	}
+}
+
+// Run connects to the Workload API and watches for SVID and bundle updates
+// until the context is cancelled.
+func (w *workloadAPI) Run(ctx context.Context) error {
+	if !w.running.CompareAndSwap(false, true) {
+		return errors.New("workload API identity source is already running")
+	}
+	defer close(w.closeCh)
+
+	client, err := workloadapi.New(ctx,
+		workloadapi.WithAddr(w.address),
+		workloadapi.WithLogger(log),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create workload API client for %s: %w", w.address, err)
+	}
+	defer client.Close()
+
+	w.lock.Lock()
+	w.client = client
+	w.lock.Unlock()
+
+	log.Infof("Fetching identity from the SPIFFE workload API at %s", w.address)
+
+	return concurrency.NewRunnerManager(
+		func(ctx context.Context) error {
+			return ignoreCanceled(ctx, client.WatchX509Context(ctx, &x509ContextWatcher{w: w, ctx: ctx}))
+		},
+		func(ctx context.Context) error {
+			return ignoreCanceled(ctx, client.WatchJWTBundles(ctx, &jwtBundleWatcher{w: w}))
+		},
+	).Run(ctx)
+}
+
+// Ready blocks until the first SVID has been received or the context is done.
+func (w *workloadAPI) Ready(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-w.closeCh:
+		return errWorkloadAPIClosed
+	case <-w.readyCh:
+		return nil
+	}
+}
+
+func (w *workloadAPI) X509SVIDSource() x509svid.Source {
+	return w
+}
+
+func (w *workloadAPI) JWTSVIDSource() jwtsvid.Source {
+	return w
+}
+
+// GetX509SVID returns the current X.509 SVID of this workload.
+func (w *workloadAPI) GetX509SVID() (*x509svid.SVID, error) {
+	select {
+	case <-w.closeCh:
+		return nil, errWorkloadAPIClosed
+	case <-w.readyCh:
+	}
+
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	return w.svid, nil
+}
+
+// FetchJWTSVID fetches a JWT SVID for the requested audience from the Workload
+// API.
+func (w *workloadAPI) FetchJWTSVID(ctx context.Context, params jwtsvid.Params) (*jwtsvid.SVID, error) {
+	if err := w.Ready(ctx); err != nil {
+		return nil, err
+	}
+
+	w.lock.RLock()
+	client, id := w.client, w.svid.ID
+	w.lock.RUnlock()
+
+	if params.Subject.IsZero() {
+		params.Subject = id
+	}
+	return client.FetchJWTSVID(ctx, params)
+}
+
+// GetX509BundleForTrustDomain returns the X.509 bundle of the given trust
+// domain. Dapr trust anchors aren't scoped to a trust domain, so if the
+// Workload API doesn't provide a bundle for it, the bundle of the trust domain
+// of this workload is returned instead. Bundles of other, federated, trust
+// domains are never used for it.
+func (w *workloadAPI) GetX509BundleForTrustDomain(td spiffeid.TrustDomain) (*x509bundle.Bundle, error) {
+	select {
+	case <-w.closeCh:
+		return nil, errWorkloadAPIClosed
+	case <-w.readyCh:
+	}
+
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+
+	if bundle, ok := w.x509Bundles.Get(td); ok {
+		return bundle, nil
+	}
+
+	local, ok := w.x509Bundles.Get(w.svid.ID.TrustDomain())
+	if !ok {
+		return nil, fmt.Errorf("%w for trust domain %q", errNoWorkloadAPIBundle, td)
+	}
+	return x509bundle.FromX509Authorities(td, local.X509Authorities()), nil
+}
+
+// GetJWTBundleForTrustDomain returns the JWT bundle of the given trust domain.
+func (w *workloadAPI) GetJWTBundleForTrustDomain(td spiffeid.TrustDomain) (*jwtbundle.Bundle, error) {
+	select {
+	case <-w.closeCh:
+		return nil, errWorkloadAPIClosed
+	case <-w.readyCh:
+	}
+
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+
+	if w.jwtBundles == nil {
+		return nil, errNoWorkloadAPIBundle
+	}
+	bundle, ok := w.jwtBundles.Get(td)
+	if !ok {
+		return nil, fmt.Errorf("%w for trust domain %q", errNoWorkloadAPIBundle, td)
+	}
+	return bundle, nil
+}
+
+// CurrentTrustAnchors returns the PEM encoded X.509 authorities of all the
+// trust bundles received from the Workload API.
+func (w *workloadAPI) CurrentTrustAnchors(ctx context.Context) ([]byte, error) {
+	if err := w.Ready(ctx); err != nil {
+		return nil, err
+	}
+
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	rootPEM := make([]byte, len(w.rootPEM))
+	copy(rootPEM, w.rootPEM)
+	return rootPEM, nil
+}
+
+// Watch sends the PEM encoded trust anchors to ch every time the trust bundles
+// change. Returns when the given context is canceled.
+func (w *workloadAPI) Watch(ctx context.Context, ch chan<- []byte) {
+	w.lock.Lock()
+	sub := make(chan struct{}, 5)
+	w.subs = append(w.subs, sub)
+	w.lock.Unlock()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.closeCh:
+			return
+		case <-sub:
+			w.lock.RLock()
+			rootPEM := make([]byte, len(w.rootPEM))
+			copy(rootPEM, w.rootPEM)
+			w.lock.RUnlock()
+
+			select {
+			case ch <- rootPEM:
+			case <-ctx.Done():
+			case <-w.closeCh:
+			}
+		}
+	}
+}
+
+func (w *workloadAPI) updateX509Context(ctx context.Context, x509Context *workloadapi.X509Context) error {
+	svid := x509Context.DefaultSVID()
+	if svid == nil {
+		return errors.New("no X.509 SVID received")
+	}
+	if err := w.validateID(svid.ID); err != nil {
+		return err
+	}
+
+	// The trust anchors are the bundle of the trust domain of this workload
+	// only: bundles of federated trust domains only verify their own SVIDs.
+	var rootPEM []byte
+	if bundle, ok := x509Context.Bundles.Get(svid.ID.TrustDomain()); ok {
+		for _, cert := range bundle.X509Authorities() {
+			rootPEM = append(rootPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+		}
+	}
+	if len(rootPEM) == 0 {
+		return fmt.Errorf("no X.509 trust bundle received for trust domain %q", svid.ID.TrustDomain())
+	}
+
+	if w.dir != nil {
+		if err := w.writeIdentity(svid, rootPEM); err != nil {
+			return err
+		}
+	}
+
+	w.lock.Lock()
+	bundleChanged := string(w.rootPEM) != string(rootPEM)
+	w.svid = svid
+	w.x509Bundles = x509Context.Bundles
+	w.rootPEM = rootPEM
+	subs := w.subs
+	w.lock.Unlock()
+
+	log.Infof("Received X.509 SVID %s from the workload API; cert expires on: %s", svid.ID, svid.Certificates[0].NotAfter)
+	w.readyOnce.Do(func() { close(w.readyCh) })
+
+	if bundleChanged {
+		for _, sub := range subs {
+			select {
+			case sub <- struct{}{}:
+			case <-ctx.Done():
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateID checks the SVID's SPIFFE ID is the Dapr identity of this
+// workload, so that the SPIFFE ID based access checks of peers apply to it.
+func (w *workloadAPI) validateID(id spiffeid.ID) error {
+	if len(w.appID) == 0 {
+		return nil
+	}
+
+	exp, err := spiffeid.FromSegments(id.TrustDomain(), "ns", w.namespace, w.appID)
+	if err != nil {
+		return err
+	}
+	if id != exp {
+		return fmt.Errorf("unexpected SPIFFE ID %q: expected %q", id, exp)
+	}
+	return nil
+}
+
+func (w *workloadAPI) writeIdentity(svid *x509svid.SVID, rootPEM []byte) error {
+	certPEM, keyPEM, err := svid.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to encode SVID: %w", err)
+	}
+
+	return w.dir.Write(map[string][]byte{
+		"key.pem":  keyPEM,
+		"cert.pem": certPEM,
+		"ca.pem":   rootPEM,
+	})
+}
+
+type x509ContextWatcher struct {
+	w   *workloadAPI
+	ctx context.Context
+}
+
+func (x *x509ContextWatcher) OnX509ContextUpdate(x509Context *workloadapi.X509Context) {
+	if err := x.w.updateX509Context(x.ctx, x509Context); err != nil {
+		log.Errorf("Ignoring X.509 SVID update from the workload API: %s", err)
+	}
+}
+
+func (x *x509ContextWatcher) OnX509ContextWatchError(err error) {
+	log.Errorf("Error watching X.509 SVID from the workload API: %s", err)
+}
+
+type jwtBundleWatcher struct {
+	w *workloadAPI
+}
+
+func (j *jwtBundleWatcher) OnJWTBundlesUpdate(set *jwtbundle.Set) {
+	j.w.lock.Lock()
+	defer j.w.lock.Unlock()
+	j.w.jwtBundles = set
+	log.Debug("Received JWT bundles from the workload API")
+}
+
+func (j *jwtBundleWatcher) OnJWTBundlesWatchError(err error) {
+	log.Errorf("Error watching JWT bundles from the workload API: %s", err)
+}
+
+// ignoreCanceled returns nil if the error is the result of the context being
+// cancelled.
+func ignoreCanceled(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
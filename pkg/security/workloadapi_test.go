@@ -0,0 +1,265 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
+	"github.com/spiffe/go-spiffe/v2/proto/spiffe/workload"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/kit/ptr"
+)
+
+// fakeWorkloadAPI is a stand-in SPIFFE Workload API server which streams the
+// X.509 SVID responses sent on its channel.
+type fakeWorkloadAPI struct {
+	workload.UnimplementedSpiffeWorkloadAPIServer
+	x509Ch chan *workload.X509SVIDResponse
+}
+
+func (f *fakeWorkloadAPI) FetchX509SVID(_ *workload.X509SVIDRequest, stream workload.SpiffeWorkloadAPI_FetchX509SVIDServer) error {
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case resp := <-f.x509Ch:
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (f *fakeWorkloadAPI) FetchJWTBundles(_ *workload.JWTBundlesRequest, stream workload.SpiffeWorkloadAPI_FetchJWTBundlesServer) error {
+	<-stream.Context().Done()
+	return nil
+}
+
+// genWorkloadAPIResponse generates a new root CA and an X.509 SVID for the
+// given SPIFFE ID signed by it.
+func genWorkloadAPIResponse(t *testing.T, id spiffeid.ID) (*workload.X509SVIDResponse, []byte) {
+	t.Helper()
+
+	rootPK, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rootTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		URIs:                  []*url.URL{id.TrustDomain().ID().URL()},
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTmpl, rootTmpl, &rootPK.PublicKey, rootPK)
+	require.NoError(t, err)
+	root, err := x509.ParseCertificate(rootDER)
+	require.NoError(t, err)
+
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		URIs:         []*url.URL{id.URL()},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, tmpl, root, &pk.PublicKey, rootPK)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(pk)
+	require.NoError(t, err)
+
+	return &workload.X509SVIDResponse{
+			Svids: []*workload.X509SVID{{
+				SpiffeId:    id.String(),
+				X509Svid:    certDER,
+				X509SvidKey: keyDER,
+				Bundle:      rootDER,
+			}},
+		},
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootDER})
+}
+
+func TestWorkloadAPI(t *testing.T) {
+	sock := filepath.Join(t.TempDir(), "wapi.sock")
+	lis, err := net.Listen("unix", sock)
+	require.NoError(t, err)
+
+	fake := &fakeWorkloadAPI{x509Ch: make(chan *workload.X509SVIDResponse, 1)}
+	srv := grpc.NewServer()
+	workload.RegisterSpiffeWorkloadAPIServer(srv, fake)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	td := spiffeid.RequireTrustDomainFromString("example.org")
+	id := spiffeid.RequireFromSegments(td, "ns", "foo", "bar")
+
+	resp1, root1 := genWorkloadAPIResponse(t, id)
+	fake.x509Ch <- resp1
+
+	p, err := New(t.Context(), Options{
+		WorkloadAPIAddress:       "unix://" + sock,
+		AppID:                    "bar",
+		OverrideRequestNamespace: ptr.Of("foo"),
+		ControlPlaneTrustDomain:  "localhost",
+		ControlPlaneNamespace:    "default",
+		MTLSEnabled:              true,
+		Healthz:                  healthz.New(),
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	providerStopped := make(chan struct{})
+	go func() {
+		defer close(providerStopped)
+		assert.NoError(t, p.Run(ctx))
+	}()
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case <-providerStopped:
+		case <-time.After(5 * time.Second):
+			t.Error("provider did not stop in time")
+		}
+	})
+
+	sec, err := p.Handler(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, id, sec.ID())
+
+	anchors, err := sec.CurrentTrustAnchors(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root1, anchors)
+
+	bundle, err := p.(*provider).sec.trustAnchors.GetX509BundleForTrustDomain(td)
+	require.NoError(t, err)
+	assert.Len(t, bundle.X509Authorities(), 1)
+
+	caBundleCh := make(chan []byte, 2)
+	go sec.WatchTrustAnchors(ctx, caBundleCh)
+
+	t.Run("rotated SVID and trust bundle are picked up", func(t *testing.T) {
+		resp2, root2 := genWorkloadAPIResponse(t, id)
+		// Wait for the watcher to subscribe before rotating.
+		assert.Eventually(t, func() bool {
+			wapi := p.(*provider).sec.spiffe.(*workloadAPI)
+			wapi.lock.RLock()
+			defer wapi.lock.RUnlock()
+			return len(wapi.subs) == 1
+		}, time.Second, 10*time.Millisecond)
+		fake.x509Ch <- resp2
+
+		select {
+		case anchors := <-caBundleCh:
+			assert.Equal(t, root2, anchors)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "trust bundle update not received")
+		}
+
+		svid, err := p.(*provider).sec.spiffe.X509SVIDSource().GetX509SVID()
+		require.NoError(t, err)
+		assert.Equal(t, resp2.GetSvids()[0].GetX509Svid(), svid.Certificates[0].Raw)
+	})
+
+	t.Run("SVID for another identity is ignored", func(t *testing.T) {
+		before, err := p.(*provider).sec.spiffe.X509SVIDSource().GetX509SVID()
+		require.NoError(t, err)
+
+		other, _ := genWorkloadAPIResponse(t, spiffeid.RequireFromSegments(td, "ns", "foo", "other"))
+		fake.x509Ch <- other
+
+		assert.Never(t, func() bool {
+			svid, err := p.(*provider).sec.spiffe.X509SVIDSource().GetX509SVID()
+			return err != nil || svid != before
+		}, 500*time.Millisecond, 10*time.Millisecond)
+	})
+}
+
+func TestWorkloadAPIGetX509BundleForTrustDomain(t *testing.T) {
+	localTD := spiffeid.RequireTrustDomainFromString("example.org")
+	federatedTD := spiffeid.RequireTrustDomainFromString("federated.org")
+
+	genRoot := func(t *testing.T, td spiffeid.TrustDomain) *x509.Certificate {
+		t.Helper()
+		resp, _ := genWorkloadAPIResponse(t, spiffeid.RequireFromSegments(td, "app"))
+		root, err := x509.ParseCertificate(resp.GetSvids()[0].GetBundle())
+		require.NoError(t, err)
+		return root
+	}
+	localRoot, federatedRoot := genRoot(t, localTD), genRoot(t, federatedTD)
+
+	readyCh := make(chan struct{})
+	close(readyCh)
+	w := &workloadAPI{
+		svid: &x509svid.SVID{ID: spiffeid.RequireFromSegments(localTD, "ns", "foo", "bar")},
+		x509Bundles: x509bundle.NewSet(
+			x509bundle.FromX509Authorities(localTD, []*x509.Certificate{localRoot}),
+			x509bundle.FromX509Authorities(federatedTD, []*x509.Certificate{federatedRoot}),
+		),
+		readyCh: readyCh,
+		closeCh: make(chan struct{}),
+	}
+
+	t.Run("bundle of the trust domain is returned", func(t *testing.T) {
+		bundle, err := w.GetX509BundleForTrustDomain(federatedTD)
+		require.NoError(t, err)
+		assert.Equal(t, []*x509.Certificate{federatedRoot}, bundle.X509Authorities())
+	})
+
+	t.Run("bundle of the local trust domain is returned for an unknown trust domain", func(t *testing.T) {
+		otherTD := spiffeid.RequireTrustDomainFromString("other.org")
+		bundle, err := w.GetX509BundleForTrustDomain(otherTD)
+		require.NoError(t, err)
+		assert.Equal(t, otherTD, bundle.TrustDomain())
+		assert.Equal(t, []*x509.Certificate{localRoot}, bundle.X509Authorities())
+	})
+
+	t.Run("no bundle is returned without a bundle of the local trust domain", func(t *testing.T) {
+		w.x509Bundles = x509bundle.NewSet(x509bundle.FromX509Authorities(federatedTD, []*x509.Certificate{federatedRoot}))
+		_, err := w.GetX509BundleForTrustDomain(spiffeid.RequireTrustDomainFromString("other.org"))
+		require.ErrorIs(t, err, errNoWorkloadAPIBundle)
+	})
+}
+
+func TestNew_WorkloadAPIAndTrustAnchors(t *testing.T) {
+	_, err := New(t.Context(), Options{
+		WorkloadAPIAddress:      "unix:///tmp/wapi.sock",
+		TrustAnchors:            []byte("anchors"),
+		AppID:                   "bar",
+		ControlPlaneTrustDomain: "localhost",
+		MTLSEnabled:             true,
+		Healthz:                 healthz.New(),
+	})
+	require.ErrorContains(t, err, "trust anchors cannot be used with the SPIFFE workload API")
+}
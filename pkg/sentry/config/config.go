@@ -40,6 +40,10 @@ const (
 	defaultAllowedClockSkew     = time.Minute * 15
 	defaultTrustDomain          = "cluster.local"
 
+	// DefaultTrustAnchorPropagationPeriod is the default time new trust anchors
+	// are published before Sentry issues from the new issuer.
+	DefaultTrustAnchorPropagationPeriod = time.Hour * 24
+
 	// defaultDaprSystemConfigName is the default resource object name for Dapr System Config.
 	defaultDaprSystemConfigName = "daprsystem"
 
@@ -76,10 +80,18 @@ type Config struct {
 	IssuerCertPath   string
 	IssuerKeyPath    string
 	JWT              ConfigJWT
-	Mode             modes.DaprMode
-	Validators       map[sentryv1pb.SignCertificateRequest_TokenValidator]map[string]string
-	DefaultValidator sentryv1pb.SignCertificateRequest_TokenValidator
-	Features         []daprGlobalConfig.FeatureSpec
+	ExternalSigner   *ConfigExternalSigner
+	DenyList         ConfigDenyList
+	Audit            ConfigAudit
+	// TrustAnchorPropagationPeriod is the minimum time a trust anchor rotation
+	// publishes the new root alongside the old one before issuing from the new
+	// issuer. The new issuer doesn't issue either before every client has been
+	// observed using the new root.
+	TrustAnchorPropagationPeriod time.Duration
+	Mode                         modes.DaprMode
+	Validators                   map[sentryv1pb.SignCertificateRequest_TokenValidator]map[string]string
+	DefaultValidator             sentryv1pb.SignCertificateRequest_TokenValidator
+	Features                     []daprGlobalConfig.FeatureSpec
 }
 
 type ConfigJWT struct {
@@ -92,6 +104,30 @@ type ConfigJWT struct {
 	TTL              time.Duration
 }
 
+// ConfigDenyList is the list of SPIFFE IDs and namespaces Sentry refuses to
+// sign certificates for.
+type ConfigDenyList struct {
+	SPIFFEIDs  []string
+	Namespaces []string
+}
+
+// ConfigAudit configures the sinks of the certificate audit records.
+type ConfigAudit struct {
+	FilePath     string
+	OTLPEndpoint string
+	OTLPInsecure bool
+}
+
+// ConfigExternalSigner configures an external signing service which holds
+// the issuer key, instead of the issuer key file.
+type ConfigExternalSigner struct {
+	Address     string
+	KeyID       string
+	TLSCAPath   string
+	TLSCertPath string
+	TLSKeyPath  string
+}
+
 // FromConfigName returns a Sentry configuration based on a configuration spec.
 // A default configuration is loaded in case of an error.
 func FromConfigName(configName, mode string) (conf Config, err error) {
@@ -129,6 +165,8 @@ func getDefaultConfig() Config {
 		AllowedClockSkew: defaultAllowedClockSkew,
 		TrustDomain:      defaultTrustDomain,
 		JWT:              ConfigJWT{},
+
+		TrustAnchorPropagationPeriod: DefaultTrustAnchorPropagationPeriod,
 	}
 }
 
@@ -214,6 +252,13 @@ func parseConfiguration(conf Config, daprConfig *daprGlobalConfig.Configuration)
 		conf.TrustDomain = daprConfig.Spec.MTLSSpec.ControlPlaneTrustDomain
 	}
 
+	if mtlsSpec != nil && mtlsSpec.DenyList != nil {
+		conf.DenyList = ConfigDenyList{
+			SPIFFEIDs:  mtlsSpec.DenyList.SPIFFEIDs,
+			Namespaces: mtlsSpec.DenyList.Namespaces,
+		}
+	}
+
 	daprConfig.SetDefaultFeatures()
 	conf.Features = daprConfig.Spec.Features
 
@@ -51,8 +51,18 @@ var (
 		"The unix timestamp, in seconds, when issuer/root cert will expire.",
 		stats.UnitDimensionless)
 
+	trustAnchorRotationPhase = stats.Int64(
+		"sentry/trustanchor/rotation_phase",
+		"The phase of the trust anchor rotation in progress: 0 when none, 1 when publishing the new root, 2 when issuing from the new issuer.",
+		stats.UnitDimensionless)
+	trustAnchorRotationPhaseChangedTotal = stats.Int64(
+		"sentry/trustanchor/rotation_phase_changed_total",
+		"The number of trust anchor rotation phase changes.",
+		stats.UnitDimensionless)
+
 	// Metrics Tags.
 	failedReasonKey = tag.MustNewKey("reason")
+	phaseKey        = tag.MustNewKey("phase")
 	noKeys          = []tag.Key{}
 )
 
@@ -89,6 +99,19 @@ func IssuerCertChanged() {
 	stats.Record(context.Background(), issuerCertChangedTotal.M(1))
 }
 
+// TrustAnchorRotationPhase records the phase of the trust anchor rotation.
+func TrustAnchorRotationPhase(phase int64) {
+	stats.Record(context.Background(), trustAnchorRotationPhase.M(phase))
+}
+
+// TrustAnchorRotationPhaseChanged counts trust anchor rotation phase changes.
+func TrustAnchorRotationPhaseChanged(phase string) {
+	stats.RecordWithTags(
+		context.Background(),
+		diagUtils.WithTags(trustAnchorRotationPhaseChangedTotal.Name(), phaseKey, phase),
+		trustAnchorRotationPhaseChangedTotal.M(1))
+}
+
 // InitMetrics initializes metrics.
 func InitMetrics() error {
 	return view.Register(
@@ -98,5 +121,7 @@ func InitMetrics() error {
 		diagUtils.NewMeasureView(serverTLSCertIssueFailedTotal, []tag.Key{failedReasonKey}, view.Count()),
 		diagUtils.NewMeasureView(issuerCertChangedTotal, noKeys, view.Count()),
 		diagUtils.NewMeasureView(issuerCertExpiryTimestamp, noKeys, view.LastValue()),
+		diagUtils.NewMeasureView(trustAnchorRotationPhase, noKeys, view.LastValue()),
+		diagUtils.NewMeasureView(trustAnchorRotationPhaseChangedTotal, []tag.Key{phaseKey}, view.Count()),
 	)
 }
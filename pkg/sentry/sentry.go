@@ -31,7 +31,9 @@ import (
 	"github.com/dapr/dapr/pkg/sentry/config"
 	"github.com/dapr/dapr/pkg/sentry/monitoring"
 	"github.com/dapr/dapr/pkg/sentry/server"
+	"github.com/dapr/dapr/pkg/sentry/server/audit"
 	"github.com/dapr/dapr/pkg/sentry/server/ca"
+	"github.com/dapr/dapr/pkg/sentry/server/denylist"
 	"github.com/dapr/dapr/pkg/sentry/server/oidc"
 	"github.com/dapr/dapr/pkg/sentry/server/validator"
 	validatorInsecure "github.com/dapr/dapr/pkg/sentry/server/validator/insecure"
@@ -49,6 +51,10 @@ type Options struct {
 	Config  config.Config
 	Healthz healthz.Healthz
 	OIDC    OIDCOptions
+
+	// LoadConfig reloads the configuration, to apply changes to the deny-list
+	// without restarting. Optional.
+	LoadConfig func() (config.Config, error)
 }
 
 type OIDCOptions struct {
@@ -103,11 +109,12 @@ func New(ctx context.Context, opts Options) (CertificateAuthority, error) {
 				return nil, csrErr
 			}
 			certs, csrErr := camngr.SignIdentity(ctx, &ca.SignRequest{
-				PublicKey:          csr.PublicKey.(crypto.PublicKey),
-				SignatureAlgorithm: csr.SignatureAlgorithm,
-				TrustDomain:        opts.Config.TrustDomain,
-				Namespace:          ns,
-				AppID:              "dapr-sentry",
+				PublicKey:           csr.PublicKey.(crypto.PublicKey),
+				SignatureAlgorithm:  csr.SignatureAlgorithm,
+				TrustDomain:         opts.Config.TrustDomain,
+				Namespace:           ns,
+				AppID:               "dapr-sentry",
+				TrustAnchorsVersion: security.TrustAnchorsVersion(camngr.TrustAnchors()),
 			})
 			if csrErr != nil {
 				monitoring.ServerCertIssueFailed("ca_error")
@@ -122,9 +129,33 @@ func New(ctx context.Context, opts Options) (CertificateAuthority, error) {
 		return nil, fmt.Errorf("error creating security: %s", err)
 	}
 
+	auditor, err := audit.New(audit.Options{
+		FilePath:     opts.Config.Audit.FilePath,
+		OTLPEndpoint: opts.Config.Audit.OTLPEndpoint,
+		OTLPInsecure: opts.Config.Audit.OTLPInsecure,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating auditor: %w", err)
+	}
+
+	var loadDenyList func() (config.ConfigDenyList, error)
+	if opts.LoadConfig != nil {
+		loadDenyList = func() (config.ConfigDenyList, error) {
+			conf, lerr := opts.LoadConfig()
+			return conf.DenyList, lerr
+		}
+	}
+	denyList := denylist.New(denylist.Options{
+		DenyList: opts.Config.DenyList,
+		Load:     loadDenyList,
+	})
+
 	// Start all background processes
 	runners := concurrency.NewRunnerManager(
 		sec.Run,
+		camngr.RunRotation,
+		auditor.Run,
+		denyList.Run,
 		server.New(server.Options{
 			Port:             opts.Config.Port,
 			Security:         sec,
@@ -135,6 +166,8 @@ func New(ctx context.Context, opts Options) (CertificateAuthority, error) {
 			ListenAddress:    opts.Config.ListenAddress,
 			JWTEnabled:       opts.Config.JWT.Enabled,
 			JWTTTL:           opts.Config.JWT.TTL,
+			DenyList:         denyList,
+			Auditor:          auditor,
 		}).Start,
 	)
 
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records every certificate issued, or denied, by Sentry to
+// sinks: a durable file of JSON lines, or OpenTelemetry logs exported over
+// OTLP on a best-effort basis.
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.sentry.audit")
+
+// Outcome is the outcome of a certificate request.
+type Outcome string
+
+const (
+	// OutcomeIssued is the outcome of a certificate request which was signed.
+	OutcomeIssued Outcome = "issued"
+	// OutcomeDenied is the outcome of a certificate request which was refused
+	// because of the deny-list.
+	OutcomeDenied Outcome = "denied"
+)
+
+// Record is the audit record of a certificate request.
+type Record struct {
+	// Time the request was processed.
+	Time time.Time `json:"time"`
+	// Outcome of the request.
+	Outcome Outcome `json:"outcome"`
+	// Requester is the network address of the client.
+	Requester string `json:"requester,omitempty"`
+	// SPIFFEID is the SPIFFE ID requested.
+	SPIFFEID string `json:"spiffeId"`
+	// Namespace and AppID are the namespace and app ID requested.
+	Namespace string `json:"namespace"`
+	AppID     string `json:"appId"`
+	// Validator is the validator which authenticated the request.
+	Validator string `json:"validator"`
+	// Serial is the hex encoded serial number of the certificate issued.
+	Serial string `json:"serial,omitempty"`
+	// Expiry is the time the certificate issued expires.
+	Expiry time.Time `json:"expiry,omitzero"`
+	// Reason is the reason the request was denied.
+	Reason string `json:"reason,omitempty"`
+}
+
+// sink writes audit records. Sinks may be written to concurrently.
+type sink interface {
+	write(ctx context.Context, record *Record) error
+	close() error
+}
+
+// Options are the options of the auditor.
+type Options struct {
+	// FilePath is the path of the file audit records are appended to, as JSON
+	// lines. Disabled if empty.
+	FilePath string
+
+	// OTLPEndpoint is the address of the OTLP gRPC endpoint audit records are
+	// exported to, as OpenTelemetry logs. Records are exported in background
+	// and dropped if they can't be exported, so the endpoint never blocks
+	// certificate issuance. Disabled if empty.
+	OTLPEndpoint string
+
+	// OTLPInsecure disables TLS to the OTLP endpoint.
+	OTLPInsecure bool
+}
+
+// Auditor writes the audit records of certificate requests to the configured
+// sinks.
+type Auditor struct {
+	sinks  []sink
+	lock   sync.RWMutex
+	closed bool
+}
+
+// New returns an auditor writing to the sinks configured in opts. The
+// auditor discards records if no sink is configured.
+func New(opts Options) (*Auditor, error) {
+	a := new(Auditor)
+
+	if opts.FilePath != "" {
+		s, err := newFileSink(opts.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		a.sinks = append(a.sinks, s)
+		log.Infof("Writing certificate audit records to %s", opts.FilePath)
+	}
+
+	if opts.OTLPEndpoint != "" {
+		s, err := newOTLPSink(opts.OTLPEndpoint, opts.OTLPInsecure)
+		if err != nil {
+			return nil, errors.Join(err, a.close())
+		}
+		a.sinks = append(a.sinks, s)
+		log.Infof("Exporting certificate audit records to OTLP endpoint %s", opts.OTLPEndpoint)
+	}
+
+	return a, nil
+}
+
+// Audit writes the audit record of a certificate request to every sink.
+// Records of concurrent requests are written concurrently. Returns an error if
+// any sink failed to write the record, in which case the certificate must not
+// be issued. Only the file sink fails: the OTLP sink queues the record for
+// export.
+func (a *Auditor) Audit(ctx context.Context, record *Record) error {
+	if len(a.sinks) == 0 {
+		return nil
+	}
+
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	if a.closed {
+		return errors.New("auditor is closed")
+	}
+
+	errs := make([]error, 0, len(a.sinks))
+	for _, s := range a.sinks {
+		if err := s.write(ctx, record); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("failed to write audit record of %s certificate for %s: %w", record.Outcome, record.SPIFFEID, err)
+	}
+	return nil
+}
+
+// Run blocks until the context is cancelled, then closes the sinks once the
+// records being written are written. Records queued for export to the OTLP
+// endpoint are exported, for up to the export timeout.
+func (a *Auditor) Run(ctx context.Context) error {
+	<-ctx.Done()
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.closed = true
+	return a.close()
+}
+
+func (a *Auditor) close() error {
+	errs := make([]error, 0, len(a.sinks))
+	for _, s := range a.sinks {
+		errs = append(errs, s.close())
+	}
+	return errors.Join(errs...)
+}
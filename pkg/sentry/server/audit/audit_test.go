@@ -0,0 +1,217 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/grpc"
+)
+
+func testRecords() []*Record {
+	now := time.Now().UTC().Truncate(time.Second)
+	return []*Record{
+		{
+			Time:      now,
+			Outcome:   OutcomeIssued,
+			Requester: "10.0.0.1:1234",
+			SPIFFEID:  "spiffe://example.com/ns/default/app",
+			Namespace: "default",
+			AppID:     "app",
+			Validator: "kubernetes",
+			Serial:    "abcdef",
+			Expiry:    now.Add(time.Hour),
+		},
+		{
+			Time:      now,
+			Outcome:   OutcomeDenied,
+			SPIFFEID:  "spiffe://example.com/ns/compromised/app",
+			Namespace: "compromised",
+			AppID:     "app",
+			Validator: "kubernetes",
+			Reason:    `namespace "compromised" is denied`,
+		},
+	}
+}
+
+// runAuditor runs the auditor until the returned function is called, which
+// waits for the sinks to be closed.
+func runAuditor(t *testing.T, a *Auditor) func() {
+	t.Helper()
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error)
+	go func() { errCh <- a.Run(ctx) }()
+	return func() {
+		cancel()
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the auditor to stop")
+		}
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	require.NoError(t, os.WriteFile(path, []byte(`{"existing":"record"}`+"\n"), 0o600))
+
+	a, err := New(Options{FilePath: path})
+	require.NoError(t, err)
+	stop := runAuditor(t, a)
+
+	records := testRecords()
+	for _, record := range records {
+		require.NoError(t, a.Audit(t.Context(), record))
+	}
+	stop()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	require.True(t, scanner.Scan())
+	assert.JSONEq(t, `{"existing":"record"}`, scanner.Text())
+
+	for _, exp := range records {
+		require.True(t, scanner.Scan())
+		var got Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &got))
+		assert.Equal(t, *exp, got)
+	}
+	assert.False(t, scanner.Scan())
+}
+
+type fakeLogsServer struct {
+	collogspb.UnimplementedLogsServiceServer
+
+	lock    sync.Mutex
+	records []*logspb.LogRecord
+}
+
+func (f *fakeLogsServer) Export(_ context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for _, rl := range req.GetResourceLogs() {
+		for _, sl := range rl.GetScopeLogs() {
+			f.records = append(f.records, sl.GetLogRecords()...)
+		}
+	}
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func TestOTLPSink(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	fake := new(fakeLogsServer)
+	srv := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(srv, fake)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	a, err := New(Options{OTLPEndpoint: lis.Addr().String(), OTLPInsecure: true})
+	require.NoError(t, err)
+	stop := runAuditor(t, a)
+
+	for _, record := range testRecords() {
+		require.NoError(t, a.Audit(t.Context(), record))
+	}
+	stop()
+
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+	require.Len(t, fake.records, 2)
+
+	attrs := func(r *logspb.LogRecord) map[string]string {
+		m := make(map[string]string)
+		for _, kv := range r.GetAttributes() {
+			m[kv.GetKey()] = kv.GetValue().GetStringValue()
+		}
+		return m
+	}
+
+	assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_INFO, fake.records[0].GetSeverityNumber())
+	assert.Equal(t, "certificate issued", fake.records[0].GetBody().GetStringValue())
+	assert.Equal(t, "spiffe://example.com/ns/default/app", attrs(fake.records[0])["spiffe_id"])
+	assert.Equal(t, "abcdef", attrs(fake.records[0])["serial"])
+	assert.Equal(t, "10.0.0.1:1234", attrs(fake.records[0])["requester"])
+
+	assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_WARN, fake.records[1].GetSeverityNumber())
+	assert.Equal(t, "denied", attrs(fake.records[1])["outcome"])
+	assert.Equal(t, `namespace "compromised" is denied`, attrs(fake.records[1])["reason"])
+}
+
+func TestNoSinks(t *testing.T) {
+	a, err := New(Options{})
+	require.NoError(t, err)
+	stop := runAuditor(t, a)
+	require.NoError(t, a.Audit(t.Context(), testRecords()[0]))
+	stop()
+}
+
+func TestAuditFailure(t *testing.T) {
+	t.Run("unreachable OTLP endpoint should not fail the audit", func(t *testing.T) {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := lis.Addr().String()
+		require.NoError(t, lis.Close())
+
+		a, err := New(Options{OTLPEndpoint: addr, OTLPInsecure: true})
+		require.NoError(t, err)
+		stop := runAuditor(t, a)
+		defer stop()
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+		defer cancel()
+		require.NoError(t, a.Audit(ctx, testRecords()[0]))
+	})
+
+	t.Run("file sink failure should fail the audit", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		a, err := New(Options{FilePath: path})
+		require.NoError(t, err)
+
+		// Records can't be written to a read-only file.
+		s := a.sinks[0].(*fileSink)
+		require.NoError(t, s.file.Close())
+		s.file, err = os.Open(path)
+		require.NoError(t, err)
+		s.enc = json.NewEncoder(s.file)
+
+		stop := runAuditor(t, a)
+		defer stop()
+		require.Error(t, a.Audit(t.Context(), testRecords()[0]))
+	})
+
+	t.Run("closed auditor should fail the audit", func(t *testing.T) {
+		a, err := New(Options{FilePath: filepath.Join(t.TempDir(), "audit.log")})
+		require.NoError(t, err)
+		runAuditor(t, a)()
+		require.Error(t, a.Audit(t.Context(), testRecords()[0]))
+	})
+}
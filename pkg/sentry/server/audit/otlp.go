@@ -0,0 +1,197 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// otlpTimeout is the timeout of an export to the OTLP endpoint, and of the
+	// export of the queued records when the sink is closed.
+	otlpTimeout = 10 * time.Second
+
+	// otlpQueueSize is the number of audit records queued for export. Records
+	// are dropped while the queue is full.
+	otlpQueueSize = 4096
+
+	// otlpBatchSize is the maximum number of audit records exported at once.
+	otlpBatchSize = 512
+
+	// otlpScope is the instrumentation scope of the audit logs.
+	otlpScope = "dapr.sentry.audit"
+)
+
+// otlpSink exports audit records as OpenTelemetry logs to an OTLP gRPC
+// endpoint. Records are queued and exported in background, on a best-effort
+// basis: records which can't be exported are logged and dropped.
+type otlpSink struct {
+	conn   *grpc.ClientConn
+	client collogspb.LogsServiceClient
+	queue  chan *Record
+	done   chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newOTLPSink(endpoint string, insecureConn bool) (*otlpSink, error) {
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if insecureConn {
+		creds = insecure.NewCredentials()
+	}
+
+	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP audit log client: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	o := &otlpSink{
+		conn:   conn,
+		client: collogspb.NewLogsServiceClient(conn),
+		queue:  make(chan *Record, otlpQueueSize),
+		done:   make(chan struct{}),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	go o.run()
+	return o, nil
+}
+
+// write queues the record for export. It doesn't wait for the record to be
+// exported, and never fails.
+func (o *otlpSink) write(_ context.Context, record *Record) error {
+	select {
+	case o.queue <- record:
+	default:
+		log.Errorf("Dropping audit record of %s certificate for %s: too many records queued for export to the OTLP endpoint", record.Outcome, record.SPIFFEID)
+	}
+	return nil
+}
+
+// run exports the queued records until the queue is closed.
+func (o *otlpSink) run() {
+	defer close(o.done)
+
+	batch := make([]*Record, 0, otlpBatchSize)
+	for record := range o.queue {
+		batch = append(batch[:0], record)
+	drain:
+		for len(batch) < otlpBatchSize {
+			select {
+			case record, ok := <-o.queue:
+				if !ok {
+					break drain
+				}
+				batch = append(batch, record)
+			default:
+				break drain
+			}
+		}
+
+		if err := o.export(batch); err != nil {
+			log.Errorf("Failed to export %d audit records to the OTLP endpoint: %v", len(batch), err)
+		}
+	}
+}
+
+func (o *otlpSink) export(records []*Record) error {
+	logRecords := make([]*logspb.LogRecord, 0, len(records))
+	for _, record := range records {
+		logRecords = append(logRecords, otlpLogRecord(record))
+	}
+
+	ctx, cancel := context.WithTimeout(o.ctx, otlpTimeout)
+	defer cancel()
+
+	_, err := o.client.Export(ctx, &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: &resourcepb.Resource{
+				Attributes: []*commonpb.KeyValue{stringAttribute("service.name", "dapr-sentry")},
+			},
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: otlpScope},
+				LogRecords: logRecords,
+			}},
+		}},
+	})
+	return err
+}
+
+// close exports the queued records, giving up after otlpTimeout, and closes
+// the connection. The sink must not be written to anymore.
+func (o *otlpSink) close() error {
+	close(o.queue)
+	select {
+	case <-o.done:
+	case <-time.After(otlpTimeout):
+		o.cancel()
+		<-o.done
+	}
+	o.cancel()
+	return o.conn.Close()
+}
+
+func otlpLogRecord(record *Record) *logspb.LogRecord {
+	severity, body := logspb.SeverityNumber_SEVERITY_NUMBER_INFO, "certificate issued"
+	if record.Outcome == OutcomeDenied {
+		severity, body = logspb.SeverityNumber_SEVERITY_NUMBER_WARN, "certificate denied"
+	}
+
+	attrs := []*commonpb.KeyValue{
+		stringAttribute("outcome", string(record.Outcome)),
+		stringAttribute("spiffe_id", record.SPIFFEID),
+		stringAttribute("namespace", record.Namespace),
+		stringAttribute("app_id", record.AppID),
+		stringAttribute("validator", record.Validator),
+	}
+	if record.Requester != "" {
+		attrs = append(attrs, stringAttribute("requester", record.Requester))
+	}
+	if record.Serial != "" {
+		attrs = append(attrs, stringAttribute("serial", record.Serial))
+	}
+	if !record.Expiry.IsZero() {
+		attrs = append(attrs, stringAttribute("expiry", record.Expiry.UTC().Format(time.RFC3339)))
+	}
+	if record.Reason != "" {
+		attrs = append(attrs, stringAttribute("reason", record.Reason))
+	}
+
+	return &logspb.LogRecord{
+		TimeUnixNano:   uint64(record.Time.UnixNano()), //nolint:gosec
+		SeverityNumber: severity,
+		SeverityText:   severity.String(),
+		Body:           &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: body}},
+		Attributes:     attrs,
+	}
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
@@ -40,6 +40,11 @@ const (
 type Bundle struct {
 	X509 *X509
 	JWT  *JWT
+
+	// Next is the staged X.509 bundle to rotate the trust anchors to, if any.
+	Next *X509
+	// Rotation is the trust anchor rotation in progress, if any.
+	Rotation *Rotation
 }
 
 type OptionsX509 struct {
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"bytes"
+	"errors"
+	"time"
+)
+
+// RotationPhase is the phase of a trust anchor rotation.
+type RotationPhase string
+
+const (
+	// RotationPhasePublishing is the phase in which the new root is published
+	// alongside the old one in the trust anchors, while the old issuer still
+	// issues certificates.
+	RotationPhasePublishing RotationPhase = "Publishing"
+
+	// RotationPhaseIssuing is the phase in which the new issuer issues
+	// certificates, while the old root is still published until the
+	// certificates issued by the old issuer have expired.
+	RotationPhaseIssuing RotationPhase = "Issuing"
+)
+
+// Rotation is the state of a trust anchor rotation in progress.
+type Rotation struct {
+	// Phase is the current phase of the rotation.
+	Phase RotationPhase `json:"phase"`
+	// PhaseStarted is the time the current phase started.
+	PhaseStarted time.Time `json:"phaseStarted"`
+	// OldTrustAnchors are the PEM encoded trust anchors rotated out.
+	OldTrustAnchors []byte `json:"oldTrustAnchors"`
+	// TrustAnchors are the PEM encoded trust anchors rotated in.
+	TrustAnchors []byte `json:"trustAnchors"`
+	// Propagated is true once every client has been observed using the
+	// combined trust anchors. The new issuer doesn't issue before.
+	Propagated bool `json:"propagated,omitempty"`
+}
+
+// OptionsRotation are the options to advance a trust anchor rotation.
+type OptionsRotation struct {
+	// Current is the X.509 bundle in use.
+	Current *X509
+	// Next is the staged X.509 bundle to rotate to, if any.
+	Next *X509
+	// Rotation is the rotation in progress, if any.
+	Rotation *Rotation
+	// Now is the current time.
+	Now time.Time
+	// PropagationPeriod is how long the combined trust anchors are published
+	// before issuing from the new issuer, so that every sidecar has picked them
+	// up.
+	PropagationPeriod time.Duration
+	// IssuedCertTTL is the longest lifetime of a certificate issued by the old
+	// issuer, after which the old root is no longer needed.
+	IssuedCertTTL time.Duration
+}
+
+// RotationResult is the result of advancing a trust anchor rotation.
+type RotationResult struct {
+	// X509 is the X.509 bundle to use.
+	X509 *X509
+	// Rotation is the rotation in progress, or nil once it has completed.
+	Rotation *Rotation
+	// Transitions are the transitions made, in order: a rotation phase, or
+	// "Complete" or "Cancelled". Empty if the bundle is unchanged.
+	Transitions []string
+}
+
+// Changed returns true if the X.509 bundle or rotation state changed, and
+// should be persisted.
+func (r RotationResult) Changed() bool {
+	return len(r.Transitions) > 0
+}
+
+// Deadline returns the time the current phase of the rotation ends.
+func (r *Rotation) Deadline(propagationPeriod, issuedCertTTL time.Duration) time.Time {
+	if r.Phase == RotationPhasePublishing {
+		return r.PhaseStarted.Add(propagationPeriod)
+	}
+	return r.PhaseStarted.Add(issuedCertTTL)
+}
+
+// Rotate advances the trust anchor rotation given the staged X.509 bundle
+// and the rotation in progress. A rotation starts when a staged bundle with
+// different trust anchors is found, and moves to the next phase once the
+// current phase has lasted long enough, and the combined trust anchors have
+// propagated to issue from the new issuer. Removing or replacing the staged
+// bundle while the new root is still being published cancels the rotation.
+func Rotate(opts OptionsRotation) (RotationResult, error) {
+	if opts.Current == nil {
+		return RotationResult{}, errors.New("no X.509 bundle to rotate")
+	}
+
+	res := RotationResult{
+		X509:     opts.Current,
+		Rotation: opts.Rotation,
+	}
+
+	if res.Rotation != nil && res.Rotation.Phase == RotationPhasePublishing &&
+		(opts.Next == nil || !bytes.Equal(opts.Next.TrustAnchors, res.Rotation.TrustAnchors)) {
+		x509 := *res.X509
+		x509.TrustAnchors = res.Rotation.OldTrustAnchors
+		res.X509 = &x509
+		res.Rotation = nil
+		res.Transitions = append(res.Transitions, "Cancelled")
+	}
+
+	switch {
+	case res.Rotation == nil:
+		if opts.Next == nil || bytes.Equal(opts.Next.TrustAnchors, res.X509.TrustAnchors) {
+			return res, nil
+		}
+		if len(opts.Next.IssChain) == 0 || opts.Next.IssKey == nil {
+			return RotationResult{}, errors.New("staged X.509 bundle has no issuer")
+		}
+
+		res.Rotation = &Rotation{
+			Phase:           RotationPhasePublishing,
+			PhaseStarted:    opts.Now,
+			OldTrustAnchors: res.X509.TrustAnchors,
+			TrustAnchors:    opts.Next.TrustAnchors,
+		}
+		x509 := *res.X509
+		x509.TrustAnchors = append(bytes.Clone(res.X509.TrustAnchors), opts.Next.TrustAnchors...)
+		res.X509 = &x509
+
+	case opts.Now.Before(res.Rotation.Deadline(opts.PropagationPeriod, opts.IssuedCertTTL)),
+		res.Rotation.Phase == RotationPhasePublishing && !res.Rotation.Propagated:
+		return res, nil
+
+	case res.Rotation.Phase == RotationPhasePublishing:
+		res.X509 = &X509{
+			TrustAnchors: res.X509.TrustAnchors,
+			IssChainPEM:  opts.Next.IssChainPEM,
+			IssKeyPEM:    opts.Next.IssKeyPEM,
+			IssChain:     opts.Next.IssChain,
+			IssKey:       opts.Next.IssKey,
+		}
+		rotation := *res.Rotation
+		rotation.Phase = RotationPhaseIssuing
+		rotation.PhaseStarted = opts.Now
+		res.Rotation = &rotation
+
+	default:
+		x509 := *res.X509
+		x509.TrustAnchors = res.Rotation.TrustAnchors
+		res.X509 = &x509
+		res.Rotation = nil
+		res.Transitions = append(res.Transitions, "Complete")
+		return res, nil
+	}
+
+	res.Transitions = append(res.Transitions, string(res.Rotation.Phase))
+	return res, nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotate(t *testing.T) {
+	genX509 := func(t *testing.T) *X509 {
+		t.Helper()
+		rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		x509, err := GenerateX509(OptionsX509{
+			X509RootKey: rootKey,
+			TrustDomain: "example.com",
+		})
+		require.NoError(t, err)
+		return x509
+	}
+
+	old := genX509(t)
+	next := genX509(t)
+	other := genX509(t)
+	combined := append(append([]byte{}, old.TrustAnchors...), next.TrustAnchors...)
+
+	now := time.Now()
+	opts := func(current, next *X509, rotation *Rotation, now time.Time) OptionsRotation {
+		return OptionsRotation{
+			Current:           current,
+			Next:              next,
+			Rotation:          rotation,
+			Now:               now,
+			PropagationPeriod: time.Hour,
+			IssuedCertTTL:     2 * time.Hour,
+		}
+	}
+
+	t.Run("no staged bundle should not change anything", func(t *testing.T) {
+		res, err := Rotate(opts(old, nil, nil, now))
+		require.NoError(t, err)
+		assert.False(t, res.Changed())
+		assert.Same(t, old, res.X509)
+		assert.Nil(t, res.Rotation)
+	})
+
+	t.Run("staged bundle with the current trust anchors should not change anything", func(t *testing.T) {
+		res, err := Rotate(opts(next, next, nil, now))
+		require.NoError(t, err)
+		assert.False(t, res.Changed())
+		assert.Nil(t, res.Rotation)
+	})
+
+	t.Run("rotation should publish, issue from the new issuer, then remove the old root", func(t *testing.T) {
+		res, err := Rotate(opts(old, next, nil, now))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Publishing"}, res.Transitions)
+		assert.Equal(t, combined, res.X509.TrustAnchors)
+		assert.Equal(t, old.IssChain, res.X509.IssChain)
+		assert.Equal(t, old.IssKey, res.X509.IssKey)
+		require.NotNil(t, res.Rotation)
+		assert.Equal(t, RotationPhasePublishing, res.Rotation.Phase)
+		assert.Equal(t, now.Add(time.Hour), res.Rotation.Deadline(time.Hour, 2*time.Hour))
+
+		current, rotation := res.X509, res.Rotation
+
+		res, err = Rotate(opts(current, next, rotation, now.Add(time.Hour-time.Second)))
+		require.NoError(t, err)
+		assert.False(t, res.Changed())
+
+		// The new issuer doesn't issue before the new root has propagated.
+		res, err = Rotate(opts(current, next, rotation, now.Add(time.Hour)))
+		require.NoError(t, err)
+		assert.False(t, res.Changed())
+
+		propagated := *rotation
+		propagated.Propagated = true
+		rotation = &propagated
+
+		res, err = Rotate(opts(current, next, rotation, now.Add(time.Hour-time.Second)))
+		require.NoError(t, err)
+		assert.False(t, res.Changed())
+
+		res, err = Rotate(opts(current, next, rotation, now.Add(time.Hour)))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Issuing"}, res.Transitions)
+		assert.Equal(t, combined, res.X509.TrustAnchors)
+		assert.Equal(t, next.IssChain, res.X509.IssChain)
+		assert.Equal(t, next.IssKeyPEM, res.X509.IssKeyPEM)
+		require.NotNil(t, res.Rotation)
+		assert.Equal(t, RotationPhaseIssuing, res.Rotation.Phase)
+
+		current, rotation = res.X509, res.Rotation
+
+		res, err = Rotate(opts(current, nil, rotation, now.Add(3*time.Hour-time.Second)))
+		require.NoError(t, err)
+		assert.False(t, res.Changed())
+
+		res, err = Rotate(opts(current, nil, rotation, now.Add(3*time.Hour)))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Complete"}, res.Transitions)
+		assert.Equal(t, next.TrustAnchors, res.X509.TrustAnchors)
+		assert.Equal(t, next.IssChain, res.X509.IssChain)
+		assert.Nil(t, res.Rotation)
+
+		res, err = Rotate(opts(res.X509, next, nil, now.Add(4*time.Hour)))
+		require.NoError(t, err)
+		assert.False(t, res.Changed())
+	})
+
+	t.Run("removing the staged bundle while publishing should cancel the rotation", func(t *testing.T) {
+		res, err := Rotate(opts(old, next, nil, now))
+		require.NoError(t, err)
+
+		res, err = Rotate(opts(res.X509, nil, res.Rotation, now.Add(time.Minute)))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Cancelled"}, res.Transitions)
+		assert.Equal(t, old.TrustAnchors, res.X509.TrustAnchors)
+		assert.Equal(t, old.IssChain, res.X509.IssChain)
+		assert.Nil(t, res.Rotation)
+	})
+
+	t.Run("replacing the staged bundle while publishing should restart the rotation", func(t *testing.T) {
+		res, err := Rotate(opts(old, next, nil, now))
+		require.NoError(t, err)
+
+		res, err = Rotate(opts(res.X509, other, res.Rotation, now.Add(time.Minute)))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Cancelled", "Publishing"}, res.Transitions)
+		assert.Equal(t, append(append([]byte{}, old.TrustAnchors...), other.TrustAnchors...), res.X509.TrustAnchors)
+		require.NotNil(t, res.Rotation)
+		assert.Equal(t, old.TrustAnchors, res.Rotation.OldTrustAnchors)
+		assert.Equal(t, other.TrustAnchors, res.Rotation.TrustAnchors)
+		assert.Equal(t, now.Add(time.Minute), res.Rotation.PhaseStarted)
+	})
+
+	t.Run("staged bundle without issuer should error", func(t *testing.T) {
+		_, err := Rotate(opts(old, &X509{TrustAnchors: next.TrustAnchors}, nil, now))
+		require.Error(t, err)
+	})
+}
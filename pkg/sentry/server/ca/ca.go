@@ -24,11 +24,14 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/lestrrat-go/jwx/v2/jwa"
 	"github.com/lestrrat-go/jwx/v2/jwk"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/clock"
 
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/security"
@@ -36,6 +39,7 @@ import (
 	"github.com/dapr/dapr/pkg/sentry/config"
 	"github.com/dapr/dapr/pkg/sentry/monitoring"
 	bundle "github.com/dapr/dapr/pkg/sentry/server/ca/bundle"
+	"github.com/dapr/dapr/pkg/sentry/server/ca/external"
 	"github.com/dapr/dapr/pkg/sentry/server/ca/jwt"
 	"github.com/dapr/dapr/utils"
 	"github.com/dapr/kit/logger"
@@ -62,6 +66,10 @@ type SignRequest struct {
 
 	// Optional DNS names to add to the certificate.
 	DNS []string
+
+	// TrustAnchorsVersion is the version of the trust anchors the client
+	// uses, as returned by security.TrustAnchorsVersion.
+	TrustAnchorsVersion string
 }
 
 // Signer is the interface for the CA.
@@ -77,6 +85,10 @@ type Signer interface {
 	// TrustAnchors returns the trust anchors for the CA in PEM format.
 	TrustAnchors() []byte
 
+	// RunRotation blocks until the current phase of the trust anchor rotation
+	// in progress ends, at which point the CA needs to be reloaded.
+	RunRotation(context.Context) error
+
 	// Extends signing to issue JWT tokens.
 	jwt.Issuer
 }
@@ -85,16 +97,45 @@ type Signer interface {
 type store interface {
 	store(context.Context, bundle.Bundle) error
 	get(context.Context) (bundle.Bundle, error)
+	// instances returns the number of Sentry instances sharing the store.
+	instances(context.Context) (int, error)
 }
 
 // ca is the implementation of the CA Signer.
 type ca struct {
 	bundle bundle.Bundle
 	config config.Config
+	clock  clock.Clock
+	store  store
 	jwt.Issuer
+
+	// trustAnchorsVersion is the version of the trust anchors of the bundle.
+	trustAnchorsVersion string
+	// lastStaleReport is the last time a client reported other trust anchors
+	// than the ones of the bundle while a trust anchor rotation publishes the
+	// new root.
+	lastStaleReport time.Time
+	lock            sync.Mutex
 }
 
 func New(ctx context.Context, conf config.Config) (Signer, error) {
+	var signer crypto.Signer
+	if conf.ExternalSigner != nil {
+		log.Infof("Using external signer at %s for the issuer key", conf.ExternalSigner.Address)
+
+		var err error
+		signer, err = external.New(ctx, external.Options{
+			Address:     conf.ExternalSigner.Address,
+			KeyID:       conf.ExternalSigner.KeyID,
+			TLSCAPath:   conf.ExternalSigner.TLSCAPath,
+			TLSCertPath: conf.ExternalSigner.TLSCertPath,
+			TLSKeyPath:  conf.ExternalSigner.TLSKeyPath,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var castore store
 	if conf.Mode == modes.KubernetesMode {
 		log.Info("Using kubernetes secret store for trust bundle storage")
@@ -108,10 +149,11 @@ func New(ctx context.Context, conf config.Config) (Signer, error) {
 			config:    conf,
 			namespace: security.CurrentNamespace(),
 			client:    client,
+			signer:    signer,
 		}
 	} else {
 		log.Info("Using local file system for trust bundle storage")
-		castore = &selfhosted{config: conf}
+		castore = &selfhosted{config: conf, signer: signer}
 	}
 
 	bndle, err := castore.get(ctx)
@@ -119,8 +161,34 @@ func New(ctx context.Context, conf config.Config) (Signer, error) {
 		return nil, fmt.Errorf("failed to get CA bundle: %w", err)
 	}
 
+	if signer != nil && bndle.Rotation != nil {
+		return nil, errors.New("a trust anchor rotation is in progress: trust anchor rotation is not supported with an external signer")
+	}
+
+	c := &ca{
+		config: conf,
+		clock:  clock.RealClock{},
+		store:  castore,
+	}
+	c.lastStaleReport = c.clock.Now()
+
 	var needsWrite bool
-	if bndle.X509 == nil {
+	if bndle.X509 != nil {
+		if bndle.Rotation == nil && bndle.Next != nil {
+			if err = c.singleInstance(ctx); err != nil {
+				log.Errorf("Staged trust anchors found but not starting a trust anchor rotation: %v", err)
+				bndle.Next = nil
+			}
+		}
+		needsWrite, err = c.rotate(&bndle)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if signer != nil {
+			return nil, errors.New("root and issuer certs not found: a self signed CA can't be generated when using an external signer")
+		}
+
 		needsWrite = true
 
 		log.Info("Root and issuer certs not found: generating self signed CA")
@@ -172,7 +240,7 @@ func New(ctx context.Context, conf config.Config) (Signer, error) {
 		if err := castore.store(ctx, bndle); err != nil {
 			return nil, fmt.Errorf("failed to store CA bundle: %w", err)
 		}
-		log.Info("Self-signed certs and JWT root key generated and persisted successfully")
+		log.Info("Trust bundle persisted successfully")
 
 		monitoring.IssuerCertChanged()
 	} else {
@@ -251,14 +319,17 @@ func New(ctx context.Context, conf config.Config) (Signer, error) {
 		}
 	}
 
-	return &ca{
-		bundle: bndle,
-		config: conf,
-		Issuer: jwtIss,
-	}, nil
+	c.bundle = bndle
+	c.config = conf
+	c.Issuer = jwtIss
+	c.trustAnchorsVersion = security.TrustAnchorsVersion(bndle.X509.TrustAnchors)
+
+	return c, nil
 }
 
 func (c *ca) SignIdentity(ctx context.Context, req *SignRequest) ([]*x509.Certificate, error) {
+	c.observeTrustAnchors(req.TrustAnchorsVersion)
+
 	td, err := spiffeid.TrustDomainFromString(req.TrustDomain)
 	if err != nil {
 		return nil, err
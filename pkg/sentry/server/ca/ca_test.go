@@ -14,11 +14,16 @@ limitations under the License.
 package ca
 
 import (
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -34,8 +39,9 @@ import (
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/sentry/config"
 	ca_bundle "github.com/dapr/dapr/pkg/sentry/server/ca/bundle"
+	"github.com/dapr/dapr/pkg/sentry/server/ca/external"
 	"github.com/dapr/dapr/pkg/sentry/server/ca/jwt"
-	"github.com/dapr/kit/crypto/pem"
+	kitpem "github.com/dapr/kit/crypto/pem"
 )
 
 func TestNew(t *testing.T) {
@@ -78,21 +84,21 @@ func TestNew(t *testing.T) {
 			require.NoError(t, err)
 
 			// Verify certificate content
-			rootCertX509, err := pem.DecodePEMCertificates(rootCert)
+			rootCertX509, err := kitpem.DecodePEMCertificates(rootCert)
 			require.NoError(t, err)
 			require.Len(t, rootCertX509, 1)
 			assert.Equal(t, []string{"test.example.com"}, rootCertX509[0].Subject.Organization)
 
-			issuerCertX509, err := pem.DecodePEMCertificates(issuerCert)
+			issuerCertX509, err := kitpem.DecodePEMCertificates(issuerCert)
 			require.NoError(t, err)
 			require.Len(t, issuerCertX509, 1)
 			assert.Equal(t, []string{"spiffe://test.example.com/ns/dapr-test/dapr-sentry"}, issuerCertX509[0].Subject.Organization)
 
-			issuerKeyPK, err := pem.DecodePEMPrivateKey(issuerKey)
+			issuerKeyPK, err := kitpem.DecodePEMPrivateKey(issuerKey)
 			require.NoError(t, err)
 
 			require.NoError(t, issuerCertX509[0].CheckSignatureFrom(rootCertX509[0]))
-			ok, err := pem.PublicKeysEqual(issuerCertX509[0].PublicKey, issuerKeyPK.Public())
+			ok, err := kitpem.PublicKeysEqual(issuerCertX509[0].PublicKey, issuerKeyPK.Public())
 			require.NoError(t, err)
 			assert.True(t, ok)
 		})
@@ -148,21 +154,21 @@ func TestNew(t *testing.T) {
 			require.NoError(t, err)
 
 			// Verify certificate content
-			rootCertX509, err := pem.DecodePEMCertificates(rootCert)
+			rootCertX509, err := kitpem.DecodePEMCertificates(rootCert)
 			require.NoError(t, err)
 			require.Len(t, rootCertX509, 1)
 			assert.Equal(t, []string{"test.example.com"}, rootCertX509[0].Subject.Organization)
 
-			issuerCertX509, err := pem.DecodePEMCertificates(issuerCert)
+			issuerCertX509, err := kitpem.DecodePEMCertificates(issuerCert)
 			require.NoError(t, err)
 			require.Len(t, issuerCertX509, 1)
 			assert.Equal(t, []string{"spiffe://test.example.com/ns/dapr-test/dapr-sentry"}, issuerCertX509[0].Subject.Organization)
 
-			issuerKeyPK, err := pem.DecodePEMPrivateKey(issuerKey)
+			issuerKeyPK, err := kitpem.DecodePEMPrivateKey(issuerKey)
 			require.NoError(t, err)
 
 			require.NoError(t, issuerCertX509[0].CheckSignatureFrom(rootCertX509[0]))
-			ok, err := pem.PublicKeysEqual(issuerCertX509[0].PublicKey, issuerKeyPK.Public())
+			ok, err := kitpem.PublicKeysEqual(issuerCertX509[0].PublicKey, issuerKeyPK.Public())
 			require.NoError(t, err)
 			assert.True(t, ok)
 
@@ -193,7 +199,7 @@ func TestNew(t *testing.T) {
 			require.Equal(t, ca_bundle.DefaultJWTSignatureAlgorithm.String(), algStr)
 
 			// JWT key should be valid
-			jwtKeyPK, err := pem.DecodePEMPrivateKey(jwtKey)
+			jwtKeyPK, err := kitpem.DecodePEMPrivateKey(jwtKey)
 			require.NoError(t, err)
 			require.NotNil(t, jwtKeyPK)
 
@@ -369,4 +375,60 @@ func TestSignIdentity(t *testing.T) {
 
 		require.NoError(t, clientCert[0].CheckSignatureFrom(int2Crt))
 	})
+
+	t.Run("signing identity with an external signer should not need the issuer key", func(t *testing.T) {
+		dir := t.TempDir()
+		rootCertPath := filepath.Join(dir, "root.cert")
+		issuerCertPath := filepath.Join(dir, "issuer.cert")
+		issuerKeyPath := filepath.Join(dir, "issuer.key")
+
+		rootPEM, rootCrt, _, rootPK := genCrt(t, "root", nil, nil)
+		intPEM, intCrt, _, intPK := genCrt(t, "int", rootCrt, rootPK)
+
+		require.NoError(t, os.WriteFile(rootCertPath, rootPEM, 0o600))
+		require.NoError(t, os.WriteFile(issuerCertPath, intPEM, 0o600))
+
+		der, err := x509.MarshalPKIXPublicKey(intPK.Public())
+		require.NoError(t, err)
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST "+external.PublicKeyPath, func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(external.PublicKeyResponse{
+				PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+			})
+		})
+		mux.HandleFunc("POST "+external.SignPath, func(w http.ResponseWriter, r *http.Request) {
+			var req external.SignRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			sig, err := intPK.Sign(rand.Reader, req.Digest, crypto.SHA256)
+			require.NoError(t, err)
+			json.NewEncoder(w).Encode(external.SignResponse{Signature: sig})
+		})
+		srv := httptest.NewServer(mux)
+		t.Cleanup(srv.Close)
+
+		ca, err := New(t.Context(), config.Config{
+			RootCertPath:   rootCertPath,
+			IssuerCertPath: issuerCertPath,
+			IssuerKeyPath:  issuerKeyPath,
+			ExternalSigner: &config.ConfigExternalSigner{Address: srv.URL, KeyID: "issuer"},
+		})
+		require.NoError(t, err)
+
+		clientPK, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		clientCert, err := ca.SignIdentity(t.Context(), &SignRequest{
+			PublicKey:          clientPK.Public(),
+			SignatureAlgorithm: x509.ECDSAWithSHA256,
+			TrustDomain:        "example.test.dapr.io",
+			Namespace:          "my-test-namespace",
+			AppID:              "my-app-id",
+		})
+		require.NoError(t, err)
+		require.Len(t, clientCert, 2)
+		require.NoError(t, clientCert[0].CheckSignatureFrom(intCrt))
+
+		_, err = os.Stat(issuerKeyPath)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
 }
@@ -0,0 +1,264 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package external implements a crypto.Signer which delegates the signatures
+// of the issuer to an external signing service, so the private key of the
+// issuer is never present in the Sentry process.
+//
+// The signing service implements the following plugin protocol, over HTTP(S)
+// or HTTP over a Unix Domain Socket. Request and response bodies are JSON,
+// and byte fields are encoded in standard base64.
+//
+//	POST /v1/publickey
+//	  Request:  {"keyId": "<key id>"}
+//	  Response: {"publicKey": "<PEM-encoded PKIX public key>"}
+//
+//	POST /v1/sign
+//	  Request:  {"keyId": "<key id>", "digest": "<digest>", "hashAlgorithm": "SHA-256", "rsaPss": false, "rsaPssSaltLength": 0}
+//	  Response: {"signature": "<signature>"}
+//
+// The signature is computed over the digest, in the format of Go's
+// crypto.Signer: ASN.1 DER for ECDSA, PKCS #1 v1.5 for RSA, or PSS if rsaPss
+// is true, with the salt length of Go's rsa.PSSOptions (0 for the maximum, -1
+// for the length of the hash). hashAlgorithm is empty when the message itself is
+// sent as the digest (Ed25519). A PKCS #11 module or a KMS is plugged in by
+// running a service implementing this protocol in front of it, for example
+// on a Unix Domain Socket next to Sentry.
+// Errors are returned with a non-2xx status code and a {"error": "<message>"}
+// body.
+package external
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// PublicKeyPath is the path of the public key endpoint of the signing service.
+	PublicKeyPath = "/v1/publickey"
+	// SignPath is the path of the sign endpoint of the signing service.
+	SignPath = "/v1/sign"
+
+	defaultTimeout = 10 * time.Second
+	unixPrefix     = "unix://"
+)
+
+// Options are the options of an external signer.
+type Options struct {
+	// Address of the signing service: an http:// or https:// URL, or
+	// unix://<path> for a Unix Domain Socket.
+	Address string
+
+	// KeyID is the ID of the issuer key at the signing service.
+	KeyID string
+
+	// Optional TLS configuration for https:// addresses: the CA certificate to
+	// verify the signing service, and the client certificate and key Sentry
+	// authenticates with.
+	TLSCAPath   string
+	TLSCertPath string
+	TLSKeyPath  string
+
+	// Timeout of the requests to the signing service. Defaults to 10s.
+	Timeout time.Duration
+}
+
+// PublicKeyRequest is the request of the public key endpoint.
+type PublicKeyRequest struct {
+	KeyID string `json:"keyId"`
+}
+
+// PublicKeyResponse is the response of the public key endpoint.
+type PublicKeyResponse struct {
+	PublicKey string `json:"publicKey"`
+}
+
+// SignRequest is the request of the sign endpoint.
+type SignRequest struct {
+	KeyID            string `json:"keyId"`
+	Digest           []byte `json:"digest"`
+	HashAlgorithm    string `json:"hashAlgorithm"`
+	RSAPSS           bool   `json:"rsaPss,omitempty"`
+	RSAPSSSaltLength int    `json:"rsaPssSaltLength,omitempty"`
+}
+
+// SignResponse is the response of the sign endpoint.
+type SignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// ErrorResponse is the response of the signing service on errors.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Signer is a crypto.Signer whose signatures are made by an external signing
+// service.
+type Signer struct {
+	client    *http.Client
+	baseURL   string
+	keyID     string
+	publicKey crypto.PublicKey
+}
+
+// New returns the external signer of the key at the signing service.
+func New(ctx context.Context, opts Options) (*Signer, error) {
+	if opts.Address == "" {
+		return nil, errors.New("external signer address is required")
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	baseURL := strings.TrimSuffix(opts.Address, "/")
+	switch {
+	case strings.HasPrefix(opts.Address, unixPrefix):
+		socket := strings.TrimPrefix(opts.Address, unixPrefix)
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socket)
+		}
+		baseURL = "http://localhost"
+	case strings.HasPrefix(opts.Address, "https://"):
+		tlsConfig, err := tlsConfig(opts)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	case strings.HasPrefix(opts.Address, "http://"):
+	default:
+		return nil, fmt.Errorf("invalid external signer address %q: must start with http://, https:// or unix://", opts.Address)
+	}
+
+	s := &Signer{
+		client:  &http.Client{Transport: transport, Timeout: timeout},
+		baseURL: baseURL,
+		keyID:   opts.KeyID,
+	}
+
+	var res PublicKeyResponse
+	if err := s.call(ctx, PublicKeyPath, PublicKeyRequest{KeyID: s.keyID}, &res); err != nil {
+		return nil, fmt.Errorf("failed to get public key from external signer: %w", err)
+	}
+	block, _ := pem.Decode([]byte(res.PublicKey))
+	if block == nil {
+		return nil, errors.New("failed to decode public key from external signer: not PEM encoded")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key from external signer: %w", err)
+	}
+	s.publicKey = pub
+
+	return s, nil
+}
+
+func tlsConfig(opts Options) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if opts.TLSCAPath != "" {
+		ca, err := os.ReadFile(opts.TLSCAPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read external signer CA certificate: %w", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, errors.New("failed to parse external signer CA certificate")
+		}
+	}
+
+	if opts.TLSCertPath != "" || opts.TLSKeyPath != "" {
+		cert, err := tls.LoadX509KeyPair(opts.TLSCertPath, opts.TLSKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load external signer client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// Public returns the public key of the issuer.
+func (s *Signer) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+// Sign signs digest with the issuer key at the signing service.
+func (s *Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	req := SignRequest{
+		KeyID:  s.keyID,
+		Digest: digest,
+	}
+	if h := opts.HashFunc(); h != 0 {
+		req.HashAlgorithm = h.String()
+	}
+	if pss, ok := opts.(*rsa.PSSOptions); ok {
+		req.RSAPSS = true
+		req.RSAPSSSaltLength = pss.SaltLength
+	}
+
+	var res SignResponse
+	if err := s.call(context.Background(), SignPath, req, &res); err != nil {
+		return nil, fmt.Errorf("failed to sign with external signer: %w", err)
+	}
+	if len(res.Signature) == 0 {
+		return nil, errors.New("external signer returned an empty signature")
+	}
+	return res.Signature, nil
+}
+
+func (s *Signer) call(ctx context.Context, path string, in, out any) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var errRes ErrorResponse
+		if json.NewDecoder(resp.Body).Decode(&errRes) == nil && errRes.Error != "" {
+			return fmt.Errorf("status %d: %s", resp.StatusCode, errRes.Error)
+		}
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
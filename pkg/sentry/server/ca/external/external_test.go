@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signingService returns a handler implementing the plugin protocol with key,
+// recording the sign requests it receives.
+func signingService(t *testing.T, keyID string, key crypto.Signer, reqs *[]SignRequest) http.Handler {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	writeErr := func(w http.ResponseWriter, code int, msg string) {
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(ErrorResponse{Error: msg})
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+PublicKeyPath, func(w http.ResponseWriter, r *http.Request) {
+		var req PublicKeyRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.KeyID != keyID {
+			writeErr(w, http.StatusNotFound, "unknown key")
+			return
+		}
+		json.NewEncoder(w).Encode(PublicKeyResponse{PublicKey: string(publicKeyPEM)})
+	})
+	mux.HandleFunc("POST "+SignPath, func(w http.ResponseWriter, r *http.Request) {
+		var req SignRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.KeyID != keyID {
+			writeErr(w, http.StatusNotFound, "unknown key")
+			return
+		}
+		if reqs != nil {
+			*reqs = append(*reqs, req)
+		}
+		sig, err := key.Sign(rand.Reader, req.Digest, crypto.SHA256)
+		if err != nil {
+			writeErr(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		json.NewEncoder(w).Encode(SignResponse{Signature: sig})
+	})
+	return mux
+}
+
+func TestNew(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("no address should error", func(t *testing.T) {
+		_, err := New(t.Context(), Options{})
+		require.Error(t, err)
+	})
+
+	t.Run("unsupported address scheme should error", func(t *testing.T) {
+		_, err := New(t.Context(), Options{Address: "tcp://localhost:1234"})
+		require.ErrorContains(t, err, "invalid external signer address")
+	})
+
+	t.Run("unknown key should return the error of the signing service", func(t *testing.T) {
+		srv := httptest.NewServer(signingService(t, "issuer", key, nil))
+		t.Cleanup(srv.Close)
+
+		_, err := New(t.Context(), Options{Address: srv.URL, KeyID: "other"})
+		require.ErrorContains(t, err, "unknown key")
+	})
+
+	t.Run("should fetch the public key over HTTP", func(t *testing.T) {
+		srv := httptest.NewServer(signingService(t, "issuer", key, nil))
+		t.Cleanup(srv.Close)
+
+		s, err := New(t.Context(), Options{Address: srv.URL, KeyID: "issuer"})
+		require.NoError(t, err)
+		assert.True(t, key.PublicKey.Equal(s.Public()))
+	})
+
+	t.Run("should fetch the public key over a Unix Domain Socket", func(t *testing.T) {
+		socket := filepath.Join(t.TempDir(), "signer.sock")
+		lis, err := net.Listen("unix", socket)
+		require.NoError(t, err)
+
+		srv := httptest.NewUnstartedServer(signingService(t, "issuer", key, nil))
+		srv.Listener = lis
+		srv.Start()
+		t.Cleanup(srv.Close)
+
+		s, err := New(t.Context(), Options{Address: "unix://" + socket, KeyID: "issuer"})
+		require.NoError(t, err)
+		assert.True(t, key.PublicKey.Equal(s.Public()))
+	})
+}
+
+func TestSign(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var reqs []SignRequest
+	srv := httptest.NewServer(signingService(t, "issuer", key, &reqs))
+	t.Cleanup(srv.Close)
+
+	s, err := New(t.Context(), Options{Address: srv.URL, KeyID: "issuer"})
+	require.NoError(t, err)
+
+	t.Run("signature should verify with the public key", func(t *testing.T) {
+		digest := sha256.Sum256([]byte("hello"))
+		sig, err := s.Sign(rand.Reader, digest[:], crypto.SHA256)
+		require.NoError(t, err)
+		assert.True(t, ecdsa.VerifyASN1(&key.PublicKey, digest[:], sig))
+
+		require.Len(t, reqs, 1)
+		assert.Equal(t, "issuer", reqs[0].KeyID)
+		assert.Equal(t, "SHA-256", reqs[0].HashAlgorithm)
+		assert.False(t, reqs[0].RSAPSS)
+	})
+
+	t.Run("PSS options should be sent to the signing service", func(t *testing.T) {
+		reqs = nil
+		digest := sha256.Sum256([]byte("hello"))
+		_, err := s.Sign(rand.Reader, digest[:], &rsa.PSSOptions{Hash: crypto.SHA256, SaltLength: rsa.PSSSaltLengthEqualsHash})
+		require.NoError(t, err)
+
+		require.Len(t, reqs, 1)
+		assert.True(t, reqs[0].RSAPSS)
+		assert.Equal(t, rsa.PSSSaltLengthEqualsHash, reqs[0].RSAPSSSaltLength)
+	})
+
+	t.Run("unavailable signing service should error", func(t *testing.T) {
+		srv.Close()
+		digest := sha256.Sum256([]byte("hello"))
+		_, err := s.Sign(rand.Reader, digest[:], crypto.SHA256)
+		require.Error(t, err)
+	})
+}
@@ -27,6 +27,7 @@ import (
 type Fake struct {
 	signIdentityFn        func(context.Context, *ca.SignRequest) ([]*x509.Certificate, error)
 	trustAnchorsFn        func() []byte
+	runRotationFn         func(context.Context) error
 	generateJWTFn         func(context.Context, *jwt.Request) (string, error)
 	jwksFn                func() jwk.Set
 	jwtSignatureAlgorithm func() jwa.KeyAlgorithm
@@ -40,6 +41,10 @@ func New() *Fake {
 		trustAnchorsFn: func() []byte {
 			return nil
 		},
+		runRotationFn: func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		},
 		generateJWTFn: func(context.Context, *jwt.Request) (string, error) {
 			return "", nil
 		},
@@ -62,6 +67,11 @@ func (f *Fake) WithTrustAnchors(fn func() []byte) *Fake {
 	return f
 }
 
+func (f *Fake) WithRunRotation(fn func(context.Context) error) *Fake {
+	f.runRotationFn = fn
+	return f
+}
+
 func (f *Fake) WithGenerateJWT(fn func(context.Context, *jwt.Request) (string, error)) *Fake {
 	f.generateJWTFn = fn
 	return f
@@ -85,6 +95,10 @@ func (f *Fake) TrustAnchors() []byte {
 	return f.trustAnchorsFn()
 }
 
+func (f *Fake) RunRotation(ctx context.Context) error {
+	return f.runRotationFn(ctx)
+}
+
 func (f *Fake) Generate(ctx context.Context, req *jwt.Request) (string, error) {
 	return f.generateJWTFn(ctx, req)
 }
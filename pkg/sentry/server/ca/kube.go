@@ -15,10 +15,14 @@ package ca
 
 import (
 	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"path/filepath"
 
 	"github.com/lestrrat-go/jwx/v2/jwk"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
@@ -31,6 +35,9 @@ const (
 	// issuer certificate key pair and trust anchors, and configmap that holds
 	// the trust anchors.
 	TrustBundleK8sName = "dapr-trust-bundle" /* #nosec */
+
+	// sentryPodSelector is the label selector of the Sentry pods.
+	sentryPodSelector = "app=dapr-sentry"
 )
 
 // kube is a store that uses Kubernetes as the secret store.
@@ -38,6 +45,10 @@ type kube struct {
 	config    config.Config
 	namespace string
 	client    kubernetes.Interface
+
+	// signer is the external signer of the issuer, if any. When set, the issuer
+	// key is not stored in the secret.
+	signer crypto.Signer
 }
 
 // get retrieves the existing certificate bundle from Kubernetes.
@@ -53,7 +64,7 @@ func (k *kube) get(ctx context.Context) (bundle.Bundle, error) {
 	issChainPEM, hasIssuerCert := secret.Data[filepath.Base(k.config.IssuerCertPath)]
 	issKeyPEM, hasIssuerKey := secret.Data[filepath.Base(k.config.IssuerKeyPath)]
 
-	generateX509 := !hasRootCert || !hasIssuerCert || !hasIssuerKey
+	generateX509 := !hasRootCert || !hasIssuerCert || (!hasIssuerKey && k.signer == nil)
 
 	// Also check if the ConfigMap is in sync
 	configMap, err := k.client.CoreV1().ConfigMaps(k.namespace).Get(ctx, TrustBundleK8sName, metav1.GetOptions{})
@@ -68,12 +79,37 @@ func (k *kube) get(ctx context.Context) (bundle.Bundle, error) {
 	// Create a bundle if certificates are available
 	var bndle bundle.Bundle
 	if !generateX509 {
-		bndle.X509, err = verifyX509Bundle(trustAnchors, issChainPEM, issKeyPEM)
+		if k.signer != nil {
+			bndle.X509, err = verifyX509BundleWithSigner(trustAnchors, issChainPEM, k.signer)
+		} else {
+			bndle.X509, err = verifyX509Bundle(trustAnchors, issChainPEM, issKeyPEM)
+		}
 		if err != nil {
 			return bundle.Bundle{}, fmt.Errorf("failed to verify CA bundle: %w", err)
 		}
 	}
 
+	// Check for staged credentials of a trust anchor rotation
+	stagedTrustAnchors, hasStagedRootCert := secret.Data[filepath.Base(k.config.RootCertPath)+StagedSuffix]
+	stagedIssChainPEM, hasStagedIssuerCert := secret.Data[filepath.Base(k.config.IssuerCertPath)+StagedSuffix]
+	stagedIssKeyPEM, hasStagedIssuerKey := secret.Data[filepath.Base(k.config.IssuerKeyPath)+StagedSuffix]
+	if k.signer != nil && (hasStagedRootCert || hasStagedIssuerCert || hasStagedIssuerKey) {
+		return bundle.Bundle{}, errors.New("staged credentials found: trust anchor rotation is not supported with an external signer")
+	}
+	if hasStagedRootCert && hasStagedIssuerCert && hasStagedIssuerKey {
+		bndle.Next, err = verifyX509Bundle(stagedTrustAnchors, stagedIssChainPEM, stagedIssKeyPEM)
+		if err != nil {
+			return bundle.Bundle{}, fmt.Errorf("failed to verify staged CA bundle: %w", err)
+		}
+	}
+
+	if rotation, ok := secret.Data[RotationFilename]; ok {
+		bndle.Rotation, err = decodeRotation(rotation)
+		if err != nil {
+			return bundle.Bundle{}, err
+		}
+	}
+
 	// Check for JWT signing key and JWKS
 	jwtKeyPEM, hasJWTKey := secret.Data[filepath.Base(k.config.JWT.SigningKeyPath)]
 	jwks, hasJWKS := secret.Data[filepath.Base(k.config.JWT.JWKSPath)]
@@ -117,7 +153,22 @@ func (k *kube) store(ctx context.Context, bundle bundle.Bundle) error {
 	// Add all required certificates and keys
 	secret.Data[filepath.Base(k.config.RootCertPath)] = bundle.X509.TrustAnchors
 	secret.Data[filepath.Base(k.config.IssuerCertPath)] = bundle.X509.IssChainPEM
-	secret.Data[filepath.Base(k.config.IssuerKeyPath)] = bundle.X509.IssKeyPEM
+	if bundle.X509.IssKeyPEM != nil {
+		secret.Data[filepath.Base(k.config.IssuerKeyPath)] = bundle.X509.IssKeyPEM
+	} else {
+		delete(secret.Data, filepath.Base(k.config.IssuerKeyPath))
+	}
+
+	// Add the state of the trust anchor rotation in progress, if any
+	if bundle.Rotation != nil {
+		rotation, err := json.Marshal(bundle.Rotation)
+		if err != nil {
+			return fmt.Errorf("failed to encode trust anchor rotation state: %w", err)
+		}
+		secret.Data[RotationFilename] = rotation
+	} else {
+		delete(secret.Data, RotationFilename)
+	}
 
 	// Add JWT related data if available
 	if bundle.JWT != nil {
@@ -160,3 +211,19 @@ func (k *kube) store(ctx context.Context, bundle bundle.Bundle) error {
 
 	return nil
 }
+
+// instances returns the number of Sentry pods running in the namespace.
+func (k *kube) instances(ctx context.Context) (int, error) {
+	pods, err := k.client.CoreV1().Pods(k.namespace).List(ctx, metav1.ListOptions{LabelSelector: sentryPodSelector})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list Sentry pods: %w", err)
+	}
+
+	var n int
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp == nil && pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			n++
+		}
+	}
+	return n, nil
+}
@@ -533,6 +533,35 @@ func TestKube_get(t *testing.T) {
 	}
 }
 
+func TestKube_instances(t *testing.T) {
+	pod := func(name string, labels map[string]string, phase corev1.PodPhase, deleting bool) runtime.Object {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "dapr-system-test", Labels: labels},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+		if deleting {
+			p.DeletionTimestamp = &metav1.Time{}
+		}
+		return p
+	}
+	sentry := map[string]string{"app": "dapr-sentry"}
+
+	k := &kube{
+		client: fake.NewSimpleClientset(
+			pod("sentry-1", sentry, corev1.PodRunning, false),
+			pod("sentry-2", sentry, corev1.PodPending, false),
+			pod("sentry-3", sentry, corev1.PodRunning, true),
+			pod("sentry-4", sentry, corev1.PodFailed, false),
+			pod("operator", map[string]string{"app": "dapr-operator"}, corev1.PodRunning, false),
+		),
+		namespace: "dapr-system-test",
+	}
+
+	n, err := k.instances(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
 func bundlesEqual(t *testing.T, expected, actual ca_bundle.Bundle) {
 	t.Helper()
 
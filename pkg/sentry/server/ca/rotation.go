@@ -0,0 +1,207 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dapr/dapr/pkg/sentry/monitoring"
+	bundle "github.com/dapr/dapr/pkg/sentry/server/ca/bundle"
+)
+
+const (
+	// StagedSuffix is the suffix of the file names (or secret keys) of the
+	// staged root certificate, issuer certificate and issuer key a trust anchor
+	// rotation rotates to.
+	StagedSuffix = ".next"
+
+	// RotationFilename is the file name (or secret key) holding the state of
+	// the trust anchor rotation in progress, next to the root certificate.
+	RotationFilename = "rotation.json"
+)
+
+// rotate advances the trust anchor rotation of the bundle, if any. Returns
+// true if the bundle changed and needs to be persisted.
+func (c *ca) rotate(bndle *bundle.Bundle) (bool, error) {
+	res, err := bundle.Rotate(bundle.OptionsRotation{
+		Current:           bndle.X509,
+		Next:              bndle.Next,
+		Rotation:          bndle.Rotation,
+		Now:               c.clock.Now(),
+		PropagationPeriod: c.config.TrustAnchorPropagationPeriod,
+		IssuedCertTTL:     c.issuedCertTTL(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to rotate trust anchors: %w", err)
+	}
+
+	bndle.X509 = res.X509
+	bndle.Rotation = res.Rotation
+
+	for _, transition := range res.Transitions {
+		monitoring.TrustAnchorRotationPhaseChanged(transition)
+		switch transition {
+		case "Cancelled":
+			log.Warn("Staged trust anchors removed or replaced: trust anchor rotation cancelled, old trust anchors restored")
+		case "Complete":
+			log.Info("Old trust anchors removed: trust anchor rotation complete")
+		case string(bundle.RotationPhasePublishing):
+			log.Infof("Staged trust anchors found: publishing new root alongside the old one until %s", c.rotationDeadline(res.Rotation).Format(time.RFC3339))
+		case string(bundle.RotationPhaseIssuing):
+			log.Infof("Issuing from the new issuer: publishing old root until %s", c.rotationDeadline(res.Rotation).Format(time.RFC3339))
+		}
+	}
+
+	if res.Rotation != nil && !res.Changed() {
+		log.Infof("Trust anchor rotation in phase %s until %s", res.Rotation.Phase, c.rotationDeadline(res.Rotation).Format(time.RFC3339))
+	}
+	monitoring.TrustAnchorRotationPhase(rotationPhaseValue(res.Rotation))
+
+	return res.Changed(), nil
+}
+
+// RunRotation blocks until the current phase of the trust anchor rotation in
+// progress ends, or the context is cancelled. Returning makes Sentry reload
+// the CA, which advances the rotation.
+// The new root is published until the propagation period has passed and no
+// client reported other trust anchors for the lifetime of an issued
+// certificate, during which every client renews its certificate. The new
+// trust anchors are then recorded as propagated in the rotation state. Each
+// Sentry instance only observes the clients it serves, so the propagation is
+// only recorded while a single instance is running, and a rotation only
+// starts then.
+func (c *ca) RunRotation(ctx context.Context) error {
+	rotation := c.bundle.Rotation
+	if rotation == nil {
+		<-ctx.Done()
+		return nil
+	}
+
+	waitPropagation := rotation.Phase == bundle.RotationPhasePublishing && !rotation.Propagated
+	for {
+		deadline := c.rotationDeadline(rotation)
+		if waitPropagation {
+			if propagated := c.propagationDeadline(); propagated.After(deadline) {
+				deadline = propagated
+			}
+		}
+
+		timer := c.clock.NewTimer(deadline.Sub(c.clock.Now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C():
+		}
+
+		if !waitPropagation {
+			log.Infof("Trust anchor rotation phase %s ended; reloading", rotation.Phase)
+			return nil
+		}
+
+		if c.propagationDeadline().After(c.clock.Now()) {
+			log.Info("Clients still report the old trust anchors: publishing the new root alongside the old one")
+			continue
+		}
+
+		if err := c.singleInstance(ctx); err != nil {
+			// Clients of the other instances may still use the old trust anchors.
+			log.Errorf("Not recording the propagation of the new trust anchors: %v", err)
+			c.lock.Lock()
+			c.lastStaleReport = c.clock.Now()
+			c.lock.Unlock()
+			continue
+		}
+
+		propagated := *rotation
+		propagated.Propagated = true
+		bndle := c.bundle
+		bndle.Rotation = &propagated
+		if err := c.store.store(ctx, bndle); err != nil {
+			return fmt.Errorf("failed to store trust anchor rotation state: %w", err)
+		}
+		log.Info("New trust anchors propagated to every client; reloading")
+		return nil
+	}
+}
+
+// observeTrustAnchors records the version of the trust anchors reported by a
+// client, to tell when the new root published by a trust anchor rotation has
+// propagated. Clients which don't report it are assumed to use the old
+// trust anchors.
+func (c *ca) observeTrustAnchors(version string) {
+	rotation := c.bundle.Rotation
+	if rotation == nil || rotation.Phase != bundle.RotationPhasePublishing || version == c.trustAnchorsVersion {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.lastStaleReport = c.clock.Now()
+}
+
+// singleInstance returns an error unless this is the only Sentry instance
+// sharing the trust bundle.
+func (c *ca) singleInstance(ctx context.Context) error {
+	n, err := c.store.instances(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count Sentry instances: %w", err)
+	}
+	if n > 1 {
+		return fmt.Errorf("%d Sentry instances are running: trust anchor rotation requires a single instance", n)
+	}
+	return nil
+}
+
+// propagationDeadline returns the time the new trust anchors are considered
+// propagated, if no client reports other trust anchors until then.
+func (c *ca) propagationDeadline() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.lastStaleReport.Add(c.issuedCertTTL())
+}
+
+func (c *ca) rotationDeadline(rotation *bundle.Rotation) time.Time {
+	return rotation.Deadline(c.config.TrustAnchorPropagationPeriod, c.issuedCertTTL())
+}
+
+// issuedCertTTL is the longest lifetime of a certificate issued by the CA.
+func (c *ca) issuedCertTTL() time.Duration {
+	return c.config.WorkloadCertTTL + c.config.AllowedClockSkew
+}
+
+// rotationPhaseValue returns the value of the trust anchor rotation phase
+// metric: 0 when no rotation is in progress, 1 when publishing the new root
+// and 2 when issuing from the new issuer.
+func rotationPhaseValue(rotation *bundle.Rotation) int64 {
+	switch {
+	case rotation == nil:
+		return 0
+	case rotation.Phase == bundle.RotationPhasePublishing:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func decodeRotation(data []byte) (*bundle.Rotation, error) {
+	var rotation bundle.Rotation
+	if err := json.Unmarshal(data, &rotation); err != nil {
+		return nil, fmt.Errorf("failed to decode trust anchor rotation state: %w", err)
+	}
+	return &rotation, nil
+}
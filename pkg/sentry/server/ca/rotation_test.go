@@ -0,0 +1,338 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/sentry/config"
+	"github.com/dapr/dapr/pkg/sentry/server/ca/bundle"
+)
+
+func TestRotation(t *testing.T) {
+	dir := t.TempDir()
+	conf := config.Config{
+		RootCertPath:                 filepath.Join(dir, "ca.crt"),
+		IssuerCertPath:               filepath.Join(dir, "issuer.crt"),
+		IssuerKeyPath:                filepath.Join(dir, "issuer.key"),
+		Mode:                         modes.StandaloneMode,
+		WorkloadCertTTL:              time.Hour,
+		TrustAnchorPropagationPeriod: time.Hour,
+	}
+	rotationPath := filepath.Join(dir, RotationFilename)
+
+	oldRootPEM, oldRootCrt, _, oldRootPK := genCrt(t, "old-root", nil, nil)
+	oldIntPEM, oldIntCrt, oldIntPKPEM, _ := genCrt(t, "old-int", oldRootCrt, oldRootPK)
+	newRootPEM, newRootCrt, _, newRootPK := genCrt(t, "new-root", nil, nil)
+	newIntPEM, newIntCrt, newIntPKPEM, _ := genCrt(t, "new-int", newRootCrt, newRootPK)
+
+	require.NoError(t, os.WriteFile(conf.RootCertPath, oldRootPEM, 0o600))
+	require.NoError(t, os.WriteFile(conf.IssuerCertPath, oldIntPEM, 0o600))
+	require.NoError(t, os.WriteFile(conf.IssuerKeyPath, oldIntPKPEM, 0o600))
+
+	//nolint:gocritic
+	combined := append(oldRootPEM, newRootPEM...)
+
+	// updateRotation updates the state of the rotation in progress.
+	updateRotation := func(t *testing.T, fn func(*bundle.Rotation)) {
+		t.Helper()
+		data, err := os.ReadFile(rotationPath)
+		require.NoError(t, err)
+		var rotation bundle.Rotation
+		require.NoError(t, json.Unmarshal(data, &rotation))
+		fn(&rotation)
+		data, err = json.Marshal(rotation)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(rotationPath, data, 0o600))
+	}
+
+	// backdate moves the start of the current rotation phase into the past, so
+	// the next load of the CA advances the rotation.
+	backdate := func(t *testing.T, d time.Duration) {
+		t.Helper()
+		updateRotation(t, func(rotation *bundle.Rotation) {
+			rotation.PhaseStarted = rotation.PhaseStarted.Add(-d)
+		})
+	}
+
+	t.Run("without staged credentials, no rotation should start", func(t *testing.T) {
+		caImp, err := New(t.Context(), conf)
+		require.NoError(t, err)
+		assert.Equal(t, oldRootPEM, caImp.TrustAnchors())
+		assert.NoFileExists(t, rotationPath)
+	})
+
+	require.NoError(t, os.WriteFile(conf.RootCertPath+StagedSuffix, newRootPEM, 0o600))
+	require.NoError(t, os.WriteFile(conf.IssuerCertPath+StagedSuffix, newIntPEM, 0o600))
+	require.NoError(t, os.WriteFile(conf.IssuerKeyPath+StagedSuffix, newIntPKPEM, 0o600))
+
+	t.Run("staged credentials should publish the new root alongside the old one", func(t *testing.T) {
+		caImp, err := New(t.Context(), conf)
+		require.NoError(t, err)
+		assert.Equal(t, combined, caImp.TrustAnchors())
+		assert.Equal(t, oldIntCrt, caImp.(*ca).bundle.X509.IssChain[0])
+
+		rootCert, err := os.ReadFile(conf.RootCertPath)
+		require.NoError(t, err)
+		assert.Equal(t, combined, rootCert)
+		assert.FileExists(t, rotationPath)
+
+		// Reloading before the end of the phase should not change anything.
+		caImp, err = New(t.Context(), conf)
+		require.NoError(t, err)
+		assert.Equal(t, oldIntCrt, caImp.(*ca).bundle.X509.IssChain[0])
+	})
+
+	t.Run("after the propagation period, the new issuer should not issue before the new root propagated", func(t *testing.T) {
+		backdate(t, time.Hour)
+
+		caImp, err := New(t.Context(), conf)
+		require.NoError(t, err)
+		assert.Equal(t, combined, caImp.TrustAnchors())
+		assert.Equal(t, oldIntCrt, caImp.(*ca).bundle.X509.IssChain[0])
+	})
+
+	t.Run("after the propagation period, the new issuer should issue once the new root propagated", func(t *testing.T) {
+		updateRotation(t, func(rotation *bundle.Rotation) {
+			rotation.Propagated = true
+		})
+
+		caImp, err := New(t.Context(), conf)
+		require.NoError(t, err)
+		assert.Equal(t, combined, caImp.TrustAnchors())
+		assert.Equal(t, newIntCrt, caImp.(*ca).bundle.X509.IssChain[0])
+
+		issuerCert, err := os.ReadFile(conf.IssuerCertPath)
+		require.NoError(t, err)
+		assert.Equal(t, newIntPEM, issuerCert)
+		issuerKey, err := os.ReadFile(conf.IssuerKeyPath)
+		require.NoError(t, err)
+		assert.Equal(t, newIntPKPEM, issuerKey)
+	})
+
+	t.Run("after the issued certificates expired, the old root should be removed", func(t *testing.T) {
+		backdate(t, time.Hour)
+
+		caImp, err := New(t.Context(), conf)
+		require.NoError(t, err)
+		assert.Equal(t, newRootPEM, caImp.TrustAnchors())
+		assert.Equal(t, newIntCrt, caImp.(*ca).bundle.X509.IssChain[0])
+		assert.NoFileExists(t, rotationPath)
+
+		rootCert, err := os.ReadFile(conf.RootCertPath)
+		require.NoError(t, err)
+		assert.Equal(t, newRootPEM, rootCert)
+
+		// Staged credentials left in place should not start another rotation.
+		caImp, err = New(t.Context(), conf)
+		require.NoError(t, err)
+		assert.Equal(t, newRootPEM, caImp.TrustAnchors())
+		assert.NoFileExists(t, rotationPath)
+	})
+}
+
+func TestRunRotation(t *testing.T) {
+	t.Run("without rotation, should block until the context is cancelled", func(t *testing.T) {
+		c := &ca{clock: clocktesting.NewFakeClock(time.Now())}
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		require.NoError(t, c.RunRotation(ctx))
+	})
+
+	t.Run("should return when the current phase ends", func(t *testing.T) {
+		now := time.Now()
+		clock := clocktesting.NewFakeClock(now)
+		c := &ca{
+			clock:  clock,
+			config: config.Config{WorkloadCertTTL: time.Minute},
+			bundle: bundle.Bundle{Rotation: &bundle.Rotation{
+				Phase:        bundle.RotationPhaseIssuing,
+				PhaseStarted: now,
+			}},
+		}
+
+		errCh := make(chan error)
+		go func() { errCh <- c.RunRotation(t.Context()) }()
+
+		assert.Eventually(t, clock.HasWaiters, time.Second, time.Millisecond*10)
+		clock.Step(time.Minute - time.Second)
+		select {
+		case <-errCh:
+			t.Fatal("expected RunRotation to block until the end of the phase")
+		case <-time.After(time.Millisecond * 50):
+		}
+
+		clock.Step(time.Second)
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(time.Second * 5):
+			t.Fatal("expected RunRotation to return at the end of the phase")
+		}
+	})
+	t.Run("should record the propagation of the new root when no client reported the old trust anchors", func(t *testing.T) {
+		now := time.Now()
+		clock := clocktesting.NewFakeClock(now)
+		store := &fakeStore{}
+		c := &ca{
+			clock: clock,
+			store: store,
+			config: config.Config{
+				TrustAnchorPropagationPeriod: time.Minute,
+				WorkloadCertTTL:              time.Hour,
+			},
+			bundle: bundle.Bundle{Rotation: &bundle.Rotation{
+				Phase:        bundle.RotationPhasePublishing,
+				PhaseStarted: now,
+			}},
+			trustAnchorsVersion: "new",
+			lastStaleReport:     now,
+		}
+
+		errCh := make(chan error)
+		go func() { errCh <- c.RunRotation(t.Context()) }()
+
+		// Clients reporting the old trust anchors delay the end of the phase.
+		assert.Eventually(t, clock.HasWaiters, time.Second, time.Millisecond*10)
+		clock.Step(time.Minute)
+		c.observeTrustAnchors("old")
+		c.observeTrustAnchors("")
+		c.observeTrustAnchors("new")
+		clock.Step(time.Hour - time.Minute)
+
+		assert.Eventually(t, clock.HasWaiters, time.Second, time.Millisecond*10)
+		select {
+		case <-errCh:
+			t.Fatal("expected RunRotation to block until the new root propagated")
+		case <-time.After(time.Millisecond * 50):
+		}
+		assert.Nil(t, store.stored)
+
+		clock.Step(time.Minute)
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(time.Second * 5):
+			t.Fatal("expected RunRotation to return once the new root propagated")
+		}
+
+		require.NotNil(t, store.stored)
+		require.NotNil(t, store.stored.Rotation)
+		assert.True(t, store.stored.Rotation.Propagated)
+		assert.False(t, c.bundle.Rotation.Propagated)
+	})
+
+	t.Run("should not record the propagation of the new root while other Sentry instances run", func(t *testing.T) {
+		now := time.Now()
+		clock := clocktesting.NewFakeClock(now)
+		store := &fakeStore{count: 2}
+		c := &ca{
+			clock: clock,
+			store: store,
+			config: config.Config{
+				TrustAnchorPropagationPeriod: time.Minute,
+				WorkloadCertTTL:              time.Hour,
+			},
+			bundle: bundle.Bundle{Rotation: &bundle.Rotation{
+				Phase:        bundle.RotationPhasePublishing,
+				PhaseStarted: now,
+			}},
+			trustAnchorsVersion: "new",
+			lastStaleReport:     now,
+		}
+
+		errCh := make(chan error)
+		go func() { errCh <- c.RunRotation(t.Context()) }()
+
+		assert.Eventually(t, clock.HasWaiters, time.Second, time.Millisecond*10)
+		clock.Step(time.Hour)
+
+		// The propagation window starts again as long as other instances run.
+		assert.Eventually(t, func() bool {
+			return c.propagationDeadline().Equal(now.Add(2 * time.Hour))
+		}, time.Second*5, time.Millisecond*10)
+		select {
+		case <-errCh:
+			t.Fatal("expected RunRotation to block while other Sentry instances run")
+		case <-time.After(time.Millisecond * 50):
+		}
+		assert.Nil(t, store.stored)
+
+		assert.Eventually(t, clock.HasWaiters, time.Second, time.Millisecond*10)
+		store.count = 1
+		clock.Step(time.Hour)
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(time.Second * 5):
+			t.Fatal("expected RunRotation to return once a single instance runs")
+		}
+
+		require.NotNil(t, store.stored)
+		assert.True(t, store.stored.Rotation.Propagated)
+	})
+}
+
+func TestRotationExternalSigner(t *testing.T) {
+	dir := t.TempDir()
+	rootCertPath := filepath.Join(dir, "ca.crt")
+	rootPEM, _, _, rootPK := genCrt(t, "root", nil, nil)
+	require.NoError(t, os.WriteFile(rootCertPath+StagedSuffix, rootPEM, 0o600))
+
+	s := &selfhosted{
+		config: config.Config{
+			RootCertPath:   rootCertPath,
+			IssuerCertPath: filepath.Join(dir, "issuer.crt"),
+			IssuerKeyPath:  filepath.Join(dir, "issuer.key"),
+		},
+		signer: rootPK,
+	}
+	_, err := s.loadAndValidateStagedX509Bundle()
+	require.ErrorContains(t, err, "trust anchor rotation is not supported with an external signer")
+
+	s.signer = nil
+	next, err := s.loadAndValidateStagedX509Bundle()
+	require.NoError(t, err)
+	assert.Nil(t, next)
+}
+
+// fakeStore is a store recording the last bundle stored.
+type fakeStore struct {
+	stored *bundle.Bundle
+	count  int
+}
+
+func (f *fakeStore) store(_ context.Context, bndle bundle.Bundle) error {
+	f.stored = &bndle
+	return nil
+}
+
+func (f *fakeStore) get(context.Context) (bundle.Bundle, error) {
+	return bundle.Bundle{}, nil
+}
+
+func (f *fakeStore) instances(context.Context) (int, error) {
+	return f.count, nil
+}
@@ -15,8 +15,12 @@ package ca
 
 import (
 	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/lestrrat-go/jwx/v2/jwk"
 
@@ -27,6 +31,10 @@ import (
 // selfhosted is a store that uses the file system as the secret store.
 type selfhosted struct {
 	config config.Config
+
+	// signer is the external signer of the issuer, if any. When set, the issuer
+	// key is not read from disk.
+	signer crypto.Signer
 }
 
 // store saves the certificate bundle to the local filesystem.
@@ -53,9 +61,35 @@ func (s *selfhosted) store(_ context.Context, bundle bundle.Bundle) error {
 		}
 	}
 
+	if bundle.Rotation == nil {
+		if err := os.Remove(s.rotationPath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove trust anchor rotation state: %w", err)
+		}
+		return nil
+	}
+
+	rotation, err := json.Marshal(bundle.Rotation)
+	if err != nil {
+		return fmt.Errorf("failed to encode trust anchor rotation state: %w", err)
+	}
+	if err := os.WriteFile(s.rotationPath(), rotation, 0o600); err != nil {
+		return fmt.Errorf("failed to write file %s: %w", s.rotationPath(), err)
+	}
+
 	return nil
 }
 
+// rotationPath is the path of the trust anchor rotation state, next to the
+// root certificate.
+// instances returns 1: the files of a self-hosted Sentry aren't shared.
+func (s *selfhosted) instances(context.Context) (int, error) {
+	return 1, nil
+}
+
+func (s *selfhosted) rotationPath() string {
+	return filepath.Join(filepath.Dir(s.config.RootCertPath), RotationFilename)
+}
+
 // get retrieves the existing certificate bundle from the filesystem.
 func (s *selfhosted) get(_ context.Context) (bundle.Bundle, error) {
 	x509, err := s.loadAndValidateX509Bundle()
@@ -68,12 +102,62 @@ func (s *selfhosted) get(_ context.Context) (bundle.Bundle, error) {
 		return bundle.Bundle{}, err
 	}
 
+	next, err := s.loadAndValidateStagedX509Bundle()
+	if err != nil {
+		return bundle.Bundle{}, err
+	}
+
+	var rotation *bundle.Rotation
+	rotationJSON, err := os.ReadFile(s.rotationPath())
+	switch {
+	case err == nil:
+		rotation, err = decodeRotation(rotationJSON)
+		if err != nil {
+			return bundle.Bundle{}, err
+		}
+	case !os.IsNotExist(err):
+		return bundle.Bundle{}, fmt.Errorf("failed to read trust anchor rotation state: %w", err)
+	}
+
 	return bundle.Bundle{
-		X509: x509,
-		JWT:  jwt,
+		X509:     x509,
+		JWT:      jwt,
+		Next:     next,
+		Rotation: rotation,
 	}, nil
 }
 
+// loadAndValidateStagedX509Bundle loads the staged X.509 certificates and key
+// a trust anchor rotation rotates to, and verifies them. Returns nil if any
+// are missing.
+func (s *selfhosted) loadAndValidateStagedX509Bundle() (*bundle.X509, error) {
+	staged := make([][]byte, 0, 3)
+	for _, path := range []string{s.config.RootCertPath, s.config.IssuerCertPath, s.config.IssuerKeyPath} {
+		data, err := os.ReadFile(path + StagedSuffix)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read staged credentials: %w", err)
+		}
+		staged = append(staged, data)
+	}
+
+	if s.signer != nil && len(staged) > 0 {
+		return nil, errors.New("staged credentials found: trust anchor rotation is not supported with an external signer")
+	}
+	if len(staged) < 3 {
+		return nil, nil
+	}
+
+	verifiedBundle, err := verifyX509Bundle(staged[0], staged[1], staged[2])
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify staged CA bundle: %w", err)
+	}
+
+	return verifiedBundle, nil
+}
+
 // loadAndValidateX509Bundle loads the X.509 certificates and keys from disk, verifies them, and updates the bundle. Returns whether any are missing.
 func (s *selfhosted) loadAndValidateX509Bundle() (*bundle.X509, error) {
 	// Read trust anchors (root certificate)
@@ -94,6 +178,14 @@ func (s *selfhosted) loadAndValidateX509Bundle() (*bundle.X509, error) {
 		return nil, fmt.Errorf("failed to read issuer certificate: %w", err)
 	}
 
+	if s.signer != nil {
+		verifiedBundle, verr := verifyX509BundleWithSigner(trustAnchors, issChainPEM, s.signer)
+		if verr != nil {
+			return nil, fmt.Errorf("failed to verify CA bundle: %w", verr)
+		}
+		return verifiedBundle, nil
+	}
+
 	// Read issuer private key
 	issKeyPEM, err := os.ReadFile(s.config.IssuerKeyPath)
 	if os.IsNotExist(err) {
@@ -34,6 +34,29 @@ import (
 // Returns error if any of the verification fails.
 // Returned CA bundle is ready for sentry.
 func verifyX509Bundle(trustAnchors, issChainPEM, issKeyPEM []byte) (*bundle.X509, error) {
+	issKey, err := pem.DecodePEMPrivateKey(issKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	issKeyPEM, err = pem.EncodePrivateKey(issKey)
+	if err != nil {
+		return nil, err
+	}
+
+	x509Bundle, err := verifyX509BundleWithSigner(trustAnchors, issChainPEM, issKey)
+	if err != nil {
+		return nil, err
+	}
+	x509Bundle.IssKeyPEM = issKeyPEM
+
+	return x509Bundle, nil
+}
+
+// verifyX509BundleWithSigner verifies the issuer certificate chain, and trust
+// anchor set, against an issuer key held by the given signer. The returned CA
+// bundle has no issuer key PEM, since the key isn't available to sentry.
+func verifyX509BundleWithSigner(trustAnchors, issChainPEM []byte, issKey crypto.Signer) (*bundle.X509, error) {
 	trustAnchorsX509, err := pem.DecodePEMCertificates(trustAnchors)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode trust anchors: %w", err)
@@ -86,16 +109,6 @@ func verifyX509Bundle(trustAnchors, issChainPEM, issKeyPEM []byte) (*bundle.X509
 		return nil, errors.New("issuer chain is empty after re-encoding")
 	}
 
-	issKey, err := pem.DecodePEMPrivateKey(issKeyPEM)
-	if err != nil {
-		return nil, err
-	}
-
-	issKeyPEM, err = pem.EncodePrivateKey(issKey)
-	if err != nil {
-		return nil, err
-	}
-
 	// Ensure issuer key matches the issuer certificate.
 	ok, err := pem.PublicKeysEqual(issKey.Public(), issChain[0].PublicKey)
 	if err != nil {
@@ -127,7 +140,6 @@ func verifyX509Bundle(trustAnchors, issChainPEM, issKeyPEM []byte) (*bundle.X509
 		TrustAnchors: trustAnchors,
 		IssChainPEM:  issChainPEM,
 		IssChain:     issChain,
-		IssKeyPEM:    issKeyPEM,
 		IssKey:       issKey,
 	}, nil
 }
@@ -0,0 +1,142 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package denylist
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/dapr/pkg/sentry/config"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.sentry.denylist")
+
+// reloadInterval is how often the deny-list is reloaded from the
+// configuration.
+const reloadInterval = 10 * time.Second
+
+// Options are the options of the deny-list.
+type Options struct {
+	// DenyList is the initial deny-list.
+	DenyList config.ConfigDenyList
+
+	// Load loads the current deny-list from the configuration. When set, it is
+	// polled so that changes to the configuration are applied without
+	// restarting Sentry.
+	Load func() (config.ConfigDenyList, error)
+
+	// Clock is the clock used to poll the configuration. Defaults to the real
+	// clock.
+	Clock clock.WithTicker
+}
+
+// DenyList is the list of SPIFFE IDs and namespaces Sentry refuses to sign
+// certificates for.
+type DenyList struct {
+	lock       sync.RWMutex
+	current    config.ConfigDenyList
+	spiffeIDs  map[string]struct{}
+	namespaces map[string]struct{}
+
+	load  func() (config.ConfigDenyList, error)
+	clock clock.WithTicker
+}
+
+func New(opts Options) *DenyList {
+	d := &DenyList{
+		load:  opts.Load,
+		clock: opts.Clock,
+	}
+	if d.clock == nil {
+		d.clock = clock.RealClock{}
+	}
+	d.set(opts.DenyList)
+	return d
+}
+
+// Denied returns the reason a certificate for the SPIFFE ID in namespace is
+// denied, or false if it isn't.
+func (d *DenyList) Denied(spiffeID, namespace string) (string, bool) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	if _, ok := d.namespaces[namespace]; ok {
+		return fmt.Sprintf("namespace %q is denied", namespace), true
+	}
+	if _, ok := d.spiffeIDs[spiffeID]; ok {
+		return fmt.Sprintf("SPIFFE ID %q is denied", spiffeID), true
+	}
+	return "", false
+}
+
+// Run reloads the deny-list from the configuration until the context is
+// cancelled.
+func (d *DenyList) Run(ctx context.Context) error {
+	if d.load == nil {
+		<-ctx.Done()
+		return nil
+	}
+
+	ticker := d.clock.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+			denyList, err := d.load()
+			if err != nil {
+				log.Warnf("Failed to reload deny-list, keeping the current one: %s", err)
+				continue
+			}
+			d.set(denyList)
+		}
+	}
+}
+
+func (d *DenyList) set(denyList config.ConfigDenyList) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	loaded := d.spiffeIDs != nil
+	if loaded &&
+		slices.Equal(d.current.SPIFFEIDs, denyList.SPIFFEIDs) &&
+		slices.Equal(d.current.Namespaces, denyList.Namespaces) {
+		return
+	}
+
+	d.current = denyList
+	d.spiffeIDs = make(map[string]struct{}, len(denyList.SPIFFEIDs))
+	for _, id := range denyList.SPIFFEIDs {
+		d.spiffeIDs[id] = struct{}{}
+	}
+	d.namespaces = make(map[string]struct{}, len(denyList.Namespaces))
+	for _, ns := range denyList.Namespaces {
+		d.namespaces[ns] = struct{}{}
+	}
+
+	switch {
+	case len(denyList.SPIFFEIDs) > 0 || len(denyList.Namespaces) > 0:
+		log.Infof("Denying certificates for SPIFFE IDs %v and namespaces %v", denyList.SPIFFEIDs, denyList.Namespaces)
+	case loaded:
+		log.Info("Deny-list is empty: no longer denying certificates")
+	}
+}
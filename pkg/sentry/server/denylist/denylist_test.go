@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package denylist
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/sentry/config"
+)
+
+func TestDenied(t *testing.T) {
+	d := New(Options{
+		DenyList: config.ConfigDenyList{
+			SPIFFEIDs:  []string{"spiffe://example.com/ns/default/bad-app"},
+			Namespaces: []string{"compromised"},
+		},
+	})
+
+	reason, denied := d.Denied("spiffe://example.com/ns/default/bad-app", "default")
+	assert.True(t, denied)
+	assert.Contains(t, reason, "spiffe://example.com/ns/default/bad-app")
+
+	reason, denied = d.Denied("spiffe://example.com/ns/compromised/app", "compromised")
+	assert.True(t, denied)
+	assert.Contains(t, reason, "compromised")
+
+	_, denied = d.Denied("spiffe://example.com/ns/default/good-app", "default")
+	assert.False(t, denied)
+
+	_, denied = d.Denied("spiffe://other.com/ns/default/bad-app", "default")
+	assert.False(t, denied)
+}
+
+func TestRun(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+
+	var loadErr atomic.Bool
+	var current atomic.Pointer[config.ConfigDenyList]
+	current.Store(&config.ConfigDenyList{})
+
+	d := New(Options{
+		Clock: clock,
+		Load: func() (config.ConfigDenyList, error) {
+			if loadErr.Load() {
+				return config.ConfigDenyList{}, errors.New("load error")
+			}
+			return *current.Load(), nil
+		},
+	})
+
+	errCh := make(chan error)
+	go func() { errCh <- d.Run(t.Context()) }()
+	t.Cleanup(func() {
+		select {
+		case err := <-errCh:
+			assert.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Error("timed out waiting for Run to return")
+		}
+	})
+
+	assert.Eventually(t, clock.HasWaiters, time.Second, 10*time.Millisecond)
+
+	_, denied := d.Denied("spiffe://example.com/ns/default/app", "default")
+	assert.False(t, denied)
+
+	current.Store(&config.ConfigDenyList{Namespaces: []string{"default"}})
+	clock.Step(reloadInterval)
+	assert.Eventually(t, func() bool {
+		_, denied := d.Denied("spiffe://example.com/ns/default/app", "default")
+		return denied
+	}, time.Second, 10*time.Millisecond)
+
+	// Errors loading the configuration should keep the current deny-list.
+	loadErr.Store(true)
+	current.Store(&config.ConfigDenyList{})
+	clock.Step(reloadInterval)
+	assert.Never(t, func() bool {
+		_, denied := d.Denied("spiffe://example.com/ns/default/app", "default")
+		return !denied
+	}, 100*time.Millisecond, 10*time.Millisecond)
+
+	loadErr.Store(false)
+	clock.Step(reloadInterval)
+	assert.Eventually(t, func() bool {
+		_, denied := d.Denied("spiffe://example.com/ns/default/app", "default")
+		return !denied
+	}, time.Second, 10*time.Millisecond)
+}
@@ -19,10 +19,12 @@ import (
 	"encoding/pem"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -30,9 +32,12 @@ import (
 	"github.com/dapr/dapr/pkg/healthz"
 	sentryv1pb "github.com/dapr/dapr/pkg/proto/sentry/v1"
 	"github.com/dapr/dapr/pkg/security"
+	"github.com/dapr/dapr/pkg/security/spiffe"
 	"github.com/dapr/dapr/pkg/sentry/monitoring"
+	"github.com/dapr/dapr/pkg/sentry/server/audit"
 	"github.com/dapr/dapr/pkg/sentry/server/ca"
 	"github.com/dapr/dapr/pkg/sentry/server/ca/jwt"
+	"github.com/dapr/dapr/pkg/sentry/server/denylist"
 	"github.com/dapr/dapr/pkg/sentry/server/validator"
 	secpem "github.com/dapr/kit/crypto/pem"
 	"github.com/dapr/kit/logger"
@@ -68,6 +73,13 @@ type Options struct {
 
 	// JWTTTL is the time to live for the JWT token.
 	JWTTTL time.Duration
+
+	// DenyList is the list of SPIFFE IDs and namespaces to refuse to sign
+	// certificates for. Optional.
+	DenyList *denylist.DenyList
+
+	// Auditor records every certificate issued or denied. Optional.
+	Auditor *audit.Auditor
 }
 
 // Server is the gRPC server for the Sentry service.
@@ -81,6 +93,8 @@ type Server struct {
 	htarget          healthz.Target
 	jwtEnabled       bool
 	jwtTTL           time.Duration
+	denyList         *denylist.DenyList
+	auditor          *audit.Auditor
 }
 
 func New(opts Options) *Server {
@@ -94,6 +108,8 @@ func New(opts Options) *Server {
 		htarget:          opts.Healthz.AddTarget("sentry-server"),
 		jwtEnabled:       opts.JWTEnabled,
 		jwtTTL:           opts.JWTTTL,
+		denyList:         opts.DenyList,
+		auditor:          opts.Auditor,
 	}
 }
 
@@ -191,6 +207,35 @@ func (s *Server) signCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 		return nil, status.Error(codes.InvalidArgument, "invalid signature")
 	}
 
+	spiffeID, err := spiffe.FromStrings(res.TrustDomain, namespace, req.GetId())
+	if err != nil {
+		log.Debugf("Invalid SPIFFE ID for %s/%s: %s", namespace, req.GetId(), err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid identity: %v", err)
+	}
+
+	record := &audit.Record{
+		Time:      time.Now(),
+		SPIFFEID:  spiffeID.URL().String(),
+		Namespace: namespace,
+		AppID:     req.GetId(),
+		Validator: strings.ToLower(validator.String()),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		record.Requester = p.Addr.String()
+	}
+
+	if s.denyList != nil {
+		if reason, denied := s.denyList.Denied(record.SPIFFEID, namespace); denied {
+			log.Warnf("Refusing to sign certificate for %s: %s", record.SPIFFEID, reason)
+			record.Outcome = audit.OutcomeDenied
+			record.Reason = reason
+			if err = s.audit(ctx, record); err != nil {
+				log.Errorf("Error auditing denied certificate: %v", err)
+			}
+			return nil, status.Error(codes.PermissionDenied, "the requested identity is denied")
+		}
+	}
+
 	var dns []string
 	switch {
 	case req.GetNamespace() == security.CurrentNamespace() && req.GetId() == "dapr-injector":
@@ -206,12 +251,13 @@ func (s *Server) signCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 	}
 
 	chain, err := s.ca.SignIdentity(ctx, &ca.SignRequest{
-		PublicKey:          csr.PublicKey,
-		SignatureAlgorithm: csr.SignatureAlgorithm,
-		TrustDomain:        res.TrustDomain.String(),
-		Namespace:          namespace,
-		AppID:              req.GetId(),
-		DNS:                dns,
+		PublicKey:           csr.PublicKey,
+		SignatureAlgorithm:  csr.SignatureAlgorithm,
+		TrustDomain:         res.TrustDomain.String(),
+		Namespace:           namespace,
+		AppID:               req.GetId(),
+		DNS:                 dns,
+		TrustAnchorsVersion: req.GetTrustAnchorsVersion(),
 	})
 	if err != nil {
 		log.Errorf("Error signing identity: %v", err)
@@ -226,6 +272,15 @@ func (s *Server) signCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 
 	log.Debugf("Successfully signed certificate for %s/%s", namespace, req.GetId())
 
+	// The certificate is only returned once its issuance is audited.
+	record.Outcome = audit.OutcomeIssued
+	record.Serial = chain[0].SerialNumber.Text(16)
+	record.Expiry = chain[0].NotAfter
+	if err = s.audit(ctx, record); err != nil {
+		log.Errorf("Error auditing issued certificate: %v", err)
+		return nil, status.Error(codes.Internal, "failed to audit certificate")
+	}
+
 	var jwtToken *wrapperspb.StringValue
 	if s.jwtEnabled {
 		audiences := append([]string{res.TrustDomain.String()}, req.GetJwtAudiences()...) // Default audience is the trust domain
@@ -254,3 +309,10 @@ func (s *Server) signCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 		Jwt:                    jwtToken,
 	}, nil
 }
+
+func (s *Server) audit(ctx context.Context, record *audit.Record) error {
+	if s.auditor == nil {
+		return nil
+	}
+	return s.auditor.Audit(ctx, record)
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -41,8 +42,11 @@ import (
 	"github.com/dapr/dapr/pkg/healthz"
 	sentryv1pb "github.com/dapr/dapr/pkg/proto/sentry/v1"
 	securityfake "github.com/dapr/dapr/pkg/security/fake"
+	"github.com/dapr/dapr/pkg/sentry/config"
+	"github.com/dapr/dapr/pkg/sentry/server/audit"
 	"github.com/dapr/dapr/pkg/sentry/server/ca"
 	cafake "github.com/dapr/dapr/pkg/sentry/server/ca/fake"
+	"github.com/dapr/dapr/pkg/sentry/server/denylist"
 	"github.com/dapr/dapr/pkg/sentry/server/validator"
 	validatorfake "github.com/dapr/dapr/pkg/sentry/server/validator/fake"
 )
@@ -69,11 +73,21 @@ func TestRun(t *testing.T) {
 	require.NoError(t, err)
 	crtPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: crt})
 
+	// A closed auditor fails to write any record.
+	closedAuditor, err := audit.New(audit.Options{FilePath: filepath.Join(t.TempDir(), "audit.log")})
+	require.NoError(t, err)
+	closedCtx, closedCancel := context.WithCancel(t.Context())
+	closedCancel()
+	require.NoError(t, closedAuditor.Run(closedCtx))
+
 	tests := map[string]struct {
 		sec *securityfake.Fake
 		val validator.Validator
 		ca  ca.Signer
 
+		denyList *denylist.DenyList
+		auditor  *audit.Auditor
+
 		req     *sentryv1pb.SignCertificateRequest
 		expResp *sentryv1pb.SignCertificateResponse
 		expErr  bool
@@ -211,6 +225,33 @@ func TestRun(t *testing.T) {
 			expErr:  false,
 			expCode: codes.OK,
 		},
+		"request whose issuance can't be audited should error": {
+			sec: securityfake.New().WithGRPCServerOptionNoClientAuthFn(func() grpc.ServerOption {
+				return grpc.Creds(insecure.NewCredentials())
+			}),
+			val: validatorfake.New().WithValidateFn(func(ctx context.Context, req *sentryv1pb.SignCertificateRequest) (validator.ValidateResult, error) {
+				return validator.ValidateResult{
+					TrustDomain: spiffeid.RequireTrustDomainFromString("my-trust-domain"),
+				}, nil
+			}),
+			ca: cafake.New().WithSignIdentity(func(ctx context.Context, req *ca.SignRequest) ([]*x509.Certificate, error) {
+				return []*x509.Certificate{crtX509}, nil
+			}).WithTrustAnchors(func() []byte {
+				return []byte("my-trust-anchors")
+			}),
+			auditor: closedAuditor,
+			req: &sentryv1pb.SignCertificateRequest{
+				Id:                        "my-id",
+				Token:                     "my-token",
+				TrustDomain:               "my-trust-domain",
+				Namespace:                 "my-namespace",
+				CertificateSigningRequest: csrPEM,
+				TokenValidator:            sentryv1pb.SignCertificateRequest_TokenValidator(-1),
+			},
+			expResp: nil,
+			expErr:  true,
+			expCode: codes.Internal,
+		},
 		"if request is for injector, expect injector dns name": {
 			sec: securityfake.New().WithGRPCServerOptionNoClientAuthFn(func() grpc.ServerOption {
 				return grpc.Creds(insecure.NewCredentials())
@@ -273,6 +314,62 @@ func TestRun(t *testing.T) {
 			expErr:  false,
 			expCode: codes.OK,
 		},
+		"request for a denied namespace should be denied": {
+			sec: securityfake.New().WithGRPCServerOptionNoClientAuthFn(func() grpc.ServerOption {
+				return grpc.Creds(insecure.NewCredentials())
+			}),
+			val: validatorfake.New().WithValidateFn(func(ctx context.Context, req *sentryv1pb.SignCertificateRequest) (validator.ValidateResult, error) {
+				return validator.ValidateResult{
+					TrustDomain: spiffeid.RequireTrustDomainFromString("my-trust-domain"),
+				}, nil
+			}),
+			ca: cafake.New().WithSignIdentity(func(ctx context.Context, req *ca.SignRequest) ([]*x509.Certificate, error) {
+				assert.Fail(t, "denied request should not be signed")
+				return []*x509.Certificate{crtX509}, nil
+			}),
+			denyList: denylist.New(denylist.Options{
+				DenyList: config.ConfigDenyList{Namespaces: []string{"compromised"}},
+			}),
+			req: &sentryv1pb.SignCertificateRequest{
+				Id:                        "my-id",
+				Token:                     "my-token",
+				TrustDomain:               "my-trust-domain",
+				Namespace:                 "compromised",
+				CertificateSigningRequest: csrPEM,
+				TokenValidator:            sentryv1pb.SignCertificateRequest_TokenValidator(-1),
+			},
+			expResp: nil,
+			expErr:  true,
+			expCode: codes.PermissionDenied,
+		},
+		"request for a denied SPIFFE ID should be denied": {
+			sec: securityfake.New().WithGRPCServerOptionNoClientAuthFn(func() grpc.ServerOption {
+				return grpc.Creds(insecure.NewCredentials())
+			}),
+			val: validatorfake.New().WithValidateFn(func(ctx context.Context, req *sentryv1pb.SignCertificateRequest) (validator.ValidateResult, error) {
+				return validator.ValidateResult{
+					TrustDomain: spiffeid.RequireTrustDomainFromString("my-trust-domain"),
+				}, nil
+			}),
+			ca: cafake.New().WithSignIdentity(func(ctx context.Context, req *ca.SignRequest) ([]*x509.Certificate, error) {
+				assert.Fail(t, "denied request should not be signed")
+				return []*x509.Certificate{crtX509}, nil
+			}),
+			denyList: denylist.New(denylist.Options{
+				DenyList: config.ConfigDenyList{SPIFFEIDs: []string{"spiffe://my-trust-domain/ns/default/my-id"}},
+			}),
+			req: &sentryv1pb.SignCertificateRequest{
+				Id:                        "my-id",
+				Token:                     "my-token",
+				TrustDomain:               "my-trust-domain",
+				Namespace:                 "default",
+				CertificateSigningRequest: csrPEM,
+				TokenValidator:            sentryv1pb.SignCertificateRequest_TokenValidator(-1),
+			},
+			expResp: nil,
+			expErr:  true,
+			expCode: codes.PermissionDenied,
+		},
 	}
 
 	for name, test := range tests {
@@ -287,8 +384,10 @@ func TestRun(t *testing.T) {
 					// This is an invalid validator that is just used for tests
 					-1: test.val,
 				},
-				CA:      test.ca,
-				Healthz: healthz.New(),
+				CA:       test.ca,
+				Healthz:  healthz.New(),
+				DenyList: test.denyList,
+				Auditor:  test.auditor,
 			}
 
 			serverClosed := make(chan struct{})
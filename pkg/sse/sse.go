@@ -114,3 +114,46 @@ func FlushSSEResponse(ctx context.Context, writer http.ResponseWriter, reader io
 	}
 	return nil
 }
+
+// Writer writes Server-Sent Events to a HTTP response.
+type Writer struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+// NewWriter writes the headers of a Server-Sent Events response and returns a
+// Writer for its events. It returns false if the response can't be streamed.
+func NewWriter(w http.ResponseWriter) (*Writer, bool) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, false
+	}
+
+	w.Header().Set(headerContentType, mimeEventStream)
+	w.Header().Set(headerCacheControl, cacheNoCache)
+	w.Header().Set(headerConnection, connectionKeepAlive)
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	return &Writer{w: w, flusher: flusher}, true
+}
+
+// WriteEvent writes an event with the given type and data, and flushes it to
+// the client. The event type is omitted if empty.
+func (s *Writer) WriteEvent(event string, data []byte) error {
+	var b strings.Builder
+	if event != "" {
+		b.WriteString("event: " + event + "\n")
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		b.WriteString("data: " + line + "\n")
+	}
+	b.WriteString("\n")
+
+	_, err := io.WriteString(s.w, b.String())
+	if err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
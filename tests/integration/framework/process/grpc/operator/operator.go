@@ -186,16 +186,19 @@ func New(t *testing.T, fopts ...Option) *Operator {
 		}),
 		procgrpc.WithRegister(func(s *grpc.Server) {
 			srv := &server{
-				componentUpdateFn:     opts.componentUpdateFn,
-				getConfigurationFn:    opts.getConfigurationFn,
-				getResiliencyFn:       opts.getResiliencyFn,
-				httpEndpointUpdateFn:  opts.httpEndpointUpdateFn,
-				listComponentsFn:      opts.listComponentsFn,
-				listHTTPEndpointsFn:   opts.listHTTPEndpointsFn,
-				listResiliencyFn:      opts.listResiliencyFn,
-				listSubscriptionsFn:   opts.listSubscriptionsFn,
-				listSubscriptionsV2Fn: opts.listSubscriptionsV2Fn,
-				subscriptionUpdateFn:  opts.subscriptionUpdateFn,
+				componentUpdateFn:      opts.componentUpdateFn,
+				configurationUpdateFn:  opts.configurationUpdateFn,
+				getConfigurationFn:     opts.getConfigurationFn,
+				getResiliencyFn:        opts.getResiliencyFn,
+				httpEndpointUpdateFn:   opts.httpEndpointUpdateFn,
+				listComponentsFn:       opts.listComponentsFn,
+				listHTTPEndpointsFn:    opts.listHTTPEndpointsFn,
+				listResiliencyFn:       opts.listResiliencyFn,
+				listSubscriptionsFn:    opts.listSubscriptionsFn,
+				listSubscriptionsV2Fn:  opts.listSubscriptionsV2Fn,
+				reportResourceStatusFn: opts.reportResourceStatusFn,
+				resiliencyUpdateFn:     opts.resiliencyUpdateFn,
+				subscriptionUpdateFn:   opts.subscriptionUpdateFn,
 			}
 
 			operatorv1.RegisterOperatorServer(s, srv)
@@ -29,17 +29,20 @@ type options struct {
 	grpcopts []procgrpc.Option
 	sentry   *sentry.Sentry
 
-	withRegister          func(*grpc.Server)
-	componentUpdateFn     func(*operatorv1.ComponentUpdateRequest, operatorv1.Operator_ComponentUpdateServer) error
-	getConfigurationFn    func(context.Context, *operatorv1.GetConfigurationRequest) (*operatorv1.GetConfigurationResponse, error)
-	getResiliencyFn       func(context.Context, *operatorv1.GetResiliencyRequest) (*operatorv1.GetResiliencyResponse, error)
-	httpEndpointUpdateFn  func(*operatorv1.HTTPEndpointUpdateRequest, operatorv1.Operator_HTTPEndpointUpdateServer) error
-	listComponentsFn      func(context.Context, *operatorv1.ListComponentsRequest) (*operatorv1.ListComponentResponse, error)
-	listHTTPEndpointsFn   func(context.Context, *operatorv1.ListHTTPEndpointsRequest) (*operatorv1.ListHTTPEndpointsResponse, error)
-	listResiliencyFn      func(context.Context, *operatorv1.ListResiliencyRequest) (*operatorv1.ListResiliencyResponse, error)
-	listSubscriptionsFn   func(context.Context, *emptypb.Empty) (*operatorv1.ListSubscriptionsResponse, error)
-	listSubscriptionsV2Fn func(context.Context, *operatorv1.ListSubscriptionsRequest) (*operatorv1.ListSubscriptionsResponse, error)
-	subscriptionUpdateFn  func(*operatorv1.SubscriptionUpdateRequest, operatorv1.Operator_SubscriptionUpdateServer) error
+	withRegister           func(*grpc.Server)
+	componentUpdateFn      func(*operatorv1.ComponentUpdateRequest, operatorv1.Operator_ComponentUpdateServer) error
+	configurationUpdateFn  func(*operatorv1.ConfigurationUpdateRequest, operatorv1.Operator_ConfigurationUpdateServer) error
+	getConfigurationFn     func(context.Context, *operatorv1.GetConfigurationRequest) (*operatorv1.GetConfigurationResponse, error)
+	getResiliencyFn        func(context.Context, *operatorv1.GetResiliencyRequest) (*operatorv1.GetResiliencyResponse, error)
+	httpEndpointUpdateFn   func(*operatorv1.HTTPEndpointUpdateRequest, operatorv1.Operator_HTTPEndpointUpdateServer) error
+	listComponentsFn       func(context.Context, *operatorv1.ListComponentsRequest) (*operatorv1.ListComponentResponse, error)
+	listHTTPEndpointsFn    func(context.Context, *operatorv1.ListHTTPEndpointsRequest) (*operatorv1.ListHTTPEndpointsResponse, error)
+	listResiliencyFn       func(context.Context, *operatorv1.ListResiliencyRequest) (*operatorv1.ListResiliencyResponse, error)
+	listSubscriptionsFn    func(context.Context, *emptypb.Empty) (*operatorv1.ListSubscriptionsResponse, error)
+	listSubscriptionsV2Fn  func(context.Context, *operatorv1.ListSubscriptionsRequest) (*operatorv1.ListSubscriptionsResponse, error)
+	reportResourceStatusFn func(operatorv1.Operator_ReportResourceStatusServer) error
+	resiliencyUpdateFn     func(*operatorv1.ResiliencyUpdateRequest, operatorv1.Operator_ResiliencyUpdateServer) error
+	subscriptionUpdateFn   func(*operatorv1.SubscriptionUpdateRequest, operatorv1.Operator_SubscriptionUpdateServer) error
 }
 
 func WithGRPCOptions(opts ...procgrpc.Option) func(*options) {
@@ -60,6 +63,12 @@ func WithComponentUpdateFn(fn func(*operatorv1.ComponentUpdateRequest, operatorv
 	}
 }
 
+func WithConfigurationUpdateFn(fn func(*operatorv1.ConfigurationUpdateRequest, operatorv1.Operator_ConfigurationUpdateServer) error) func(*options) {
+	return func(opts *options) {
+		opts.configurationUpdateFn = fn
+	}
+}
+
 func WithGetConfigurationFn(fn func(context.Context, *operatorv1.GetConfigurationRequest) (*operatorv1.GetConfigurationResponse, error)) func(*options) {
 	return func(opts *options) {
 		opts.getConfigurationFn = fn
@@ -108,6 +117,18 @@ func WithListSubscriptionsV2Fn(fn func(context.Context, *operatorv1.ListSubscrip
 	}
 }
 
+func WithReportResourceStatusFn(fn func(operatorv1.Operator_ReportResourceStatusServer) error) func(*options) {
+	return func(opts *options) {
+		opts.reportResourceStatusFn = fn
+	}
+}
+
+func WithResiliencyUpdateFn(fn func(*operatorv1.ResiliencyUpdateRequest, operatorv1.Operator_ResiliencyUpdateServer) error) func(*options) {
+	return func(opts *options) {
+		opts.resiliencyUpdateFn = fn
+	}
+}
+
 func WithSubscriptionUpdateFn(fn func(*operatorv1.SubscriptionUpdateRequest, operatorv1.Operator_SubscriptionUpdateServer) error) func(*options) {
 	return func(opts *options) {
 		opts.subscriptionUpdateFn = fn
@@ -15,6 +15,8 @@ package operator
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -22,16 +24,19 @@ import (
 )
 
 type server struct {
-	componentUpdateFn     func(*operatorv1.ComponentUpdateRequest, operatorv1.Operator_ComponentUpdateServer) error
-	getConfigurationFn    func(context.Context, *operatorv1.GetConfigurationRequest) (*operatorv1.GetConfigurationResponse, error)
-	getResiliencyFn       func(context.Context, *operatorv1.GetResiliencyRequest) (*operatorv1.GetResiliencyResponse, error)
-	httpEndpointUpdateFn  func(*operatorv1.HTTPEndpointUpdateRequest, operatorv1.Operator_HTTPEndpointUpdateServer) error
-	listComponentsFn      func(context.Context, *operatorv1.ListComponentsRequest) (*operatorv1.ListComponentResponse, error)
-	listHTTPEndpointsFn   func(context.Context, *operatorv1.ListHTTPEndpointsRequest) (*operatorv1.ListHTTPEndpointsResponse, error)
-	listResiliencyFn      func(context.Context, *operatorv1.ListResiliencyRequest) (*operatorv1.ListResiliencyResponse, error)
-	listSubscriptionsFn   func(context.Context, *emptypb.Empty) (*operatorv1.ListSubscriptionsResponse, error)
-	listSubscriptionsV2Fn func(context.Context, *operatorv1.ListSubscriptionsRequest) (*operatorv1.ListSubscriptionsResponse, error)
-	subscriptionUpdateFn  func(*operatorv1.SubscriptionUpdateRequest, operatorv1.Operator_SubscriptionUpdateServer) error
+	componentUpdateFn      func(*operatorv1.ComponentUpdateRequest, operatorv1.Operator_ComponentUpdateServer) error
+	configurationUpdateFn  func(*operatorv1.ConfigurationUpdateRequest, operatorv1.Operator_ConfigurationUpdateServer) error
+	getConfigurationFn     func(context.Context, *operatorv1.GetConfigurationRequest) (*operatorv1.GetConfigurationResponse, error)
+	getResiliencyFn        func(context.Context, *operatorv1.GetResiliencyRequest) (*operatorv1.GetResiliencyResponse, error)
+	httpEndpointUpdateFn   func(*operatorv1.HTTPEndpointUpdateRequest, operatorv1.Operator_HTTPEndpointUpdateServer) error
+	listComponentsFn       func(context.Context, *operatorv1.ListComponentsRequest) (*operatorv1.ListComponentResponse, error)
+	listHTTPEndpointsFn    func(context.Context, *operatorv1.ListHTTPEndpointsRequest) (*operatorv1.ListHTTPEndpointsResponse, error)
+	listResiliencyFn       func(context.Context, *operatorv1.ListResiliencyRequest) (*operatorv1.ListResiliencyResponse, error)
+	listSubscriptionsFn    func(context.Context, *emptypb.Empty) (*operatorv1.ListSubscriptionsResponse, error)
+	listSubscriptionsV2Fn  func(context.Context, *operatorv1.ListSubscriptionsRequest) (*operatorv1.ListSubscriptionsResponse, error)
+	reportResourceStatusFn func(operatorv1.Operator_ReportResourceStatusServer) error
+	resiliencyUpdateFn     func(*operatorv1.ResiliencyUpdateRequest, operatorv1.Operator_ResiliencyUpdateServer) error
+	subscriptionUpdateFn   func(*operatorv1.SubscriptionUpdateRequest, operatorv1.Operator_SubscriptionUpdateServer) error
 }
 
 func (s *server) ComponentUpdate(req *operatorv1.ComponentUpdateRequest, srv operatorv1.Operator_ComponentUpdateServer) error {
@@ -41,6 +46,13 @@ func (s *server) ComponentUpdate(req *operatorv1.ComponentUpdateRequest, srv ope
 	return nil
 }
 
+func (s *server) ConfigurationUpdate(req *operatorv1.ConfigurationUpdateRequest, srv operatorv1.Operator_ConfigurationUpdateServer) error {
+	if s.configurationUpdateFn != nil {
+		return s.configurationUpdateFn(req, srv)
+	}
+	return nil
+}
+
 func (s *server) GetConfiguration(ctx context.Context, in *operatorv1.GetConfigurationRequest) (*operatorv1.GetConfigurationResponse, error) {
 	if s.getConfigurationFn != nil {
 		return s.getConfigurationFn(ctx, in)
@@ -97,6 +109,26 @@ func (s *server) ListSubscriptionsV2(ctx context.Context, in *operatorv1.ListSub
 	return new(operatorv1.ListSubscriptionsResponse), nil
 }
 
+func (s *server) ReportResourceStatus(srv operatorv1.Operator_ReportResourceStatusServer) error {
+	if s.reportResourceStatusFn != nil {
+		return s.reportResourceStatusFn(srv)
+	}
+	for {
+		if _, err := srv.Recv(); errors.Is(err, io.EOF) {
+			return srv.SendAndClose(new(emptypb.Empty))
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *server) ResiliencyUpdate(req *operatorv1.ResiliencyUpdateRequest, srv operatorv1.Operator_ResiliencyUpdateServer) error {
+	if s.resiliencyUpdateFn != nil {
+		return s.resiliencyUpdateFn(req, srv)
+	}
+	return nil
+}
+
 func (s *server) SubscriptionUpdate(req *operatorv1.SubscriptionUpdateRequest, srv operatorv1.Operator_SubscriptionUpdateServer) error {
 	if s.subscriptionUpdateFn != nil {
 		return s.subscriptionUpdateFn(req, srv)
@@ -132,6 +132,17 @@ func (m *mtls) Run(t *testing.T, ctx context.Context) {
 	})
 	require.Error(t, err)
 	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The placement tables can only be inspected in the namespace of the
+	// SPIFFE ID, which is used when no namespace is requested.
+	_, err = client.GetPlacementLoads(ctx, &v1pb.GetPlacementLoadsRequest{})
+	require.NoError(t, err)
+	_, err = client.GetPlacementLoads(ctx, &v1pb.GetPlacementLoadsRequest{Namespace: "default"})
+	require.NoError(t, err)
+	_, err = client.GetPlacementLoads(ctx, &v1pb.GetPlacementLoadsRequest{Namespace: "foo"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.LookupActor(ctx, &v1pb.LookupActorRequest{Namespace: "foo", ActorType: "myactor", ActorId: "1"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
 }
 
 func establishStream(t *testing.T, ctx context.Context, client v1pb.PlacementClient, firstMessage *v1pb.Host) (v1pb.Placement_ReportDaprStatusClient, error) {
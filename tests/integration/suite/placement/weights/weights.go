@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weights
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	placementv1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/placement"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(weights))
+}
+
+// weights tests that the placement weights of actor hosts change which host
+// actors are placed on once the max API level is raised to the weighted API
+// level, and are ignored with the default max API level.
+type weights struct {
+	weighted   *placement.Placement
+	unweighted *placement.Placement
+}
+
+func (w *weights) Setup(t *testing.T) []framework.Option {
+	w.weighted = placement.New(t,
+		placement.WithMaxAPILevel(30),
+	)
+	w.unweighted = placement.New(t)
+
+	return []framework.Option{
+		framework.WithProcesses(w.weighted, w.unweighted),
+	}
+}
+
+func (w *weights) Run(t *testing.T, ctx context.Context) {
+	w.weighted.WaitUntilRunning(t, ctx)
+	w.unweighted.WaitUntilRunning(t, ctx)
+
+	t.Run("weights are applied when the max API level is raised", func(t *testing.T) {
+		small, large := w.placeActors(t, ctx, w.weighted, 30)
+		assert.Greater(t, large, 2*small, "small=%d large=%d", small, large)
+	})
+
+	t.Run("weights are ignored with the default max API level", func(t *testing.T) {
+		small, large := w.placeActors(t, ctx, w.unweighted, 10)
+		assert.Less(t, large, 2*small, "small=%d large=%d", small, large)
+	})
+}
+
+// placeActors registers a host of weight 1 and a host of weight 4 and returns
+// how many of 1000 actors are placed on each of them.
+func (w *weights) placeActors(t *testing.T, ctx context.Context, place *placement.Placement, apiLevel uint32) (int, int) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+
+	host := func(name string, weight uint32) *placementv1pb.Host {
+		return &placementv1pb.Host{
+			Name:      name,
+			Namespace: "default",
+			Port:      1234,
+			Entities:  []string{"myactor"},
+			Id:        name,
+			ApiLevel:  30,
+			Weight:    weight,
+		}
+	}
+	var tables atomic.Pointer[placementv1pb.PlacementTables]
+	for _, ch := range []chan *placementv1pb.PlacementTables{
+		place.RegisterHost(t, ctx, host("small", 1)),
+		place.RegisterHost(t, ctx, host("large", 4)),
+	} {
+		go func() {
+			for update := range ch {
+				tables.Store(update)
+			}
+		}()
+	}
+
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		latest := tables.Load()
+		if assert.NotNil(c, latest) {
+			assert.Equal(c, apiLevel, latest.GetApiLevel())
+			assert.Len(c, latest.GetEntries()["myactor"].GetLoadMap(), 2)
+		}
+	}, time.Second*15, time.Millisecond*10)
+
+	//nolint:staticcheck
+	conn, err := grpc.DialContext(ctx, place.Address(),
+		grpc.WithBlock(), //nolint:staticcheck
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, conn.Close()) })
+	client := placementv1pb.NewPlacementClient(conn)
+
+	placed := make(map[string]int)
+	for i := range 1000 {
+		resp, err := client.LookupActor(ctx, &placementv1pb.LookupActorRequest{
+			Namespace: "default",
+			ActorType: "myactor",
+			ActorId:   strconv.Itoa(i),
+		})
+		require.NoError(t, err)
+		placed[resp.GetHost().GetName()]++
+	}
+
+	return placed["small"], placed["large"]
+}